@@ -17,7 +17,7 @@ func NewAuthApplicationService(authDomain auth.Auth) *AuthApplicationService {
 }
 
 func (a *AuthApplicationService) GenerateToken(ctx context.Context, req *authv1.GenerateTokenRequest) (*authv1.GenerateTokenResponse, error) {
-	tokens, err := a.authDomain.GenerateToken(ctx, req.GetUserId())
+	tokens, err := a.authDomain.GenerateToken(ctx, req.GetUserID())
 	if err != nil {
 		return nil, err
 	}
@@ -28,8 +28,17 @@ func (a *AuthApplicationService) GenerateToken(ctx context.Context, req *authv1.
 	}, nil
 }
 
+func (a *AuthApplicationService) GenerateConnToken(ctx context.Context, req *authv1.GenerateConnTokenRequest) (*authv1.GenerateConnTokenResponse, error) {
+	connToken, err := a.authDomain.GenerateConnToken(ctx, req.GetUserID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &authv1.GenerateConnTokenResponse{ConnToken: connToken}, nil
+}
+
 func (a *AuthApplicationService) ParseToken(ctx context.Context, req *authv1.ParseTokenRequest) (*authv1.ParseTokenResponse, error) {
-	claims, err := a.authDomain.ParseToken(ctx, req.GetToken())
+	claims, err := a.authDomain.ParseToken(ctx, req.GetToken(), req.GetTokenType())
 	if err != nil {
 		return nil, err
 	}
@@ -37,6 +46,15 @@ func (a *AuthApplicationService) ParseToken(ctx context.Context, req *authv1.Par
 	return &authv1.ParseTokenResponse{UserID: claims.UID}, nil
 }
 
+func (a *AuthApplicationService) RevokeTokens(ctx context.Context, req *authv1.RevokeTokensRequest) (*authv1.RevokeTokensResponse, error) {
+	err := a.authDomain.RevokeTokens(ctx, req.GetUserID(), req.GetTokens())
+	if err != nil {
+		return nil, err
+	}
+
+	return &authv1.RevokeTokensResponse{}, nil
+}
+
 func (a *AuthApplicationService) RefreshToken(ctx context.Context, req *authv1.RefreshTokenRequest) (*authv1.RefreshTokenResponse, error) {
 	tokens, userID, err := a.authDomain.RefreshToken(ctx, req.GetRefreshToken())
 	if err != nil {
@@ -8,6 +8,12 @@ import (
 
 type Auth interface {
 	GenerateToken(ctx context.Context, uid int64) ([]string, error)
-	ParseToken(ctx context.Context, token string) (*token.Claims, error)
+	GenerateConnToken(ctx context.Context, uid int64) (string, error)
+	// ParseToken verifies tk and that it is of tokenType, an access token when
+	// tokenType is empty.
+	ParseToken(ctx context.Context, tk string, tokenType string) (*token.Claims, error)
 	RefreshToken(ctx context.Context, refreshToken string) ([]string, int64, error)
+	// RevokeTokens revokes the given tokens of uid one by one, leaving the
+	// user's other tokens valid.
+	RevokeTokens(ctx context.Context, uid int64, tokens []string) error
 }
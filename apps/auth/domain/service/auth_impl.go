@@ -2,8 +2,14 @@ package service
 
 import (
 	"context"
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
 
 	"github.com/crazyfrankie/goim/infra/contract/token"
+	"github.com/crazyfrankie/goim/pkg/errorx"
+	"github.com/crazyfrankie/goim/types/errno"
 )
 
 type Components struct {
@@ -27,13 +33,51 @@ func (a *authImpl) GenerateToken(ctx context.Context, uid int64) ([]string, erro
 	return tokens, nil
 }
 
-func (a *authImpl) ParseToken(ctx context.Context, token string) (*token.Claims, error) {
-	claims, err := a.TokenGen.ParseToken(token)
+func (a *authImpl) GenerateConnToken(ctx context.Context, uid int64) (string, error) {
+	return a.TokenGen.GenerateConnToken(uid)
+}
+
+func (a *authImpl) ParseToken(ctx context.Context, tk string, tokenType string) (*token.Claims, error) {
+	claims, err := a.TokenGen.ParseToken(tk)
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return nil, errorx.WrapByCode(err, errno.ErrAuthTokenExpiredCode)
+		}
+		return nil, errorx.WrapByCode(err, errno.ErrAuthTokenInvalidCode)
+	}
+	if tokenType == "" {
+		tokenType = token.TypeAccess
+	}
+	if claims.Type != tokenType {
+		return nil, errorx.New(errno.ErrAuthTokenInvalidCode, errorx.KV("type", claims.Type))
+	}
+
+	revoked, err := a.TokenGen.IsRevoked(ctx, tk, claims)
 	if err != nil {
 		return nil, err
 	}
+	if revoked {
+		return nil, errorx.New(errno.ErrAuthTokenRevokedCode)
+	}
+
+	return claims, nil
+}
+
+func (a *authImpl) RevokeTokens(ctx context.Context, uid int64, tokens []string) error {
+	now := time.Now()
+	for _, tk := range tokens {
+		// Tokens that fail to parse are already refused, and tokens of other
+		// users are not the caller's to revoke.
+		claims, err := a.TokenGen.ParseToken(tk)
+		if err != nil || claims.UID != uid || claims.ExpiresAt == nil {
+			continue
+		}
+		if err := a.TokenGen.RevokeSingleToken(ctx, tk, claims.ExpiresAt.Sub(now)); err != nil {
+			return err
+		}
+	}
 
-	return claims, err
+	return nil
 }
 
 func (a *authImpl) RefreshToken(ctx context.Context, refreshToken string) ([]string, int64, error) {
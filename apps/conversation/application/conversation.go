@@ -0,0 +1,146 @@
+package application
+
+import (
+	"context"
+
+	"github.com/crazyfrankie/goim/apps/conversation/domain/entity"
+	conversation "github.com/crazyfrankie/goim/apps/conversation/domain/service"
+	"github.com/crazyfrankie/goim/pkg/grpc/ctxutil"
+	langslice "github.com/crazyfrankie/goim/pkg/lang/slice"
+	conversationv1 "github.com/crazyfrankie/goim/protocol/conversation/v1"
+)
+
+type ConversationApplicationService struct {
+	conversationDomain conversation.Conversation
+	conversationv1.UnimplementedConversationServiceServer
+}
+
+func NewConversationApplicationService(conversationDomain conversation.Conversation) conversationv1.ConversationServiceServer {
+	return &ConversationApplicationService{conversationDomain: conversationDomain}
+}
+
+func (c *ConversationApplicationService) ListConversations(ctx context.Context, req *conversationv1.ListConversationsRequest) (*conversationv1.ListConversationsResponse, error) {
+	res, err := c.conversationDomain.ListConversations(ctx, &conversation.ListConversationsRequest{
+		OwnerID: ctxutil.MustGetUserIDFromCtx(ctx),
+		Page:    req.GetPage(),
+		Size:    req.GetSize(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &conversationv1.ListConversationsResponse{
+		Conversations:    langslice.Transform(res.Conversations, conversationDO2DTO),
+		Total:            res.Total,
+		TotalUnreadCount: res.TotalUnreadCount,
+	}, nil
+}
+
+func (c *ConversationApplicationService) GetConversation(ctx context.Context, req *conversationv1.GetConversationRequest) (*conversationv1.GetConversationResponse, error) {
+	conv, err := c.conversationDomain.GetConversation(ctx, ctxutil.MustGetUserIDFromCtx(ctx), req.GetConversationID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &conversationv1.GetConversationResponse{
+		Data: conversationDO2DTO(conv),
+	}, nil
+}
+
+func (c *ConversationApplicationService) SetConversation(ctx context.Context, req *conversationv1.SetConversationRequest) (*conversationv1.SetConversationResponse, error) {
+	err := c.conversationDomain.SetConversation(ctx, &conversation.SetConversationRequest{
+		OwnerID:        ctxutil.MustGetUserIDFromCtx(ctx),
+		ConversationID: req.GetConversationID(),
+		IsPinned:       req.IsPinned,
+		IsMuted:        req.IsMuted,
+		Draft:          req.Draft,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &conversationv1.SetConversationResponse{}, nil
+}
+
+func (c *ConversationApplicationService) GetConversationsHasReadAndMaxSeq(ctx context.Context, req *conversationv1.GetConversationsHasReadAndMaxSeqRequest) (*conversationv1.GetConversationsHasReadAndMaxSeqResponse, error) {
+	convs, err := c.conversationDomain.GetConversations(ctx, ctxutil.MustGetUserIDFromCtx(ctx), req.GetConversationIDs())
+	if err != nil {
+		return nil, err
+	}
+
+	seqs := make(map[string]*conversationv1.ConversationSeqs, len(convs))
+	for _, conv := range convs {
+		seqs[conv.ConversationID] = &conversationv1.ConversationSeqs{
+			MaxSeq:     conv.MaxSeq,
+			HasReadSeq: conv.HasReadSeq,
+		}
+	}
+
+	return &conversationv1.GetConversationsHasReadAndMaxSeqResponse{Seqs: seqs}, nil
+}
+
+func (c *ConversationApplicationService) GetConversationsLastMessage(ctx context.Context, req *conversationv1.GetConversationsLastMessageRequest) (*conversationv1.GetConversationsLastMessageResponse, error) {
+	convs, err := c.conversationDomain.GetConversations(ctx, ctxutil.MustGetUserIDFromCtx(ctx), req.GetConversationIDs())
+	if err != nil {
+		return nil, err
+	}
+
+	lastMessages := make(map[string]*conversationv1.LastMessage, len(convs))
+	for _, conv := range convs {
+		if conv.LastMessage != nil {
+			lastMessages[conv.ConversationID] = lastMessageDO2DTO(conv.LastMessage)
+		}
+	}
+
+	return &conversationv1.GetConversationsLastMessageResponse{LastMessages: lastMessages}, nil
+}
+
+// GetMutedOwnerIDs serves the push worker, which calls it on behalf of the
+// sender of the message to push. Only participants of the conversation may
+// learn who muted it.
+func (c *ConversationApplicationService) GetMutedOwnerIDs(ctx context.Context, req *conversationv1.GetMutedOwnerIDsRequest) (*conversationv1.GetMutedOwnerIDsResponse, error) {
+	if _, err := c.conversationDomain.GetConversation(ctx, ctxutil.MustGetUserIDFromCtx(ctx), req.GetConversationID()); err != nil {
+		return nil, err
+	}
+
+	mutedIDs, err := c.conversationDomain.GetMutedOwnerIDs(ctx, req.GetConversationID(), req.GetOwnerIDs())
+	if err != nil {
+		return nil, err
+	}
+
+	return &conversationv1.GetMutedOwnerIDsResponse{MutedOwnerIDs: mutedIDs}, nil
+}
+
+func conversationDO2DTO(conv *entity.Conversation) *conversationv1.Conversation {
+	res := &conversationv1.Conversation{
+		ConversationID:   conv.ConversationID,
+		OwnerID:          conv.OwnerID,
+		ConversationType: conv.ConversationType,
+		PeerID:           conv.PeerID,
+		GroupID:          conv.GroupID,
+		MaxSeq:           conv.MaxSeq,
+		HasReadSeq:       conv.HasReadSeq,
+		UnreadCount:      conv.UnreadCount(),
+		IsPinned:         conv.IsPinned,
+		IsMuted:          conv.IsMuted,
+		Draft:            conv.Draft,
+		DraftTime:        conv.DraftTime,
+		UpdateTime:       conv.UpdatedTime,
+	}
+	if conv.LastMessage != nil {
+		res.LastMessage = lastMessageDO2DTO(conv.LastMessage)
+	}
+
+	return res
+}
+
+func lastMessageDO2DTO(msg *entity.LastMessage) *conversationv1.LastMessage {
+	return &conversationv1.LastMessage{
+		ServerMsgID: msg.MsgID,
+		SendID:      msg.SendID,
+		ContentType: msg.ContentType,
+		Content:     []byte(msg.Content),
+		Seq:         msg.Seq,
+		SendTime:    msg.SendTime,
+	}
+}
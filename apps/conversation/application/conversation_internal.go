@@ -0,0 +1,59 @@
+package application
+
+import (
+	"context"
+
+	"github.com/crazyfrankie/goim/apps/conversation/domain/entity"
+	conversation "github.com/crazyfrankie/goim/apps/conversation/domain/service"
+	"github.com/crazyfrankie/goim/pkg/grpc/ctxutil"
+	conversationv1 "github.com/crazyfrankie/goim/protocol/conversation/v1"
+)
+
+// ConversationInternalApplicationService serves the message service, which
+// keeps the conversations in step with the messages it stores.
+type ConversationInternalApplicationService struct {
+	conversationDomain conversation.Conversation
+	conversationv1.UnimplementedConversationInternalServiceServer
+}
+
+func NewConversationInternalApplicationService(conversationDomain conversation.Conversation) conversationv1.ConversationInternalServiceServer {
+	return &ConversationInternalApplicationService{conversationDomain: conversationDomain}
+}
+
+func (c *ConversationInternalApplicationService) UpdateConversationsByMessage(ctx context.Context, req *conversationv1.UpdateConversationsByMessageRequest) (*conversationv1.UpdateConversationsByMessageResponse, error) {
+	msg := req.GetLastMessage()
+	// Only the sender of the message may advance the conversations it lands in.
+	if err := ctxutil.CheckAccess(ctx, msg.GetSendID()); err != nil {
+		return nil, err
+	}
+
+	err := c.conversationDomain.UpdateByMessage(ctx, &conversation.UpdateByMessageRequest{
+		ConversationID:   req.GetConversationID(),
+		ConversationType: req.GetConversationType(),
+		RecvID:           req.GetRecvID(),
+		GroupID:          req.GetGroupID(),
+		OwnerIDs:         req.GetOwnerIDs(),
+		LastMessage: &entity.LastMessage{
+			MsgID:       msg.GetServerMsgID(),
+			SendID:      msg.GetSendID(),
+			ContentType: msg.GetContentType(),
+			Content:     string(msg.GetContent()),
+			Seq:         msg.GetSeq(),
+			SendTime:    msg.GetSendTime(),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &conversationv1.UpdateConversationsByMessageResponse{}, nil
+}
+
+func (c *ConversationInternalApplicationService) MarkConversationAsRead(ctx context.Context, req *conversationv1.MarkConversationAsReadRequest) (*conversationv1.MarkConversationAsReadResponse, error) {
+	err := c.conversationDomain.MarkAsRead(ctx, ctxutil.MustGetUserIDFromCtx(ctx), req.GetConversationID(), req.GetHasReadSeq())
+	if err != nil {
+		return nil, err
+	}
+
+	return &conversationv1.MarkConversationAsReadResponse{}, nil
+}
@@ -0,0 +1,35 @@
+package entity
+
+type Conversation struct {
+	ConversationID   string
+	OwnerID          int64
+	ConversationType int32 // Session type of the conversation
+	PeerID           int64 // Peer user ID (single chat)
+	GroupID          int64 // Group ID (group chat)
+
+	MaxSeq      int64        // Seq of the newest message
+	HasReadSeq  int64        // Seq of the newest message read by the owner
+	LastMessage *LastMessage // Snapshot of the newest message
+
+	IsPinned  bool   // pinned on top of the list
+	IsMuted   bool   // do not disturb
+	Draft     string // unsent draft text
+	DraftTime int64  // draft update time
+
+	CreatedTime int64 // creation time
+	UpdatedTime int64 // update time
+}
+
+// UnreadCount returns how many messages the owner has not read yet.
+func (c *Conversation) UnreadCount() int64 {
+	return max(c.MaxSeq-c.HasReadSeq, 0)
+}
+
+type LastMessage struct {
+	MsgID       int64
+	SendID      int64
+	ContentType int32
+	Content     string
+	Seq         int64
+	SendTime    int64
+}
@@ -0,0 +1,141 @@
+package dal
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gen/field"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/crazyfrankie/goim/apps/conversation/domain/internal/dal/model"
+	"github.com/crazyfrankie/goim/apps/conversation/domain/internal/dal/query"
+)
+
+type ConversationDao struct {
+	query *query.Query
+}
+
+func NewConversationDao(db *gorm.DB) *ConversationDao {
+	return &ConversationDao{query: query.Use(db)}
+}
+
+// ListConversations returns a page of the owner's conversations, pinned ones
+// first and then by last activity.
+func (c *ConversationDao) ListConversations(ctx context.Context, ownerID int64, offset, limit int) ([]*model.Conversation, int64, error) {
+	conv := c.query.Conversation
+	return conv.WithContext(ctx).Where(
+		conv.OwnerID.Eq(ownerID),
+	).Order(conv.IsPinned.Desc(), conv.LastMsgTime.Desc()).FindByPage(offset, limit)
+}
+
+func (c *ConversationDao) GetConversation(ctx context.Context, ownerID int64, conversationID string) (*model.Conversation, bool, error) {
+	conv := c.query.Conversation
+	res, err := conv.WithContext(ctx).Where(
+		conv.OwnerID.Eq(ownerID),
+		conv.ConversationID.Eq(conversationID),
+	).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	return res, true, nil
+}
+
+func (c *ConversationDao) GetConversations(ctx context.Context, ownerID int64, conversationIDs []string) ([]*model.Conversation, error) {
+	conv := c.query.Conversation
+	return conv.WithContext(ctx).Where(
+		conv.OwnerID.Eq(ownerID),
+		conv.ConversationID.In(conversationIDs...),
+	).Find()
+}
+
+// GetMutedOwnerIDs returns those of ownerIDs who muted the conversation.
+func (c *ConversationDao) GetMutedOwnerIDs(ctx context.Context, conversationID string, ownerIDs []int64) ([]int64, error) {
+	conv := c.query.Conversation
+	var mutedIDs []int64
+	err := conv.WithContext(ctx).Where(
+		conv.ConversationID.Eq(conversationID),
+		conv.OwnerID.In(ownerIDs...),
+		conv.IsMuted.Is(true),
+	).Pluck(conv.OwnerID, &mutedIDs)
+
+	return mutedIDs, err
+}
+
+// GetTotalUnread sums the unread counts of the owner's conversations that are not muted.
+func (c *ConversationDao) GetTotalUnread(ctx context.Context, ownerID int64) (int64, error) {
+	conv := c.query.Conversation
+	var total int64
+	err := conv.WithContext(ctx).Select(
+		field.NewUnsafeFieldRaw("IFNULL(SUM(max_seq - has_read_seq), 0)"),
+	).Where(
+		conv.OwnerID.Eq(ownerID),
+		conv.IsMuted.Is(false),
+	).Scan(&total)
+
+	return total, err
+}
+
+func (c *ConversationDao) UpdateConversation(ctx context.Context, ownerID int64, conversationID string, updates map[string]any) (bool, error) {
+	if _, ok := updates["updated_time"]; !ok {
+		updates["updated_time"] = time.Now().UnixMilli()
+	}
+
+	conv := c.query.Conversation
+	res, err := conv.WithContext(ctx).Where(
+		conv.OwnerID.Eq(ownerID),
+		conv.ConversationID.Eq(conversationID),
+	).Updates(updates)
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected > 0, nil
+}
+
+// UpdateHasReadSeq moves the read cursor forward, never past max_seq and never backwards.
+func (c *ConversationDao) UpdateHasReadSeq(ctx context.Context, ownerID int64, conversationID string, hasReadSeq int64) (bool, error) {
+	conv := c.query.Conversation
+	res, err := conv.WithContext(ctx).Where(
+		conv.OwnerID.Eq(ownerID),
+		conv.ConversationID.Eq(conversationID),
+	).Updates(map[string]any{
+		"has_read_seq": gorm.Expr("GREATEST(has_read_seq, LEAST(?, max_seq))", hasReadSeq),
+		"updated_time": time.Now().UnixMilli(),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected > 0, nil
+}
+
+// UpsertByMessage creates or advances the given conversation rows with a new
+// message. The last message snapshot is only replaced by a newer seq, so
+// updates arriving out of order never move a conversation backwards.
+func (c *ConversationDao) UpsertByMessage(ctx context.Context, conversations []*model.Conversation) error {
+	newer := func(col string) clause.Expr {
+		return gorm.Expr("IF(VALUES(max_seq) > max_seq, VALUES(" + col + "), " + col + ")")
+	}
+
+	conv := c.query.Conversation
+	return conv.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "owner_id"}, {Name: "conversation_id"}},
+		// max_seq must be assigned last since MySQL evaluates the assignments in order.
+		DoUpdates: []clause.Assignment{
+			{Column: clause.Column{Name: "last_msg_id"}, Value: newer("last_msg_id")},
+			{Column: clause.Column{Name: "last_msg_send_id"}, Value: newer("last_msg_send_id")},
+			{Column: clause.Column{Name: "last_msg_content_type"}, Value: newer("last_msg_content_type")},
+			{Column: clause.Column{Name: "last_msg_content"}, Value: newer("last_msg_content")},
+			{Column: clause.Column{Name: "last_msg_time"}, Value: newer("last_msg_time")},
+			{Column: clause.Column{Name: "has_read_seq"}, Value: gorm.Expr("GREATEST(has_read_seq, VALUES(has_read_seq))")},
+			{Column: clause.Column{Name: "updated_time"}, Value: gorm.Expr("VALUES(updated_time)")},
+			{Column: clause.Column{Name: "max_seq"}, Value: gorm.Expr("GREATEST(max_seq, VALUES(max_seq))")},
+		},
+	}).Create(conversations...)
+}
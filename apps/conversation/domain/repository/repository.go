@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/crazyfrankie/goim/apps/conversation/domain/internal/dal"
+	"github.com/crazyfrankie/goim/apps/conversation/domain/internal/dal/model"
+)
+
+type ConversationRepository interface {
+	ListConversations(ctx context.Context, ownerID int64, offset, limit int) ([]*model.Conversation, int64, error)
+	GetConversation(ctx context.Context, ownerID int64, conversationID string) (*model.Conversation, bool, error)
+	GetConversations(ctx context.Context, ownerID int64, conversationIDs []string) ([]*model.Conversation, error)
+	GetMutedOwnerIDs(ctx context.Context, conversationID string, ownerIDs []int64) ([]int64, error)
+	GetTotalUnread(ctx context.Context, ownerID int64) (int64, error)
+	UpdateConversation(ctx context.Context, ownerID int64, conversationID string, updates map[string]any) (bool, error)
+	UpdateHasReadSeq(ctx context.Context, ownerID int64, conversationID string, hasReadSeq int64) (bool, error)
+	UpsertByMessage(ctx context.Context, conversations []*model.Conversation) error
+}
+
+func NewConversationRepository(db *gorm.DB) ConversationRepository {
+	return dal.NewConversationDao(db)
+}
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+
+	"github.com/crazyfrankie/goim/apps/conversation/domain/entity"
+)
+
+type ListConversationsRequest struct {
+	OwnerID int64
+	Page    int32
+	Size    int32
+}
+
+type ListConversationsResponse struct {
+	Conversations    []*entity.Conversation
+	Total            int64
+	TotalUnreadCount int64
+}
+
+type SetConversationRequest struct {
+	OwnerID        int64
+	ConversationID string
+	IsPinned       *bool
+	IsMuted        *bool
+	Draft          *string
+}
+
+type UpdateByMessageRequest struct {
+	ConversationID   string
+	ConversationType int32
+	RecvID           int64
+	GroupID          int64
+	OwnerIDs         []int64 // Users whose conversation receives the message
+	LastMessage      *entity.LastMessage
+}
+
+type Conversation interface {
+	ListConversations(ctx context.Context, req *ListConversationsRequest) (*ListConversationsResponse, error)
+	GetConversation(ctx context.Context, ownerID int64, conversationID string) (*entity.Conversation, error)
+	SetConversation(ctx context.Context, req *SetConversationRequest) error
+	MarkAsRead(ctx context.Context, ownerID int64, conversationID string, hasReadSeq int64) error
+	GetConversations(ctx context.Context, ownerID int64, conversationIDs []string) ([]*entity.Conversation, error)
+	UpdateByMessage(ctx context.Context, req *UpdateByMessageRequest) error
+	GetMutedOwnerIDs(ctx context.Context, conversationID string, ownerIDs []int64) ([]int64, error)
+}
@@ -0,0 +1,204 @@
+package service
+
+import (
+	"context"
+	"time"
+	"unicode/utf8"
+
+	"github.com/crazyfrankie/goim/apps/conversation/domain/entity"
+	"github.com/crazyfrankie/goim/apps/conversation/domain/internal/dal/model"
+	"github.com/crazyfrankie/goim/apps/conversation/domain/repository"
+	"github.com/crazyfrankie/goim/pkg/errorx"
+	langslice "github.com/crazyfrankie/goim/pkg/lang/slice"
+	"github.com/crazyfrankie/goim/types/errno"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+	maxDraftLength  = 1024
+)
+
+type Components struct {
+	ConversationRepo repository.ConversationRepository
+}
+
+type conversationImpl struct {
+	*Components
+}
+
+func NewConversationDomain(c *Components) Conversation {
+	return &conversationImpl{c}
+}
+
+func (c *conversationImpl) ListConversations(ctx context.Context, req *ListConversationsRequest) (*ListConversationsResponse, error) {
+	page, size := int(req.Page), int(req.Size)
+	if page < 1 {
+		page = 1
+	}
+	if size <= 0 {
+		size = defaultPageSize
+	}
+	size = min(size, maxPageSize)
+
+	convs, total, err := c.ConversationRepo.ListConversations(ctx, req.OwnerID, (page-1)*size, size)
+	if err != nil {
+		return nil, err
+	}
+
+	unread, err := c.ConversationRepo.GetTotalUnread(ctx, req.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListConversationsResponse{
+		Conversations:    langslice.Transform(convs, conversationPO2DO),
+		Total:            total,
+		TotalUnreadCount: unread,
+	}, nil
+}
+
+func (c *conversationImpl) GetConversation(ctx context.Context, ownerID int64, conversationID string) (*entity.Conversation, error) {
+	conv, exist, err := c.ConversationRepo.GetConversation(ctx, ownerID, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	if !exist {
+		return nil, errorx.New(errno.ErrConversationNotFoundCode, errorx.KV("conversation_id", conversationID))
+	}
+
+	return conversationPO2DO(conv), nil
+}
+
+func (c *conversationImpl) SetConversation(ctx context.Context, req *SetConversationRequest) error {
+	updates := make(map[string]any)
+	if req.IsPinned != nil {
+		updates["is_pinned"] = *req.IsPinned
+	}
+	if req.IsMuted != nil {
+		updates["is_muted"] = *req.IsMuted
+	}
+	if req.Draft != nil {
+		if utf8.RuneCountInString(*req.Draft) > maxDraftLength {
+			return errorx.New(errno.ErrConversationInvalidParamCode, errorx.KV("msg", "draft is too long"))
+		}
+		updates["draft"] = *req.Draft
+		updates["draft_time"] = time.Now().UnixMilli()
+	}
+	if len(updates) == 0 {
+		return nil
+	}
+
+	ok, err := c.ConversationRepo.UpdateConversation(ctx, req.OwnerID, req.ConversationID, updates)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errorx.New(errno.ErrConversationNotFoundCode, errorx.KV("conversation_id", req.ConversationID))
+	}
+
+	return nil
+}
+
+func (c *conversationImpl) MarkAsRead(ctx context.Context, ownerID int64, conversationID string, hasReadSeq int64) error {
+	if hasReadSeq < 0 {
+		return errorx.New(errno.ErrConversationInvalidParamCode, errorx.KV("msg", "has read seq must not be negative"))
+	}
+
+	ok, err := c.ConversationRepo.UpdateHasReadSeq(ctx, ownerID, conversationID, hasReadSeq)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errorx.New(errno.ErrConversationNotFoundCode, errorx.KV("conversation_id", conversationID))
+	}
+
+	return nil
+}
+
+func (c *conversationImpl) GetConversations(ctx context.Context, ownerID int64, conversationIDs []string) ([]*entity.Conversation, error) {
+	if len(conversationIDs) == 0 {
+		return nil, nil
+	}
+
+	convs, err := c.ConversationRepo.GetConversations(ctx, ownerID, conversationIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return langslice.Transform(convs, conversationPO2DO), nil
+}
+
+func (c *conversationImpl) UpdateByMessage(ctx context.Context, req *UpdateByMessageRequest) error {
+	if len(req.OwnerIDs) == 0 || req.LastMessage == nil {
+		return nil
+	}
+
+	now := time.Now().UnixMilli()
+	msg := req.LastMessage
+	convs := make([]*model.Conversation, 0, len(req.OwnerIDs))
+	for _, ownerID := range langslice.Unique(req.OwnerIDs) {
+		conv := &model.Conversation{
+			OwnerID:            ownerID,
+			ConversationID:     req.ConversationID,
+			ConversationType:   req.ConversationType,
+			GroupID:            req.GroupID,
+			MaxSeq:             msg.Seq,
+			LastMsgID:          msg.MsgID,
+			LastMsgSendID:      msg.SendID,
+			LastMsgContentType: msg.ContentType,
+			LastMsgContent:     msg.Content,
+			LastMsgTime:        msg.SendTime,
+			CreatedTime:        now,
+			UpdatedTime:        now,
+		}
+		if req.GroupID == 0 {
+			// In a single chat the peer is whoever is not the owner.
+			conv.PeerID = req.RecvID
+			if ownerID == req.RecvID {
+				conv.PeerID = msg.SendID
+			}
+		}
+		if ownerID == msg.SendID {
+			// Your own messages are read by definition.
+			conv.HasReadSeq = msg.Seq
+		}
+		convs = append(convs, conv)
+	}
+
+	return c.ConversationRepo.UpsertByMessage(ctx, convs)
+}
+
+func (c *conversationImpl) GetMutedOwnerIDs(ctx context.Context, conversationID string, ownerIDs []int64) ([]int64, error) {
+	if len(ownerIDs) == 0 {
+		return nil, nil
+	}
+
+	return c.ConversationRepo.GetMutedOwnerIDs(ctx, conversationID, langslice.Unique(ownerIDs))
+}
+
+func conversationPO2DO(po *model.Conversation) *entity.Conversation {
+	return &entity.Conversation{
+		ConversationID:   po.ConversationID,
+		OwnerID:          po.OwnerID,
+		ConversationType: po.ConversationType,
+		PeerID:           po.PeerID,
+		GroupID:          po.GroupID,
+		MaxSeq:           po.MaxSeq,
+		HasReadSeq:       po.HasReadSeq,
+		LastMessage: &entity.LastMessage{
+			MsgID:       po.LastMsgID,
+			SendID:      po.LastMsgSendID,
+			ContentType: po.LastMsgContentType,
+			Content:     po.LastMsgContent,
+			Seq:         po.MaxSeq,
+			SendTime:    po.LastMsgTime,
+		},
+		IsPinned:    po.IsPinned,
+		IsMuted:     po.IsMuted,
+		Draft:       po.Draft,
+		DraftTime:   po.DraftTime,
+		CreatedTime: po.CreatedTime,
+		UpdatedTime: po.UpdatedTime,
+	}
+}
@@ -0,0 +1,30 @@
+package conversation
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"github.com/crazyfrankie/goim/apps/conversation/application"
+	"github.com/crazyfrankie/goim/apps/conversation/domain/repository"
+	"github.com/crazyfrankie/goim/apps/conversation/domain/service"
+	"github.com/crazyfrankie/goim/infra/contract/discovery"
+	conversationv1 "github.com/crazyfrankie/goim/protocol/conversation/v1"
+)
+
+func Start(ctx context.Context, client discovery.SvcDiscoveryRegistry, srv grpc.ServiceRegistrar) error {
+	basic, err := application.Init(ctx, client)
+	if err != nil {
+		return err
+	}
+	conversationRepo := repository.NewConversationRepository(basic.DB)
+	conversationDomain := service.NewConversationDomain(&service.Components{
+		ConversationRepo: conversationRepo,
+	})
+	appService := application.NewConversationApplicationService(conversationDomain)
+
+	conversationv1.RegisterConversationServiceServer(srv, appService)
+	conversationv1.RegisterConversationInternalServiceServer(srv, application.NewConversationInternalApplicationService(conversationDomain))
+
+	return nil
+}
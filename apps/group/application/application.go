@@ -0,0 +1,43 @@
+package application
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/crazyfrankie/goim/infra/contract/discovery"
+	"github.com/crazyfrankie/goim/infra/contract/idgen"
+	"github.com/crazyfrankie/goim/infra/contract/storage"
+	"github.com/crazyfrankie/goim/infra/impl/cache/redis"
+	idgenimpl "github.com/crazyfrankie/goim/infra/impl/idgen"
+	"github.com/crazyfrankie/goim/infra/impl/mysql"
+	storageimpl "github.com/crazyfrankie/goim/infra/impl/storage"
+)
+
+type BasicServices struct {
+	DB      *gorm.DB
+	IDGen   idgen.IDGenerator
+	IconOSS storage.Storage
+}
+
+func Init(ctx context.Context, client discovery.SvcDiscoveryRegistry) (*BasicServices, error) {
+	basic := &BasicServices{}
+	var err error
+
+	basic.DB, err = mysql.New("MYSQL_DSN")
+	if err != nil {
+		return nil, err
+	}
+
+	basic.IDGen, err = idgenimpl.New(redis.New())
+	if err != nil {
+		return nil, err
+	}
+
+	basic.IconOSS, err = storageimpl.New(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return basic, nil
+}
@@ -0,0 +1,229 @@
+package application
+
+import (
+	"context"
+
+	"github.com/crazyfrankie/goim/apps/group/domain/entity"
+	group "github.com/crazyfrankie/goim/apps/group/domain/service"
+	"github.com/crazyfrankie/goim/pkg/errorx"
+	"github.com/crazyfrankie/goim/pkg/grpc/ctxutil"
+	langslice "github.com/crazyfrankie/goim/pkg/lang/slice"
+	groupv1 "github.com/crazyfrankie/goim/protocol/group/v1"
+	"github.com/crazyfrankie/goim/types/errno"
+)
+
+type GroupApplicationService struct {
+	groupDomain group.Group
+	groupv1.UnimplementedGroupServiceServer
+}
+
+func NewGroupApplicationService(groupDomain group.Group) groupv1.GroupServiceServer {
+	return &GroupApplicationService{groupDomain: groupDomain}
+}
+
+func (g *GroupApplicationService) CreateGroup(ctx context.Context, req *groupv1.CreateGroupRequest) (*groupv1.CreateGroupResponse, error) {
+	res, err := g.groupDomain.CreateGroup(ctx, &group.CreateGroupRequest{
+		OwnerID:   ctxutil.MustGetUserIDFromCtx(ctx),
+		Name:      req.GetName(),
+		Notice:    req.GetNotice(),
+		MemberIDs: req.GetMemberIDs(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &groupv1.CreateGroupResponse{Data: groupDO2DTO(res)}, nil
+}
+
+func (g *GroupApplicationService) DismissGroup(ctx context.Context, req *groupv1.DismissGroupRequest) (*groupv1.DismissGroupResponse, error) {
+	err := g.groupDomain.DismissGroup(ctx, ctxutil.MustGetUserIDFromCtx(ctx), req.GetGroupID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &groupv1.DismissGroupResponse{}, nil
+}
+
+func (g *GroupApplicationService) InviteToGroup(ctx context.Context, req *groupv1.InviteToGroupRequest) (*groupv1.InviteToGroupResponse, error) {
+	err := g.groupDomain.InviteMembers(ctx, ctxutil.MustGetUserIDFromCtx(ctx), req.GetGroupID(), req.GetUserIDs())
+	if err != nil {
+		return nil, err
+	}
+
+	return &groupv1.InviteToGroupResponse{}, nil
+}
+
+func (g *GroupApplicationService) KickGroupMembers(ctx context.Context, req *groupv1.KickGroupMembersRequest) (*groupv1.KickGroupMembersResponse, error) {
+	err := g.groupDomain.KickMembers(ctx, ctxutil.MustGetUserIDFromCtx(ctx), req.GetGroupID(), req.GetUserIDs())
+	if err != nil {
+		return nil, err
+	}
+
+	return &groupv1.KickGroupMembersResponse{}, nil
+}
+
+func (g *GroupApplicationService) QuitGroup(ctx context.Context, req *groupv1.QuitGroupRequest) (*groupv1.QuitGroupResponse, error) {
+	err := g.groupDomain.QuitGroup(ctx, ctxutil.MustGetUserIDFromCtx(ctx), req.GetGroupID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &groupv1.QuitGroupResponse{}, nil
+}
+
+func (g *GroupApplicationService) TransferGroupOwner(ctx context.Context, req *groupv1.TransferGroupOwnerRequest) (*groupv1.TransferGroupOwnerResponse, error) {
+	err := g.groupDomain.TransferOwner(ctx, ctxutil.MustGetUserIDFromCtx(ctx), req.GetGroupID(), req.GetNewOwnerID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &groupv1.TransferGroupOwnerResponse{}, nil
+}
+
+func (g *GroupApplicationService) SetGroupMemberRole(ctx context.Context, req *groupv1.SetGroupMemberRoleRequest) (*groupv1.SetGroupMemberRoleResponse, error) {
+	err := g.groupDomain.SetMemberRole(ctx, ctxutil.MustGetUserIDFromCtx(ctx), req.GetGroupID(), req.GetUserID(), int32(req.GetRole()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &groupv1.SetGroupMemberRoleResponse{}, nil
+}
+
+func (g *GroupApplicationService) GetGroups(ctx context.Context, req *groupv1.GetGroupsRequest) (*groupv1.GetGroupsResponse, error) {
+	groups, err := g.groupDomain.GetGroups(ctx, req.GetGroupIDs())
+	if err != nil {
+		return nil, err
+	}
+
+	return &groupv1.GetGroupsResponse{Groups: langslice.Transform(groups, groupDO2DTO)}, nil
+}
+
+func (g *GroupApplicationService) ListJoinedGroups(ctx context.Context, req *groupv1.ListJoinedGroupsRequest) (*groupv1.ListJoinedGroupsResponse, error) {
+	res, err := g.groupDomain.ListJoinedGroups(ctx, &group.ListJoinedGroupsRequest{
+		UserID: ctxutil.MustGetUserIDFromCtx(ctx),
+		Page:   req.GetPage(),
+		Size:   req.GetSize(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &groupv1.ListJoinedGroupsResponse{
+		Groups: langslice.Transform(res.Groups, groupDO2DTO),
+		Total:  res.Total,
+	}, nil
+}
+
+func (g *GroupApplicationService) SetGroupInfo(ctx context.Context, req *groupv1.SetGroupInfoRequest) (*groupv1.SetGroupInfoResponse, error) {
+	err := g.groupDomain.SetGroupInfo(ctx, &group.SetGroupInfoRequest{
+		OperatorID: ctxutil.MustGetUserIDFromCtx(ctx),
+		GroupID:    req.GetGroupID(),
+		Name:       req.Name,
+		Notice:     req.Notice,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &groupv1.SetGroupInfoResponse{}, nil
+}
+
+func (g *GroupApplicationService) UpdateGroupAvatar(ctx context.Context, req *groupv1.UpdateGroupAvatarRequest) (*groupv1.UpdateGroupAvatarResponse, error) {
+	var ext string
+	switch req.GetMimeType() {
+	case "image/jpeg", "image/jpg":
+		ext = "jpg"
+	case "image/png":
+		ext = "png"
+	case "image/gif":
+		ext = "gif"
+	case "image/webp":
+		ext = "webp"
+	default:
+		return nil, errorx.New(errno.ErrGroupInvalidParamCode, errorx.KV("msg", "unsupported image type"))
+	}
+
+	url, err := g.groupDomain.UpdateAvatar(ctx, &group.UpdateAvatarRequest{
+		OperatorID: ctxutil.MustGetUserIDFromCtx(ctx),
+		GroupID:    req.GetGroupID(),
+		Ext:        ext,
+		Avatar:     req.GetAvatar(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &groupv1.UpdateGroupAvatarResponse{AvatarUrl: url}, nil
+}
+
+func (g *GroupApplicationService) ListGroupMembers(ctx context.Context, req *groupv1.ListGroupMembersRequest) (*groupv1.ListGroupMembersResponse, error) {
+	res, err := g.groupDomain.ListMembers(ctx, &group.ListMembersRequest{
+		OperatorID: ctxutil.MustGetUserIDFromCtx(ctx),
+		GroupID:    req.GetGroupID(),
+		Page:       req.GetPage(),
+		Size:       req.GetSize(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &groupv1.ListGroupMembersResponse{
+		Members: langslice.Transform(res.Members, memberDO2DTO),
+		Total:   res.Total,
+	}, nil
+}
+
+func (g *GroupApplicationService) GetGroupMembers(ctx context.Context, req *groupv1.GetGroupMembersRequest) (*groupv1.GetGroupMembersResponse, error) {
+	members, err := g.groupDomain.GetMembers(ctx, ctxutil.MustGetUserIDFromCtx(ctx), req.GetGroupID(), req.GetUserIDs())
+	if err != nil {
+		return nil, err
+	}
+
+	return &groupv1.GetGroupMembersResponse{Members: langslice.Transform(members, memberDO2DTO)}, nil
+}
+
+// GetGroupMemberIDs lists every member of the group, it fails unless the
+// caller is a member itself. The message service relies on this to check
+// the sender of a group message and to fan the message out.
+func (g *GroupApplicationService) GetGroupMemberIDs(ctx context.Context, req *groupv1.GetGroupMemberIDsRequest) (*groupv1.GetGroupMemberIDsResponse, error) {
+	memberIDs, err := g.groupDomain.GetMemberIDs(ctx, ctxutil.MustGetUserIDFromCtx(ctx), req.GetGroupID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &groupv1.GetGroupMemberIDsResponse{MemberIDs: memberIDs}, nil
+}
+
+// GetJoinedGroupIDs returns those of the groups the caller is a member of, so
+// the message service can check access to many group conversations at once.
+func (g *GroupApplicationService) GetJoinedGroupIDs(ctx context.Context, req *groupv1.GetJoinedGroupIDsRequest) (*groupv1.GetJoinedGroupIDsResponse, error) {
+	groupIDs, err := g.groupDomain.GetJoinedGroupIDs(ctx, ctxutil.MustGetUserIDFromCtx(ctx), req.GetGroupIDs())
+	if err != nil {
+		return nil, err
+	}
+
+	return &groupv1.GetJoinedGroupIDsResponse{GroupIDs: groupIDs}, nil
+}
+
+func groupDO2DTO(groupDo *entity.Group) *groupv1.Group {
+	return &groupv1.Group{
+		GroupID:     groupDo.GroupID,
+		Name:        groupDo.Name,
+		AvatarUrl:   groupDo.IconURL,
+		Notice:      groupDo.Notice,
+		OwnerID:     groupDo.OwnerID,
+		MemberCount: groupDo.MemberCount,
+		CreateTime:  groupDo.CreatedAt,
+		UpdateTime:  groupDo.UpdatedAt,
+	}
+}
+
+func memberDO2DTO(member *entity.GroupMember) *groupv1.GroupMember {
+	return &groupv1.GroupMember{
+		GroupID:   member.GroupID,
+		UserID:    member.UserID,
+		Role:      groupv1.GroupRole(member.Role),
+		InviterID: member.InviterID,
+		JoinTime:  member.JoinTime,
+	}
+}
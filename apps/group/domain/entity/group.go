@@ -0,0 +1,36 @@
+package entity
+
+const (
+	GroupStatusNormal int32 = iota
+	GroupStatusDismissed
+)
+
+// Member roles, ordered so that a higher role may manage every lower one.
+const (
+	RoleMember int32 = iota
+	RoleAdmin
+	RoleOwner
+)
+
+type Group struct {
+	GroupID int64
+
+	Name        string // group name
+	IconURI     string // avatar URI
+	IconURL     string // avatar URL
+	Notice      string // group notice
+	OwnerID     int64  // owner user ID
+	MemberCount int32  // number of members, the owner included
+	Status      int32  // group status
+
+	CreatedAt int64 // creation time
+	UpdatedAt int64 // update time
+}
+
+type GroupMember struct {
+	GroupID   int64
+	UserID    int64
+	Role      int32 // member role
+	InviterID int64 // user who brought the member in
+	JoinTime  int64 // join time
+}
@@ -0,0 +1,280 @@
+package dal
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/crazyfrankie/goim/apps/group/domain/entity"
+	"github.com/crazyfrankie/goim/apps/group/domain/internal/dal/model"
+	"github.com/crazyfrankie/goim/apps/group/domain/internal/dal/query"
+)
+
+type GroupDao struct {
+	query *query.Query
+}
+
+func NewGroupDao(db *gorm.DB) *GroupDao {
+	return &GroupDao{query: query.Use(db)}
+}
+
+// CreateGroup stores the group together with its initial members.
+func (g *GroupDao) CreateGroup(ctx context.Context, group *model.GroupInfo, members []*model.GroupMember) error {
+	return g.query.Transaction(func(tx *query.Query) error {
+		group.MemberCount = int32(len(members))
+		if err := tx.GroupInfo.WithContext(ctx).Create(group); err != nil {
+			return err
+		}
+
+		return tx.GroupMember.WithContext(ctx).Create(members...)
+	})
+}
+
+func (g *GroupDao) GetGroup(ctx context.Context, groupID int64) (*model.GroupInfo, bool, error) {
+	info := g.query.GroupInfo
+	res, err := info.WithContext(ctx).Where(info.ID.Eq(groupID)).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	return res, true, nil
+}
+
+// GetGroups returns the groups that still exist among groupIDs.
+func (g *GroupDao) GetGroups(ctx context.Context, groupIDs []int64) ([]*model.GroupInfo, error) {
+	info := g.query.GroupInfo
+	return info.WithContext(ctx).Where(
+		info.ID.In(groupIDs...),
+		info.Status.Eq(entity.GroupStatusNormal),
+	).Find()
+}
+
+func (g *GroupDao) UpdateGroup(ctx context.Context, groupID int64, updates map[string]any) (bool, error) {
+	info := g.query.GroupInfo
+	res, err := info.WithContext(ctx).Where(
+		info.ID.Eq(groupID),
+		info.Status.Eq(entity.GroupStatusNormal),
+	).Updates(updates)
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected > 0, nil
+}
+
+// DismissGroup marks the group as dismissed and removes all of its members.
+func (g *GroupDao) DismissGroup(ctx context.Context, groupID int64) error {
+	return g.query.Transaction(func(tx *query.Query) error {
+		info := tx.GroupInfo
+		_, err := info.WithContext(ctx).Where(info.ID.Eq(groupID)).Updates(map[string]any{
+			info.Status.ColumnName().String():      entity.GroupStatusDismissed,
+			info.MemberCount.ColumnName().String(): 0,
+		})
+		if err != nil {
+			return err
+		}
+
+		member := tx.GroupMember
+		_, err = member.WithContext(ctx).Where(member.GroupID.Eq(groupID)).Delete()
+
+		return err
+	})
+}
+
+func (g *GroupDao) GetMember(ctx context.Context, groupID, userID int64) (*model.GroupMember, bool, error) {
+	member := g.query.GroupMember
+	res, err := member.WithContext(ctx).Where(
+		member.GroupID.Eq(groupID),
+		member.UserID.Eq(userID),
+	).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	return res, true, nil
+}
+
+func (g *GroupDao) GetMembers(ctx context.Context, groupID int64, userIDs []int64) ([]*model.GroupMember, error) {
+	member := g.query.GroupMember
+	return member.WithContext(ctx).Where(
+		member.GroupID.Eq(groupID),
+		member.UserID.In(userIDs...),
+	).Find()
+}
+
+// ListMembers returns a page of the group members, owner and admins first and
+// then in join order.
+func (g *GroupDao) ListMembers(ctx context.Context, groupID int64, offset, limit int) ([]*model.GroupMember, int64, error) {
+	member := g.query.GroupMember
+	return member.WithContext(ctx).Where(
+		member.GroupID.Eq(groupID),
+	).Order(member.Role.Desc(), member.ID).FindByPage(offset, limit)
+}
+
+func (g *GroupDao) GetMemberIDs(ctx context.Context, groupID int64) ([]int64, error) {
+	member := g.query.GroupMember
+	var userIDs []int64
+	err := member.WithContext(ctx).Where(member.GroupID.Eq(groupID)).Pluck(member.UserID, &userIDs)
+
+	return userIDs, err
+}
+
+// GetJoinedGroupIDs returns those of groupIDs the user is a member of.
+func (g *GroupDao) GetJoinedGroupIDs(ctx context.Context, userID int64, groupIDs []int64) ([]int64, error) {
+	member := g.query.GroupMember
+	var joined []int64
+	err := member.WithContext(ctx).Where(
+		member.UserID.Eq(userID),
+		member.GroupID.In(groupIDs...),
+	).Pluck(member.GroupID, &joined)
+
+	return joined, err
+}
+
+// ListJoinedGroupIDs returns a page of the groups the user is in, the most
+// recently joined first.
+func (g *GroupDao) ListJoinedGroupIDs(ctx context.Context, userID int64, offset, limit int) ([]int64, int64, error) {
+	member := g.query.GroupMember
+	res, total, err := member.WithContext(ctx).Where(
+		member.UserID.Eq(userID),
+	).Order(member.ID.Desc()).FindByPage(offset, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	groupIDs := make([]int64, 0, len(res))
+	for _, m := range res {
+		groupIDs = append(groupIDs, m.GroupID)
+	}
+
+	return groupIDs, total, nil
+}
+
+// AddMembers inserts the members that are not in the group yet and returns how
+// many were added. The group row is locked so that concurrent invitations can
+// not push the group past maxCount, in which case full is true and nothing is added.
+func (g *GroupDao) AddMembers(ctx context.Context, groupID int64, members []*model.GroupMember, maxCount int32) (added int64, full bool, err error) {
+	err = g.query.Transaction(func(tx *query.Query) error {
+		info := tx.GroupInfo
+		group, err := info.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Where(
+			info.ID.Eq(groupID),
+			info.Status.Eq(entity.GroupStatusNormal),
+		).First()
+		if err != nil {
+			return err
+		}
+		if int(group.MemberCount)+len(members) > int(maxCount) {
+			full = true
+			return nil
+		}
+
+		member := tx.GroupMember
+		res := member.WithContext(ctx).UnderlyingDB().Clauses(clause.OnConflict{DoNothing: true}).Create(members)
+		if res.Error != nil {
+			return res.Error
+		}
+		added = res.RowsAffected
+
+		_, err = info.WithContext(ctx).Where(info.ID.Eq(groupID)).Update(info.MemberCount, info.MemberCount.Add(int32(added)))
+
+		return err
+	})
+
+	return added, full, err
+}
+
+// RemoveMembers deletes the given members, the owner is never removed.
+func (g *GroupDao) RemoveMembers(ctx context.Context, groupID int64, userIDs []int64) (int64, error) {
+	var removed int64
+	err := g.query.Transaction(func(tx *query.Query) error {
+		member := tx.GroupMember
+		res, err := member.WithContext(ctx).Where(
+			member.GroupID.Eq(groupID),
+			member.UserID.In(userIDs...),
+			member.Role.Neq(entity.RoleOwner),
+		).Delete()
+		if err != nil {
+			return err
+		}
+		removed = res.RowsAffected
+		if removed == 0 {
+			return nil
+		}
+
+		info := tx.GroupInfo
+		_, err = info.WithContext(ctx).Where(info.ID.Eq(groupID)).Update(info.MemberCount, info.MemberCount.Sub(int32(removed)))
+
+		return err
+	})
+
+	return removed, err
+}
+
+func (g *GroupDao) UpdateMemberRole(ctx context.Context, groupID, userID int64, role int32) (bool, error) {
+	member := g.query.GroupMember
+	res, err := member.WithContext(ctx).Where(
+		member.GroupID.Eq(groupID),
+		member.UserID.Eq(userID),
+		member.Role.Neq(entity.RoleOwner),
+	).Update(member.Role, role)
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected > 0, nil
+}
+
+// TransferOwner hands the group from oldOwnerID over to newOwnerID, the
+// previous owner stays in the group as an ordinary member. It reports false
+// when oldOwnerID no longer owns the group.
+func (g *GroupDao) TransferOwner(ctx context.Context, groupID, oldOwnerID, newOwnerID int64) (bool, error) {
+	var ok bool
+	err := g.query.Transaction(func(tx *query.Query) error {
+		info := tx.GroupInfo
+		res, err := info.WithContext(ctx).Where(
+			info.ID.Eq(groupID),
+			info.OwnerID.Eq(oldOwnerID),
+			info.Status.Eq(entity.GroupStatusNormal),
+		).Update(info.OwnerID, newOwnerID)
+		if err != nil {
+			return err
+		}
+		if res.RowsAffected == 0 {
+			return nil
+		}
+
+		member := tx.GroupMember
+		res, err = member.WithContext(ctx).Where(
+			member.GroupID.Eq(groupID),
+			member.UserID.Eq(newOwnerID),
+		).Update(member.Role, entity.RoleOwner)
+		if err != nil {
+			return err
+		}
+		if res.RowsAffected == 0 {
+			// The new owner left in the meantime.
+			return gorm.ErrRecordNotFound
+		}
+
+		_, err = member.WithContext(ctx).Where(
+			member.GroupID.Eq(groupID),
+			member.UserID.Eq(oldOwnerID),
+		).Update(member.Role, entity.RoleMember)
+		if err != nil {
+			return err
+		}
+		ok = true
+
+		return nil
+	})
+
+	return ok, err
+}
@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/crazyfrankie/goim/apps/group/domain/internal/dal"
+	"github.com/crazyfrankie/goim/apps/group/domain/internal/dal/model"
+)
+
+type GroupRepository interface {
+	CreateGroup(ctx context.Context, group *model.GroupInfo, members []*model.GroupMember) error
+	GetGroup(ctx context.Context, groupID int64) (*model.GroupInfo, bool, error)
+	GetGroups(ctx context.Context, groupIDs []int64) ([]*model.GroupInfo, error)
+	UpdateGroup(ctx context.Context, groupID int64, updates map[string]any) (bool, error)
+	DismissGroup(ctx context.Context, groupID int64) error
+	GetMember(ctx context.Context, groupID, userID int64) (*model.GroupMember, bool, error)
+	GetMembers(ctx context.Context, groupID int64, userIDs []int64) ([]*model.GroupMember, error)
+	ListMembers(ctx context.Context, groupID int64, offset, limit int) ([]*model.GroupMember, int64, error)
+	GetMemberIDs(ctx context.Context, groupID int64) ([]int64, error)
+	GetJoinedGroupIDs(ctx context.Context, userID int64, groupIDs []int64) ([]int64, error)
+	ListJoinedGroupIDs(ctx context.Context, userID int64, offset, limit int) ([]int64, int64, error)
+	AddMembers(ctx context.Context, groupID int64, members []*model.GroupMember, maxCount int32) (added int64, full bool, err error)
+	RemoveMembers(ctx context.Context, groupID int64, userIDs []int64) (int64, error)
+	UpdateMemberRole(ctx context.Context, groupID, userID int64, role int32) (bool, error)
+	TransferOwner(ctx context.Context, groupID, oldOwnerID, newOwnerID int64) (bool, error)
+}
+
+func NewGroupRepository(db *gorm.DB) GroupRepository {
+	return dal.NewGroupDao(db)
+}
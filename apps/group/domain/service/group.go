@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+
+	"github.com/crazyfrankie/goim/apps/group/domain/entity"
+)
+
+type CreateGroupRequest struct {
+	OwnerID   int64
+	Name      string
+	Notice    string
+	MemberIDs []int64 // Initial members besides the owner
+}
+
+type SetGroupInfoRequest struct {
+	OperatorID int64
+	GroupID    int64
+	Name       *string
+	Notice     *string
+}
+
+type UpdateAvatarRequest struct {
+	OperatorID int64
+	GroupID    int64
+	Ext        string
+	Avatar     []byte
+}
+
+type ListMembersRequest struct {
+	OperatorID int64
+	GroupID    int64
+	Page       int32
+	Size       int32
+}
+
+type ListMembersResponse struct {
+	Members []*entity.GroupMember
+	Total   int64
+}
+
+type ListJoinedGroupsRequest struct {
+	UserID int64
+	Page   int32
+	Size   int32
+}
+
+type ListJoinedGroupsResponse struct {
+	Groups []*entity.Group
+	Total  int64
+}
+
+// Group manages groups and their members. Every operation is performed on
+// behalf of an operator, whose role in the group decides whether it is allowed.
+type Group interface {
+	CreateGroup(ctx context.Context, req *CreateGroupRequest) (*entity.Group, error)
+	DismissGroup(ctx context.Context, operatorID, groupID int64) error
+	InviteMembers(ctx context.Context, operatorID, groupID int64, userIDs []int64) error
+	KickMembers(ctx context.Context, operatorID, groupID int64, userIDs []int64) error
+	QuitGroup(ctx context.Context, userID, groupID int64) error
+	TransferOwner(ctx context.Context, operatorID, groupID, newOwnerID int64) error
+	SetMemberRole(ctx context.Context, operatorID, groupID, userID int64, role int32) error
+	GetGroups(ctx context.Context, groupIDs []int64) ([]*entity.Group, error)
+	ListJoinedGroups(ctx context.Context, req *ListJoinedGroupsRequest) (*ListJoinedGroupsResponse, error)
+	SetGroupInfo(ctx context.Context, req *SetGroupInfoRequest) error
+	UpdateAvatar(ctx context.Context, req *UpdateAvatarRequest) (url string, err error)
+	ListMembers(ctx context.Context, req *ListMembersRequest) (*ListMembersResponse, error)
+	GetMembers(ctx context.Context, operatorID, groupID int64, userIDs []int64) ([]*entity.GroupMember, error)
+	GetMemberIDs(ctx context.Context, operatorID, groupID int64) ([]int64, error)
+	GetJoinedGroupIDs(ctx context.Context, userID int64, groupIDs []int64) ([]int64, error)
+}
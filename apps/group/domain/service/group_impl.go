@@ -0,0 +1,539 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"unicode/utf8"
+
+	"gorm.io/gorm"
+
+	"github.com/crazyfrankie/goim/apps/group/domain/entity"
+	"github.com/crazyfrankie/goim/apps/group/domain/internal/dal/model"
+	"github.com/crazyfrankie/goim/apps/group/domain/repository"
+	"github.com/crazyfrankie/goim/infra/contract/idgen"
+	"github.com/crazyfrankie/goim/infra/contract/storage"
+	"github.com/crazyfrankie/goim/pkg/errorx"
+	"github.com/crazyfrankie/goim/pkg/lang/conv"
+	langslice "github.com/crazyfrankie/goim/pkg/lang/slice"
+	"github.com/crazyfrankie/goim/types/errno"
+)
+
+const (
+	defaultPageSize    = 20
+	maxPageSize        = 100
+	maxGroupMembers    = 2000
+	maxGroupNameLength = 64
+	maxNoticeLength    = 1024
+)
+
+type Components struct {
+	GroupRepo repository.GroupRepository
+	IDGen     idgen.IDGenerator
+	IconOSS   storage.Storage
+}
+
+type groupImpl struct {
+	*Components
+}
+
+func NewGroupDomain(c *Components) Group {
+	return &groupImpl{c}
+}
+
+func (g *groupImpl) CreateGroup(ctx context.Context, req *CreateGroupRequest) (*entity.Group, error) {
+	if err := validateName(req.Name); err != nil {
+		return nil, err
+	}
+	if err := validateNotice(req.Notice); err != nil {
+		return nil, err
+	}
+
+	memberIDs := excludeUser(langslice.Unique(req.MemberIDs), req.OwnerID)
+	if len(memberIDs)+1 > maxGroupMembers {
+		return nil, errorx.New(errno.ErrGroupMemberLimitCode, errorx.KV("limit", strconv.Itoa(maxGroupMembers)))
+	}
+
+	groupID, err := g.IDGen.GenID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	group := &model.GroupInfo{
+		ID:      groupID,
+		Name:    req.Name,
+		Notice:  req.Notice,
+		OwnerID: req.OwnerID,
+		Status:  entity.GroupStatusNormal,
+	}
+	members := make([]*model.GroupMember, 0, len(memberIDs)+1)
+	members = append(members, &model.GroupMember{
+		GroupID: groupID,
+		UserID:  req.OwnerID,
+		Role:    entity.RoleOwner,
+	})
+	for _, userID := range memberIDs {
+		members = append(members, &model.GroupMember{
+			GroupID:   groupID,
+			UserID:    userID,
+			Role:      entity.RoleMember,
+			InviterID: req.OwnerID,
+		})
+	}
+
+	if err := g.GroupRepo.CreateGroup(ctx, group, members); err != nil {
+		return nil, err
+	}
+
+	return groupPO2DO(group, ""), nil
+}
+
+func (g *groupImpl) DismissGroup(ctx context.Context, operatorID, groupID int64) error {
+	_, operator, err := g.getOperator(ctx, groupID, operatorID)
+	if err != nil {
+		return err
+	}
+	if operator.Role != entity.RoleOwner {
+		return errorx.New(errno.ErrGroupPermissionDeniedCode, errorx.KV("msg", "only the owner can dismiss the group"))
+	}
+
+	return g.GroupRepo.DismissGroup(ctx, groupID)
+}
+
+func (g *groupImpl) InviteMembers(ctx context.Context, operatorID, groupID int64, userIDs []int64) error {
+	userIDs = excludeUser(langslice.Unique(userIDs), operatorID)
+	if len(userIDs) == 0 {
+		return errorx.New(errno.ErrGroupInvalidParamCode, errorx.KV("msg", "no user to invite"))
+	}
+	if len(userIDs) > maxGroupMembers {
+		return errorx.New(errno.ErrGroupMemberLimitCode, errorx.KV("limit", strconv.Itoa(maxGroupMembers)))
+	}
+
+	if _, _, err := g.getOperator(ctx, groupID, operatorID); err != nil {
+		return err
+	}
+
+	// Users already in the group are skipped, so they do not count against the limit.
+	existing, err := g.GroupRepo.GetMembers(ctx, groupID, userIDs)
+	if err != nil {
+		return err
+	}
+	joined := make(map[int64]bool, len(existing))
+	for _, m := range existing {
+		joined[m.UserID] = true
+	}
+
+	members := make([]*model.GroupMember, 0, len(userIDs))
+	for _, userID := range userIDs {
+		if joined[userID] {
+			continue
+		}
+		members = append(members, &model.GroupMember{
+			GroupID:   groupID,
+			UserID:    userID,
+			Role:      entity.RoleMember,
+			InviterID: operatorID,
+		})
+	}
+	if len(members) == 0 {
+		return nil
+	}
+
+	_, full, err := g.GroupRepo.AddMembers(ctx, groupID, members, maxGroupMembers)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return errorx.New(errno.ErrGroupNotFoundCode, errorx.KV("group_id", conv.Int64ToStr(groupID)))
+	}
+	if err != nil {
+		return err
+	}
+	if full {
+		return errorx.New(errno.ErrGroupMemberLimitCode, errorx.KV("limit", strconv.Itoa(maxGroupMembers)))
+	}
+
+	return nil
+}
+
+func (g *groupImpl) KickMembers(ctx context.Context, operatorID, groupID int64, userIDs []int64) error {
+	userIDs = langslice.Unique(userIDs)
+	if len(userIDs) == 0 {
+		return errorx.New(errno.ErrGroupInvalidParamCode, errorx.KV("msg", "no member to kick"))
+	}
+	if len(excludeUser(userIDs, operatorID)) != len(userIDs) {
+		return errorx.New(errno.ErrGroupInvalidParamCode, errorx.KV("msg", "can not kick yourself, quit the group instead"))
+	}
+
+	_, operator, err := g.getOperator(ctx, groupID, operatorID)
+	if err != nil {
+		return err
+	}
+	if operator.Role < entity.RoleAdmin {
+		return errorx.New(errno.ErrGroupPermissionDeniedCode, errorx.KV("msg", "only the owner and admins can kick members"))
+	}
+
+	targets, err := g.GroupRepo.GetMembers(ctx, groupID, userIDs)
+	if err != nil {
+		return err
+	}
+	for _, target := range targets {
+		if target.Role >= operator.Role {
+			return errorx.New(errno.ErrGroupPermissionDeniedCode,
+				errorx.KV("msg", "can not kick member "+conv.Int64ToStr(target.UserID)))
+		}
+	}
+	if len(targets) == 0 {
+		return nil
+	}
+
+	_, err = g.GroupRepo.RemoveMembers(ctx, groupID, langslice.Transform(targets, func(m *model.GroupMember) int64 {
+		return m.UserID
+	}))
+
+	return err
+}
+
+func (g *groupImpl) QuitGroup(ctx context.Context, userID, groupID int64) error {
+	_, member, err := g.getOperator(ctx, groupID, userID)
+	if err != nil {
+		return err
+	}
+	if member.Role == entity.RoleOwner {
+		return errorx.New(errno.ErrGroupPermissionDeniedCode,
+			errorx.KV("msg", "the owner must transfer or dismiss the group instead of quitting"))
+	}
+
+	_, err = g.GroupRepo.RemoveMembers(ctx, groupID, []int64{userID})
+
+	return err
+}
+
+func (g *groupImpl) TransferOwner(ctx context.Context, operatorID, groupID, newOwnerID int64) error {
+	if newOwnerID == operatorID {
+		return errorx.New(errno.ErrGroupInvalidParamCode, errorx.KV("msg", "already the owner"))
+	}
+
+	_, operator, err := g.getOperator(ctx, groupID, operatorID)
+	if err != nil {
+		return err
+	}
+	if operator.Role != entity.RoleOwner {
+		return errorx.New(errno.ErrGroupPermissionDeniedCode, errorx.KV("msg", "only the owner can transfer the group"))
+	}
+
+	ok, err := g.GroupRepo.TransferOwner(ctx, groupID, operatorID, newOwnerID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return errorx.New(errno.ErrGroupNotMemberCode, errorx.KV("group_id", conv.Int64ToStr(groupID)))
+	}
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errorx.New(errno.ErrGroupPermissionDeniedCode, errorx.KV("msg", "only the owner can transfer the group"))
+	}
+
+	return nil
+}
+
+func (g *groupImpl) SetMemberRole(ctx context.Context, operatorID, groupID, userID int64, role int32) error {
+	if role != entity.RoleMember && role != entity.RoleAdmin {
+		return errorx.New(errno.ErrGroupInvalidParamCode, errorx.KV("msg", "role must be member or admin"))
+	}
+
+	_, operator, err := g.getOperator(ctx, groupID, operatorID)
+	if err != nil {
+		return err
+	}
+	if operator.Role != entity.RoleOwner {
+		return errorx.New(errno.ErrGroupPermissionDeniedCode, errorx.KV("msg", "only the owner can change member roles"))
+	}
+
+	ok, err := g.GroupRepo.UpdateMemberRole(ctx, groupID, userID, role)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errorx.New(errno.ErrGroupInvalidParamCode, errorx.KV("msg", conv.Int64ToStr(userID)+" is not a member"))
+	}
+
+	return nil
+}
+
+func (g *groupImpl) GetGroups(ctx context.Context, groupIDs []int64) ([]*entity.Group, error) {
+	groupIDs = langslice.Unique(groupIDs)
+	if len(groupIDs) == 0 {
+		return nil, nil
+	}
+	if len(groupIDs) > maxPageSize {
+		return nil, errorx.New(errno.ErrGroupInvalidParamCode, errorx.KV("msg", "too many groups"))
+	}
+
+	groups, err := g.GroupRepo.GetGroups(ctx, groupIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return g.groupsPO2DO(ctx, groups)
+}
+
+func (g *groupImpl) ListJoinedGroups(ctx context.Context, req *ListJoinedGroupsRequest) (*ListJoinedGroupsResponse, error) {
+	offset, limit := pagination(req.Page, req.Size)
+	groupIDs, total, err := g.GroupRepo.ListJoinedGroupIDs(ctx, req.UserID, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	if len(groupIDs) == 0 {
+		return &ListJoinedGroupsResponse{Total: total}, nil
+	}
+
+	groups, err := g.GroupRepo.GetGroups(ctx, groupIDs)
+	if err != nil {
+		return nil, err
+	}
+	// Keep the join order of the page.
+	byID := langslice.ToMap(groups, func(e *model.GroupInfo) (int64, *model.GroupInfo) {
+		return e.ID, e
+	})
+	ordered := make([]*model.GroupInfo, 0, len(groups))
+	for _, groupID := range groupIDs {
+		if group, ok := byID[groupID]; ok {
+			ordered = append(ordered, group)
+		}
+	}
+
+	res, err := g.groupsPO2DO(ctx, ordered)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListJoinedGroupsResponse{Groups: res, Total: total}, nil
+}
+
+func (g *groupImpl) SetGroupInfo(ctx context.Context, req *SetGroupInfoRequest) error {
+	updates := make(map[string]any)
+	if req.Name != nil {
+		if err := validateName(*req.Name); err != nil {
+			return err
+		}
+		updates["name"] = *req.Name
+	}
+	if req.Notice != nil {
+		if err := validateNotice(*req.Notice); err != nil {
+			return err
+		}
+		updates["notice"] = *req.Notice
+	}
+	if len(updates) == 0 {
+		return nil
+	}
+
+	if err := g.checkManager(ctx, req.GroupID, req.OperatorID); err != nil {
+		return err
+	}
+
+	ok, err := g.GroupRepo.UpdateGroup(ctx, req.GroupID, updates)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errorx.New(errno.ErrGroupNotFoundCode, errorx.KV("group_id", conv.Int64ToStr(req.GroupID)))
+	}
+
+	return nil
+}
+
+func (g *groupImpl) UpdateAvatar(ctx context.Context, req *UpdateAvatarRequest) (url string, err error) {
+	if err := g.checkManager(ctx, req.GroupID, req.OperatorID); err != nil {
+		return "", err
+	}
+
+	avatarKey := "group_avatar/" + conv.Int64ToStr(req.GroupID) + "." + req.Ext
+	err = g.IconOSS.PutObject(ctx, avatarKey, req.Avatar)
+	if err != nil {
+		return "", err
+	}
+
+	ok, err := g.GroupRepo.UpdateGroup(ctx, req.GroupID, map[string]any{"icon_uri": avatarKey})
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return "", errorx.New(errno.ErrGroupNotFoundCode, errorx.KV("group_id", conv.Int64ToStr(req.GroupID)))
+	}
+
+	return g.IconOSS.GetObjectUrl(ctx, avatarKey)
+}
+
+func (g *groupImpl) ListMembers(ctx context.Context, req *ListMembersRequest) (*ListMembersResponse, error) {
+	if _, _, err := g.getOperator(ctx, req.GroupID, req.OperatorID); err != nil {
+		return nil, err
+	}
+
+	offset, limit := pagination(req.Page, req.Size)
+	members, total, err := g.GroupRepo.ListMembers(ctx, req.GroupID, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListMembersResponse{
+		Members: langslice.Transform(members, memberPO2DO),
+		Total:   total,
+	}, nil
+}
+
+func (g *groupImpl) GetMembers(ctx context.Context, operatorID, groupID int64, userIDs []int64) ([]*entity.GroupMember, error) {
+	userIDs = langslice.Unique(userIDs)
+	if len(userIDs) > maxPageSize {
+		return nil, errorx.New(errno.ErrGroupInvalidParamCode, errorx.KV("msg", "too many users"))
+	}
+
+	if _, _, err := g.getOperator(ctx, groupID, operatorID); err != nil {
+		return nil, err
+	}
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+
+	members, err := g.GroupRepo.GetMembers(ctx, groupID, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return langslice.Transform(members, memberPO2DO), nil
+}
+
+func (g *groupImpl) GetMemberIDs(ctx context.Context, operatorID, groupID int64) ([]int64, error) {
+	if _, _, err := g.getOperator(ctx, groupID, operatorID); err != nil {
+		return nil, err
+	}
+
+	return g.GroupRepo.GetMemberIDs(ctx, groupID)
+}
+
+func (g *groupImpl) GetJoinedGroupIDs(ctx context.Context, userID int64, groupIDs []int64) ([]int64, error) {
+	groupIDs = langslice.Unique(groupIDs)
+	if len(groupIDs) == 0 {
+		return nil, nil
+	}
+	if len(groupIDs) > maxPageSize {
+		return nil, errorx.New(errno.ErrGroupInvalidParamCode, errorx.KV("msg", "too many groups"))
+	}
+
+	return g.GroupRepo.GetJoinedGroupIDs(ctx, userID, groupIDs)
+}
+
+// getOperator loads a group that has not been dismissed together with the
+// membership of userID in it.
+func (g *groupImpl) getOperator(ctx context.Context, groupID, userID int64) (*model.GroupInfo, *model.GroupMember, error) {
+	group, exist, err := g.GroupRepo.GetGroup(ctx, groupID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !exist || group.Status == entity.GroupStatusDismissed {
+		return nil, nil, errorx.New(errno.ErrGroupNotFoundCode, errorx.KV("group_id", conv.Int64ToStr(groupID)))
+	}
+
+	member, exist, err := g.GroupRepo.GetMember(ctx, groupID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !exist {
+		return nil, nil, errorx.New(errno.ErrGroupNotMemberCode, errorx.KV("group_id", conv.Int64ToStr(groupID)))
+	}
+
+	return group, member, nil
+}
+
+// checkManager makes sure userID is the owner or an admin of the group.
+func (g *groupImpl) checkManager(ctx context.Context, groupID, userID int64) error {
+	_, operator, err := g.getOperator(ctx, groupID, userID)
+	if err != nil {
+		return err
+	}
+	if operator.Role < entity.RoleAdmin {
+		return errorx.New(errno.ErrGroupPermissionDeniedCode, errorx.KV("msg", "only the owner and admins can edit the group"))
+	}
+
+	return nil
+}
+
+func (g *groupImpl) groupsPO2DO(ctx context.Context, groups []*model.GroupInfo) ([]*entity.Group, error) {
+	res := make([]*entity.Group, 0, len(groups))
+	for _, group := range groups {
+		var iconURL string
+		if group.IconURI != "" {
+			url, err := g.IconOSS.GetObjectUrl(ctx, group.IconURI)
+			if err != nil {
+				return nil, err
+			}
+			iconURL = url
+		}
+		res = append(res, groupPO2DO(group, iconURL))
+	}
+
+	return res, nil
+}
+
+func validateName(name string) error {
+	if n := utf8.RuneCountInString(name); n == 0 || n > maxGroupNameLength {
+		return errorx.New(errno.ErrGroupInvalidParamCode,
+			errorx.KV("msg", "group name length should be between 1 and "+strconv.Itoa(maxGroupNameLength)))
+	}
+
+	return nil
+}
+
+func validateNotice(notice string) error {
+	if utf8.RuneCountInString(notice) > maxNoticeLength {
+		return errorx.New(errno.ErrGroupInvalidParamCode, errorx.KV("msg", "group notice is too long"))
+	}
+
+	return nil
+}
+
+func pagination(page, size int32) (offset, limit int) {
+	p, s := int(page), int(size)
+	if p < 1 {
+		p = 1
+	}
+	if s <= 0 {
+		s = defaultPageSize
+	}
+	s = min(s, maxPageSize)
+
+	return (p - 1) * s, s
+}
+
+func excludeUser(userIDs []int64, userID int64) []int64 {
+	res := make([]int64, 0, len(userIDs))
+	for _, id := range userIDs {
+		if id != userID {
+			res = append(res, id)
+		}
+	}
+
+	return res
+}
+
+func groupPO2DO(po *model.GroupInfo, iconURL string) *entity.Group {
+	return &entity.Group{
+		GroupID:     po.ID,
+		Name:        po.Name,
+		IconURI:     po.IconURI,
+		IconURL:     iconURL,
+		Notice:      po.Notice,
+		OwnerID:     po.OwnerID,
+		MemberCount: po.MemberCount,
+		Status:      po.Status,
+		CreatedAt:   po.CreatedAt,
+		UpdatedAt:   po.UpdatedAt,
+	}
+}
+
+func memberPO2DO(po *model.GroupMember) *entity.GroupMember {
+	return &entity.GroupMember{
+		GroupID:   po.GroupID,
+		UserID:    po.UserID,
+		Role:      po.Role,
+		InviterID: po.InviterID,
+		JoinTime:  po.CreatedAt,
+	}
+}
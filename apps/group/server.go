@@ -0,0 +1,31 @@
+package group
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"github.com/crazyfrankie/goim/apps/group/application"
+	"github.com/crazyfrankie/goim/apps/group/domain/repository"
+	"github.com/crazyfrankie/goim/apps/group/domain/service"
+	"github.com/crazyfrankie/goim/infra/contract/discovery"
+	groupv1 "github.com/crazyfrankie/goim/protocol/group/v1"
+)
+
+func Start(ctx context.Context, client discovery.SvcDiscoveryRegistry, srv grpc.ServiceRegistrar) error {
+	basic, err := application.Init(ctx, client)
+	if err != nil {
+		return err
+	}
+	groupRepo := repository.NewGroupRepository(basic.DB)
+	groupDomain := service.NewGroupDomain(&service.Components{
+		GroupRepo: groupRepo,
+		IDGen:     basic.IDGen,
+		IconOSS:   basic.IconOSS,
+	})
+	appService := application.NewGroupApplicationService(groupDomain)
+
+	groupv1.RegisterGroupServiceServer(srv, appService)
+
+	return nil
+}
@@ -4,24 +4,36 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/crazyfrankie/goim/infra/contract/discovery"
 	"gorm.io/gorm"
 
 	message "github.com/crazyfrankie/goim/apps/message/domain/service"
+	"github.com/crazyfrankie/goim/infra/contract/cache"
 	"github.com/crazyfrankie/goim/infra/contract/idgen"
 	"github.com/crazyfrankie/goim/infra/impl/cache/redis"
 	"github.com/crazyfrankie/goim/infra/impl/eventbus"
 	idgenimpl "github.com/crazyfrankie/goim/infra/impl/idgen"
 	"github.com/crazyfrankie/goim/infra/impl/mysql"
 	messageevent "github.com/crazyfrankie/goim/internal/events/message"
+	conversationv1 "github.com/crazyfrankie/goim/protocol/conversation/v1"
+	groupv1 "github.com/crazyfrankie/goim/protocol/group/v1"
+	relationv1 "github.com/crazyfrankie/goim/protocol/relation/v1"
 	"github.com/crazyfrankie/goim/types/consts"
 )
 
+const defaultRevokeWindow = 2 * time.Minute
+
 type BasicServices struct {
-	DB              *gorm.DB
-	IDGen           idgen.IDGenerator
-	MessageEventBus messageevent.PublishEventBus
+	DB                      *gorm.DB
+	Cache                   cache.Cmdable
+	IDGen                   idgen.IDGenerator
+	MessageEventBus         messageevent.PublishEventBus
+	ConversationInternalCli conversationv1.ConversationInternalServiceClient
+	GroupCli                groupv1.GroupServiceClient
+	RelationCli             relationv1.RelationServiceClient
+	RevokeWindow            time.Duration
 }
 
 func Init(ctx context.Context, client discovery.SvcDiscoveryRegistry) (*BasicServices, error) {
@@ -33,9 +45,17 @@ func Init(ctx context.Context, client discovery.SvcDiscoveryRegistry) (*BasicSer
 		return nil, err
 	}
 
-	cacheCli := redis.New()
+	basic.Cache = redis.New()
+
+	basic.RevokeWindow = defaultRevokeWindow
+	if window := os.Getenv(consts.MsgRevokeWindow); window != "" {
+		basic.RevokeWindow, err = time.ParseDuration(window)
+		if err != nil {
+			return nil, fmt.Errorf("parse %s failed, err=%w", consts.MsgRevokeWindow, err)
+		}
+	}
 
-	basic.IDGen, err = idgenimpl.New(cacheCli)
+	basic.IDGen, err = idgenimpl.New(basic.Cache)
 	if err != nil {
 		return nil, err
 	}
@@ -47,6 +67,27 @@ func Init(ctx context.Context, client discovery.SvcDiscoveryRegistry) (*BasicSer
 
 	basic.MessageEventBus = message.NewMessageEventPublisher(appEventProducer)
 
+	conversationCC, err := client.GetConn(ctx, consts.ConversationServiceName)
+	if err != nil {
+		return nil, err
+	}
+
+	basic.ConversationInternalCli = conversationv1.NewConversationInternalServiceClient(conversationCC)
+
+	groupCC, err := client.GetConn(ctx, consts.GroupServiceName)
+	if err != nil {
+		return nil, err
+	}
+
+	basic.GroupCli = groupv1.NewGroupServiceClient(groupCC)
+
+	relationCC, err := client.GetConn(ctx, consts.RelationServiceName)
+	if err != nil {
+		return nil, err
+	}
+
+	basic.RelationCli = relationv1.NewRelationServiceClient(relationCC)
+
 	return basic, nil
 }
 
@@ -3,23 +3,47 @@ package application
 import (
 	"context"
 	"errors"
+	"slices"
+	"time"
 
 	"github.com/crazyfrankie/goim/apps/message/domain/entity"
 	message "github.com/crazyfrankie/goim/apps/message/domain/service"
 	eventbus "github.com/crazyfrankie/goim/internal/events/message"
+	"github.com/crazyfrankie/goim/pkg/apistruct"
+	"github.com/crazyfrankie/goim/pkg/errorx"
 	"github.com/crazyfrankie/goim/pkg/grpc/ctxutil"
+	"github.com/crazyfrankie/goim/pkg/lang/conv"
+	langslice "github.com/crazyfrankie/goim/pkg/lang/slice"
+	"github.com/crazyfrankie/goim/pkg/logs"
+	"github.com/crazyfrankie/goim/pkg/msgprocessor"
+	"github.com/crazyfrankie/goim/pkg/sonic"
+	conversationv1 "github.com/crazyfrankie/goim/protocol/conversation/v1"
+	groupv1 "github.com/crazyfrankie/goim/protocol/group/v1"
 	messagev1 "github.com/crazyfrankie/goim/protocol/message/v1"
+	relationv1 "github.com/crazyfrankie/goim/protocol/relation/v1"
 	"github.com/crazyfrankie/goim/types/consts"
+	"github.com/crazyfrankie/goim/types/errno"
 )
 
 type MessageApplicationService struct {
-	messageDomain   message.Message
-	messageEventBus eventbus.PublishEventBus
+	messageDomain           message.Message
+	messageEventBus         eventbus.PublishEventBus
+	conversationInternalCli conversationv1.ConversationInternalServiceClient
+	groupCli                groupv1.GroupServiceClient
+	relationCli             relationv1.RelationServiceClient
 	messagev1.UnimplementedMessageServiceServer
 }
 
-func NewMessageApplicationService(messageDomain message.Message) *MessageApplicationService {
-	return &MessageApplicationService{messageDomain: messageDomain}
+func NewMessageApplicationService(messageDomain message.Message, messageEventBus eventbus.PublishEventBus,
+	conversationInternalCli conversationv1.ConversationInternalServiceClient,
+	groupCli groupv1.GroupServiceClient, relationCli relationv1.RelationServiceClient) *MessageApplicationService {
+	return &MessageApplicationService{
+		messageDomain:           messageDomain,
+		messageEventBus:         messageEventBus,
+		conversationInternalCli: conversationInternalCli,
+		groupCli:                groupCli,
+		relationCli:             relationCli,
+	}
 }
 
 func (m *MessageApplicationService) SendMessage(ctx context.Context, req *messagev1.SendMessageRequest) (*messagev1.SendMessageResponse, error) {
@@ -27,7 +51,33 @@ func (m *MessageApplicationService) SendMessage(ctx context.Context, req *messag
 		return nil, err
 	}
 
-	msg, err := m.messageDomain.Create(ctx, &message.CreateMessageRequest{
+	// The checks run before the message is stored, so that a rejected message never takes a seq.
+	var memberIDs []int64
+	var err error
+	switch req.GetData().GetSessionType() {
+	case consts.SingleChatType:
+		outCtx := ctxutil.WithOutgoingUserID(ctx, conv.Int64ToStr(req.GetData().GetSendID()))
+		_, err = m.relationCli.CheckSingleChat(outCtx, &relationv1.CheckSingleChatRequest{
+			SendID: req.GetData().GetSendID(),
+			RecvID: req.GetData().GetRecvID(),
+		})
+		if err != nil {
+			return nil, err
+		}
+	case consts.GroupChatType, consts.WriteGroupChatType, consts.ReadGroupChatType:
+		memberIDs, err = m.getGroupMemberIDs(ctx, req.GetData().GetSendID(), req.GetData().GetGroupID())
+		if err != nil {
+			return nil, err
+		}
+	case consts.NotificationChatType:
+		// Only the server emits notifications, a client one would bypass the
+		// blocklist of the receiver.
+		return nil, errorx.New(errno.ErrMessageInvalidParamCode, errorx.KV("msg", "notifications can not be sent by clients"))
+	default:
+		return nil, errorx.New(errno.ErrMessageInvalidParamCode, errorx.KV("msg", "unsupported session type"))
+	}
+
+	msg, duplicated, err := m.messageDomain.Create(ctx, &message.CreateMessageRequest{
 		SendID:      req.GetData().GetSendID(),
 		RecvID:      req.GetData().GetRecvID(),
 		GroupID:     req.GetData().GetGroupID(),
@@ -36,19 +86,27 @@ func (m *MessageApplicationService) SendMessage(ctx context.Context, req *messag
 		SessionType: req.GetData().GetSessionType(),
 		MessageFrom: req.GetData().GetMessageFrom(),
 		ContentType: req.GetData().GetContentType(),
-		// TODO add seq generated
-		//Seq:       ,
-		SendTime: req.GetData().GetSendTime(),
+		SendTime:    req.GetData().GetSendTime(),
 	})
 	if err != nil {
 		return nil, err
 	}
+	if duplicated {
+		// A retried send, answer with the original result without delivering it again.
+		return sendMessageResp(msg), nil
+	}
 
-	switch req.GetData().GetSessionType() {
+	return m.deliver(ctx, msg, memberIDs)
+}
+
+// deliver advances the conversations msg lands in and pushes it to the online
+// receivers. memberIDs lists the group members for group messages.
+func (m *MessageApplicationService) deliver(ctx context.Context, msg *entity.Message, memberIDs []int64) (*messagev1.SendMessageResponse, error) {
+	switch msg.SessionType {
 	case consts.SingleChatType:
 		return m.sendSingleChat(ctx, msg)
-	case consts.GroupChatType:
-		return m.sendGroupChat(ctx, msg)
+	case consts.GroupChatType, consts.WriteGroupChatType, consts.ReadGroupChatType:
+		return m.sendGroupChat(ctx, msg, memberIDs)
 	case consts.NotificationChatType:
 		return m.sendNotificationChat(ctx, msg)
 	default:
@@ -57,40 +115,702 @@ func (m *MessageApplicationService) SendMessage(ctx context.Context, req *messag
 }
 
 func (m *MessageApplicationService) sendSingleChat(ctx context.Context, msg *entity.Message) (*messagev1.SendMessageResponse, error) {
-	// TODO
+	m.updateConversations(ctx, msg, []int64{msg.SendID, msg.RecvID})
+	// The sender is pushed too, so that its other devices stay in sync.
+	m.publishMessageSent(ctx, msg, []int64{msg.RecvID, msg.SendID})
+
+	return sendMessageResp(msg), nil
+}
+
+func (m *MessageApplicationService) sendGroupChat(ctx context.Context, msg *entity.Message, memberIDs []int64) (*messagev1.SendMessageResponse, error) {
+	// memberIDs holds the sender as well, which keeps its other devices in sync.
+	m.updateConversations(ctx, msg, memberIDs)
+	m.publishMessageSent(ctx, msg, memberIDs)
+
+	return sendMessageResp(msg), nil
+}
 
+func (m *MessageApplicationService) sendNotificationChat(ctx context.Context, msg *entity.Message) (*messagev1.SendMessageResponse, error) {
+	m.updateConversations(ctx, msg, []int64{msg.RecvID})
+	m.publishMessageSent(ctx, msg, []int64{msg.RecvID})
+
+	return sendMessageResp(msg), nil
+}
+
+func sendMessageResp(msg *entity.Message) *messagev1.SendMessageResponse {
 	return &messagev1.SendMessageResponse{
 		SendTime:    msg.SendTime,
 		ServerMsgID: msg.MsgID,
 		ClientMsgID: msg.ClientMsgID,
+		Seq:         msg.Seq,
+	}
+}
+
+// updateConversations advances the conversation of every owner with msg. The
+// message is already stored at this point, so a failure is logged rather than
+// returned and the conversation catches up with the next message.
+func (m *MessageApplicationService) updateConversations(ctx context.Context, msg *entity.Message, ownerIDs []int64) {
+	ctx = ctxutil.WithOutgoingUserID(ctx, conv.Int64ToStr(msg.SendID))
+	_, err := m.conversationInternalCli.UpdateConversationsByMessage(ctx, &conversationv1.UpdateConversationsByMessageRequest{
+		ConversationID:   msg.ConversationID,
+		ConversationType: msg.SessionType,
+		RecvID:           msg.RecvID,
+		GroupID:          msg.GroupID,
+		OwnerIDs:         ownerIDs,
+		LastMessage: &conversationv1.LastMessage{
+			ServerMsgID: msg.MsgID,
+			SendID:      msg.SendID,
+			ContentType: msg.ContentType,
+			Content:     []byte(msg.Content),
+			Seq:         msg.Seq,
+			SendTime:    msg.SendTime,
+		},
+	})
+	if err != nil {
+		logs.CtxErrorf(ctx, "update conversation %s by message %d failed, err=%v", msg.ConversationID, msg.MsgID, err)
+	}
+}
+
+// publishMessageSent hands msg to the push workers for online delivery. Like
+// updateConversations it only logs failures, offline pulls will pick msg up.
+func (m *MessageApplicationService) publishMessageSent(ctx context.Context, msg *entity.Message, pushToUserIDs []int64) {
+	err := m.messageEventBus.PublishMessageEvent(ctx, &eventbus.MessageEvent{
+		EventType:      eventbus.MessageSent,
+		MessageID:      msg.MsgID,
+		UserID:         msg.SendID,
+		Content:        msg.Content,
+		TimestampMS:    msg.SendTime,
+		ConversationID: msg.ConversationID,
+		PushToUserIDs:  pushToUserIDs,
+		Msg:            messageDO2DTO(msg),
+	})
+	if err != nil {
+		logs.CtxErrorf(ctx, "publish message %d sent event failed, err=%v", msg.MsgID, err)
+	}
+}
+
+func (m *MessageApplicationService) SetMessageStatus(ctx context.Context, req *messagev1.SetMessageStatusRequest) (*messagev1.SetMessageStatusResponse, error) {
+	status, ok := messageStatuses[req.GetStatus()]
+	if !ok {
+		return nil, errorx.New(errno.ErrMessageInvalidParamCode, errorx.KV("msg", "unspecified or unknown message status"))
+	}
+	if (len(req.GetSeqs()) == 0) == (len(req.GetServerMsgIDs()) == 0) {
+		return nil, errorx.New(errno.ErrMessageInvalidParamCode, errorx.KV("msg", "either seqs or server message ids are required"))
+	}
+	if err := m.checkConversationAccess(ctx, req.GetConversationID()); err != nil {
+		return nil, err
+	}
+
+	operatorID := ctxutil.MustGetUserIDFromCtx(ctx)
+	// Deliveries are confirmed in batches, those of messages gone meanwhile are skipped.
+	all := status != consts.MsgStatusDelivered
+	msgs, err := m.getMessages(ctx, operatorID, req.GetConversationID(), req.GetSeqs(), req.GetServerMsgIDs(), all)
+	if err != nil {
+		return nil, err
+	}
+
+	changed, err := m.updateMessagesStatus(ctx, operatorID, msgs, status)
+	if err != nil {
+		return nil, err
+	}
+
+	return &messagev1.SetMessageStatusResponse{
+		ChangedSeqs: langslice.Transform(changed, func(msg *entity.Message) int64 {
+			return msg.Seq
+		}),
 	}, nil
 }
 
-func (m *MessageApplicationService) sendGroupChat(ctx context.Context, msg *entity.Message) (*messagev1.SendMessageResponse, error) {
-	// TODO
+// getMessages loads the messages by seqs, or by server message IDs when no seq
+// is given. With all set it fails unless all of them are visible to userID.
+func (m *MessageApplicationService) getMessages(ctx context.Context, userID int64, conversationID string, seqs, msgIDs []int64, all bool) ([]*entity.Message, error) {
+	var msgs []*entity.Message
+	var err error
+	if len(seqs) > 0 {
+		msgs, err = m.messageDomain.GetMessagesBySeqs(ctx, userID, conversationID, seqs)
+	} else {
+		msgs, err = m.messageDomain.GetMessagesByIDs(ctx, userID, conversationID, msgIDs)
+	}
+	if err != nil || !all {
+		return msgs, err
+	}
 
-	return &messagev1.SendMessageResponse{
-		SendTime:    msg.SendTime,
-		ServerMsgID: msg.MsgID,
-		ClientMsgID: msg.ClientMsgID,
+	keys, key := seqs, func(msg *entity.Message) (int64, bool) { return msg.Seq, true }
+	if len(seqs) == 0 {
+		keys, key = msgIDs, func(msg *entity.Message) (int64, bool) { return msg.MsgID, true }
+	}
+	found := langslice.ToMap(msgs, key)
+	for _, k := range keys {
+		if !found[k] {
+			return nil, errorx.New(errno.ErrMessageNotFoundCode, errorx.KV("seq", conv.Int64ToStr(k)))
+		}
+	}
+
+	return msgs, nil
+}
+
+// updateMessagesStatus moves msgs to status on behalf of operatorID and tells
+// the affected users about the messages that changed. Group managers may
+// revoke or delete the messages of the members they manage.
+func (m *MessageApplicationService) updateMessagesStatus(ctx context.Context, operatorID int64, msgs []*entity.Message, status int32) ([]*entity.Message, error) {
+	if len(msgs) == 0 {
+		return nil, nil
+	}
+
+	var operatorRole groupv1.GroupRole
+	var managedIDs []int64
+	if (status == consts.MsgStatusRevoked || status == consts.MsgStatusDeleted) && consts.IsGroupSessionType(msgs[0].SessionType) {
+		senderIDs := slices.DeleteFunc(langslice.Unique(langslice.Transform(msgs, func(msg *entity.Message) int64 {
+			return msg.SendID
+		})), func(id int64) bool {
+			return id == operatorID
+		})
+		if len(senderIDs) > 0 {
+			var err error
+			operatorRole, managedIDs, err = m.getGroupManagedIDs(ctx, msgs[0].GroupID, operatorID, senderIDs)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	changed, err := m.messageDomain.UpdateStatus(ctx, &message.UpdateStatusRequest{
+		Msgs:       msgs,
+		Status:     status,
+		OperatorID: operatorID,
+		ManagedIDs: managedIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var memberIDs []int64
+	if status == consts.MsgStatusDeleted && len(changed) > 0 && consts.IsGroupSessionType(changed[0].SessionType) {
+		memberIDs, err = m.getGroupMemberIDs(ctx, operatorID, changed[0].GroupID)
+		if err != nil {
+			// The messages are deleted already, members catch up with their next pull.
+			logs.CtxErrorf(ctx, "get members of group %d failed, err=%v", changed[0].GroupID, err)
+		}
+	}
+	for _, msg := range changed {
+		if status == consts.MsgStatusRevoked {
+			m.notifyMessageRevoked(ctx, msg, operatorID, int32(operatorRole))
+		}
+		m.publishStatusChanged(ctx, msg, operatorID, statusPushToUserIDs(msg, memberIDs))
+	}
+
+	return changed, nil
+}
+
+// statusPushToUserIDs returns who is told that msg changed its status. Deletes
+// concern every participant. Revokes reach them through the revoke
+// notification already, every other status only concerns the sender.
+func statusPushToUserIDs(msg *entity.Message, memberIDs []int64) []int64 {
+	switch msg.Status {
+	case consts.MsgStatusRevoked:
+		return nil
+	case consts.MsgStatusDeleted:
+		switch msg.SessionType {
+		case consts.SingleChatType:
+			return langslice.Unique([]int64{msg.SendID, msg.RecvID})
+		case consts.GroupChatType, consts.WriteGroupChatType, consts.ReadGroupChatType:
+			return memberIDs
+		default:
+			return []int64{msg.RecvID}
+		}
+	default:
+		return []int64{msg.SendID}
+	}
+}
+
+// publishStatusChanged puts the status change of msg on the bus and has it
+// pushed to pushToUserIDs. The change already took effect, so failures are
+// only logged.
+func (m *MessageApplicationService) publishStatusChanged(ctx context.Context, msg *entity.Message, operatorID int64, pushToUserIDs []int64) {
+	content, err := sonic.MarshalString(&apistruct.MessageStatusChanged{
+		ConversationID: msg.ConversationID,
+		ServerMsgID:    msg.MsgID,
+		ClientMsgID:    msg.ClientMsgID,
+		Seq:            msg.Seq,
+		Status:         msg.Status,
+		OperatorID:     conv.Int64ToStr(operatorID),
+	})
+	if err != nil {
+		logs.CtxErrorf(ctx, "marshal status change of message %d failed, err=%v", msg.MsgID, err)
+		return
+	}
+
+	now := time.Now().UnixMilli()
+	err = m.messageEventBus.PublishMessageEvent(ctx, &eventbus.MessageEvent{
+		EventType:      statusEventTypes[msg.Status],
+		MessageID:      msg.MsgID,
+		UserID:         operatorID,
+		Content:        content,
+		TimestampMS:    now,
+		ConversationID: msg.ConversationID,
+		PushToUserIDs:  pushToUserIDs,
+		Msg: &messagev1.Message{
+			SendID:         operatorID,
+			RecvID:         msg.RecvID,
+			GroupID:        msg.GroupID,
+			SessionType:    msg.SessionType,
+			ContentType:    consts.MessageStatusNotification,
+			SendTime:       now,
+			Content:        []byte(content),
+			ConversationID: msg.ConversationID,
+		},
+	})
+	if err != nil {
+		logs.CtxErrorf(ctx, "publish status change of message %d failed, err=%v", msg.MsgID, err)
+	}
+}
+
+func (m *MessageApplicationService) PullMessagesBySeqs(ctx context.Context, req *messagev1.PullMessagesBySeqsRequest) (*messagev1.PullMessagesBySeqsResponse, error) {
+	if err := m.checkConversationAccess(ctx, req.GetConversationID()); err != nil {
+		return nil, err
+	}
+
+	msgs, err := m.messageDomain.GetMessagesBySeqs(ctx, ctxutil.MustGetUserIDFromCtx(ctx), req.GetConversationID(), req.GetSeqs())
+	if err != nil {
+		return nil, err
+	}
+
+	return &messagev1.PullMessagesBySeqsResponse{
+		Msgs: langslice.Transform(msgs, messageDO2DTO),
 	}, nil
 }
 
-func (m *MessageApplicationService) sendNotificationChat(ctx context.Context, msg *entity.Message) (*messagev1.SendMessageResponse, error) {
-	// TODO
+func (m *MessageApplicationService) PullMessagesBySeqRange(ctx context.Context, req *messagev1.PullMessagesBySeqRangeRequest) (*messagev1.PullMessagesBySeqRangeResponse, error) {
+	if err := m.checkConversationAccess(ctx, req.GetConversationID()); err != nil {
+		return nil, err
+	}
 
-	return &messagev1.SendMessageResponse{
-		SendTime:    msg.SendTime,
-		ServerMsgID: msg.MsgID,
+	res, err := m.messageDomain.GetMessagesBySeqRange(ctx, &message.GetMessagesBySeqRangeRequest{
+		OwnerID:        ctxutil.MustGetUserIDFromCtx(ctx),
+		ConversationID: req.GetConversationID(),
+		Begin:          req.GetBegin(),
+		End:            req.GetEnd(),
+		Limit:          req.GetLimit(),
+		Asc:            req.GetOrder() == messagev1.PullOrder_PULL_ORDER_ASC,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &messagev1.PullMessagesBySeqRangeResponse{
+		Msgs:  langslice.Transform(res.Messages, messageDO2DTO),
+		IsEnd: res.IsEnd,
+	}, nil
+}
+
+// maxNewestSeqConversations bounds how many conversations one GetNewestSeq
+// call may ask about.
+const maxNewestSeqConversations = 100
+
+func (m *MessageApplicationService) GetNewestSeq(ctx context.Context, req *messagev1.GetNewestSeqRequest) (*messagev1.GetNewestSeqResponse, error) {
+	if len(req.GetConversationIDs()) > maxNewestSeqConversations {
+		return nil, errorx.New(errno.ErrMessageInvalidParamCode, errorx.KV("msg", "too many conversations"))
+	}
+	if err := m.checkConversationsAccess(ctx, req.GetConversationIDs()); err != nil {
+		return nil, err
+	}
+
+	maxSeqs, err := m.messageDomain.GetMaxSeqs(ctx, req.GetConversationIDs())
+	if err != nil {
+		return nil, err
+	}
+
+	return &messagev1.GetNewestSeqResponse{MaxSeqs: maxSeqs}, nil
+}
+
+func (m *MessageApplicationService) RevokeMessage(ctx context.Context, req *messagev1.RevokeMessageRequest) (*messagev1.RevokeMessageResponse, error) {
+	if err := m.checkConversationAccess(ctx, req.GetConversationID()); err != nil {
+		return nil, err
+	}
+
+	revokerID := ctxutil.MustGetUserIDFromCtx(ctx)
+	msgs, err := m.getMessages(ctx, revokerID, req.GetConversationID(), []int64{req.GetSeq()}, nil, true)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = m.updateMessagesStatus(ctx, revokerID, msgs, consts.MsgStatusRevoked)
+	if err != nil {
+		return nil, err
+	}
+
+	return &messagev1.RevokeMessageResponse{}, nil
+}
+
+// notifyMessageRevoked sends a revoke notification into the conversation of
+// msg, so that every device of every participant replaces the message. The
+// revoke itself already took effect, so failures are only logged.
+func (m *MessageApplicationService) notifyMessageRevoked(ctx context.Context, msg *entity.Message, revokerID int64, revokerRole int32) {
+	content, err := sonic.MarshalString(&apistruct.MessageRevoked{
+		RevokerID:   conv.Int64ToStr(revokerID),
+		RevokerRole: revokerRole,
 		ClientMsgID: msg.ClientMsgID,
+		SessionType: msg.SessionType,
+		Seq:         msg.Seq,
+	})
+	if err != nil {
+		logs.CtxErrorf(ctx, "marshal revoke of message %d failed, err=%v", msg.MsgID, err)
+		return
+	}
+
+	var memberIDs []int64
+	if consts.IsGroupSessionType(msg.SessionType) {
+		memberIDs, err = m.getGroupMemberIDs(ctx, revokerID, msg.GroupID)
+		if err != nil {
+			logs.CtxErrorf(ctx, "get members of group %d failed, err=%v", msg.GroupID, err)
+			return
+		}
+	}
+
+	// In a single chat the notification goes to whoever did not revoke.
+	recvID := msg.RecvID
+	if revokerID == msg.RecvID {
+		recvID = msg.SendID
+	}
+	notification, _, err := m.messageDomain.Create(ctx, &message.CreateMessageRequest{
+		SendID:      revokerID,
+		RecvID:      recvID,
+		GroupID:     msg.GroupID,
+		Content:     content,
+		SessionType: msg.SessionType,
+		ContentType: consts.RevokeMessageType,
+		SendTime:    time.Now().UnixMilli(),
+	})
+	if err != nil {
+		logs.CtxErrorf(ctx, "create revoke notification of message %d failed, err=%v", msg.MsgID, err)
+		return
+	}
+
+	if _, err := m.deliver(ctx, notification, memberIDs); err != nil {
+		logs.CtxErrorf(ctx, "deliver revoke notification of message %d failed, err=%v", msg.MsgID, err)
+	}
+}
+
+func (m *MessageApplicationService) DeleteMessages(ctx context.Context, req *messagev1.DeleteMessagesRequest) (*messagev1.DeleteMessagesResponse, error) {
+	if err := m.checkConversationAccess(ctx, req.GetConversationID()); err != nil {
+		return nil, err
+	}
+
+	ownerID := ctxutil.MustGetUserIDFromCtx(ctx)
+	err := m.messageDomain.DeleteMessages(ctx, ownerID, req.GetConversationID(), req.GetSeqs())
+	if err != nil {
+		return nil, err
+	}
+	m.notifyMessagesDeleted(ctx, ownerID, &apistruct.MessagesDeleted{
+		ConversationID: req.GetConversationID(),
+		Seqs:           req.GetSeqs(),
+	})
+
+	return &messagev1.DeleteMessagesResponse{}, nil
+}
+
+func (m *MessageApplicationService) ClearConversation(ctx context.Context, req *messagev1.ClearConversationRequest) (*messagev1.ClearConversationResponse, error) {
+	if err := m.checkConversationAccess(ctx, req.GetConversationID()); err != nil {
+		return nil, err
+	}
+
+	ownerID := ctxutil.MustGetUserIDFromCtx(ctx)
+	clearedSeq, err := m.messageDomain.ClearConversation(ctx, ownerID, req.GetConversationID(), req.GetUpToSeq())
+	if err != nil {
+		return nil, err
+	}
+	if clearedSeq > 0 {
+		m.notifyMessagesDeleted(ctx, ownerID, &apistruct.MessagesDeleted{
+			ConversationID: req.GetConversationID(),
+			ClearedSeq:     clearedSeq,
+		})
+	}
+
+	return &messagev1.ClearConversationResponse{ClearedSeq: clearedSeq}, nil
+}
+
+// notifyMessagesDeleted tells the other online devices of ownerID to drop the
+// deleted messages. Unlike a revoke it is not stored as a message, since nobody
+// else is told and devices coming online again learn it from their next pull.
+func (m *MessageApplicationService) notifyMessagesDeleted(ctx context.Context, ownerID int64, deleted *apistruct.MessagesDeleted) {
+	content, err := sonic.MarshalString(deleted)
+	if err != nil {
+		logs.CtxErrorf(ctx, "marshal deletion in conversation %s failed, err=%v", deleted.ConversationID, err)
+		return
+	}
+
+	now := time.Now().UnixMilli()
+	err = m.messageEventBus.PublishMessageEvent(ctx, &eventbus.MessageEvent{
+		EventType:      eventbus.MessageDeleted,
+		UserID:         ownerID,
+		Content:        content,
+		TimestampMS:    now,
+		ConversationID: deleted.ConversationID,
+		PushToUserIDs:  []int64{ownerID},
+		Msg: &messagev1.Message{
+			SendID:         ownerID,
+			RecvID:         ownerID,
+			SessionType:    consts.NotificationChatType,
+			ContentType:    consts.DeleteMessageNotification,
+			SendTime:       now,
+			Content:        []byte(content),
+			ConversationID: deleted.ConversationID,
+		},
+	})
+	if err != nil {
+		logs.CtxErrorf(ctx, "publish deletion in conversation %s failed, err=%v", deleted.ConversationID, err)
+	}
+}
+
+func (m *MessageApplicationService) MarkConversationAsRead(ctx context.Context, req *messagev1.MarkConversationAsReadRequest) (*messagev1.MarkConversationAsReadResponse, error) {
+	if err := m.checkConversationAccess(ctx, req.GetConversationID()); err != nil {
+		return nil, err
+	}
+
+	readerID := ctxutil.MustGetUserIDFromCtx(ctx)
+	res, err := m.messageDomain.MarkAsRead(ctx, readerID, req.GetConversationID(), req.GetHasReadSeq())
+	if err != nil {
+		return nil, err
+	}
+	if res.Advanced {
+		m.syncConversationRead(ctx, readerID, req.GetConversationID(), res.HasReadSeq)
+		// The reader is told as well, so that its other devices clear the unread badge.
+		m.notifyConversationRead(ctx, readerID, req.GetConversationID(), res.HasReadSeq,
+			langslice.Unique(append(res.SenderIDs, readerID)))
+	}
+
+	return &messagev1.MarkConversationAsReadResponse{HasReadSeq: res.HasReadSeq}, nil
+}
+
+func (m *MessageApplicationService) GetMessageReaders(ctx context.Context, req *messagev1.GetMessageReadersRequest) (*messagev1.GetMessageReadersResponse, error) {
+	if err := m.checkConversationAccess(ctx, req.GetConversationID()); err != nil {
+		return nil, err
+	}
+
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+	msgs, err := m.messageDomain.GetMessagesBySeqs(ctx, userID, req.GetConversationID(), []int64{req.GetSeq()})
+	if err != nil {
+		return nil, err
+	}
+	if len(msgs) == 0 {
+		return nil, errorx.New(errno.ErrMessageNotFoundCode, errorx.KV("seq", conv.Int64ToStr(req.GetSeq())))
+	}
+	if msgs[0].SendID != userID {
+		return nil, errorx.New(errno.ErrMessageReadersDeniedCode, errorx.KV("seq", conv.Int64ToStr(req.GetSeq())))
+	}
+
+	res, err := m.messageDomain.GetMessageReaders(ctx, &message.GetMessageReadersRequest{
+		Msg:  msgs[0],
+		Page: req.GetPage(),
+		Size: req.GetSize(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &messagev1.GetMessageReadersResponse{
+		ReaderIDs: res.ReaderIDs,
+		Total:     res.Total,
 	}, nil
 }
 
-func (m *MessageApplicationService) SetMessageStatus(ctx context.Context, req *messagev1.SetMessageStatusRequest) (*messagev1.SetMessageStatusResponse, error) {
-	err := m.messageDomain.UpdateMessageStatus(ctx, req.GetStatus())
+// syncConversationRead keeps the unread count of the conversation list in step
+// with the read cursor. The cursor already moved, so failures are only logged.
+func (m *MessageApplicationService) syncConversationRead(ctx context.Context, readerID int64, conversationID string, hasReadSeq int64) {
+	ctx = ctxutil.WithOutgoingUserID(ctx, conv.Int64ToStr(readerID))
+	_, err := m.conversationInternalCli.MarkConversationAsRead(ctx, &conversationv1.MarkConversationAsReadRequest{
+		ConversationID: conversationID,
+		HasReadSeq:     hasReadSeq,
+	})
+	if err != nil {
+		logs.CtxWarnf(ctx, "mark conversation %s of user %d as read failed, err=%v", conversationID, readerID, err)
+	}
+}
+
+// notifyConversationRead pushes a read receipt to the online devices of
+// pushToUserIDs. Like deletions it is not stored, offline senders learn about
+// reads from GetMessageReaders.
+func (m *MessageApplicationService) notifyConversationRead(ctx context.Context, readerID int64, conversationID string, hasReadSeq int64, pushToUserIDs []int64) {
+	content, err := sonic.MarshalString(&apistruct.MarkAsReadTips{
+		ConversationID: conversationID,
+		ReaderID:       conv.Int64ToStr(readerID),
+		HasReadSeq:     hasReadSeq,
+	})
+	if err != nil {
+		logs.CtxErrorf(ctx, "marshal read receipt of conversation %s failed, err=%v", conversationID, err)
+		return
+	}
+
+	sessionType := int32(consts.SingleChatType)
+	if msgprocessor.IsGroupConversationID(conversationID) {
+		sessionType = consts.GroupChatType
+	}
+	now := time.Now().UnixMilli()
+	err = m.messageEventBus.PublishMessageEvent(ctx, &eventbus.MessageEvent{
+		EventType:      eventbus.MessageRead,
+		UserID:         readerID,
+		Content:        content,
+		TimestampMS:    now,
+		ConversationID: conversationID,
+		PushToUserIDs:  pushToUserIDs,
+		Msg: &messagev1.Message{
+			SendID:         readerID,
+			SessionType:    sessionType,
+			ContentType:    consts.HasReadReceipt,
+			SendTime:       now,
+			Content:        []byte(content),
+			ConversationID: conversationID,
+		},
+	})
+	if err != nil {
+		logs.CtxErrorf(ctx, "publish read receipt of conversation %s failed, err=%v", conversationID, err)
+	}
+}
+
+// getGroupMemberIDs lists the members of the group on behalf of userID, which
+// fails unless userID is a member itself.
+func (m *MessageApplicationService) getGroupMemberIDs(ctx context.Context, userID, groupID int64) ([]int64, error) {
+	ctx = ctxutil.WithOutgoingUserID(ctx, conv.Int64ToStr(userID))
+	resp, err := m.groupCli.GetGroupMemberIDs(ctx, &groupv1.GetGroupMemberIDsRequest{GroupID: groupID})
 	if err != nil {
 		return nil, err
 	}
 
-	return &messagev1.SetMessageStatusResponse{}, nil
+	return resp.GetMemberIDs(), nil
+}
+
+// getGroupManagedIDs returns the role of operatorID in the group and those of
+// userIDs it manages, that is everyone ranked below an admin or owner. Users
+// who are no longer in the group count as ordinary members.
+func (m *MessageApplicationService) getGroupManagedIDs(ctx context.Context, groupID, operatorID int64, userIDs []int64) (groupv1.GroupRole, []int64, error) {
+	ctx = ctxutil.WithOutgoingUserID(ctx, conv.Int64ToStr(operatorID))
+	resp, err := m.groupCli.GetGroupMembers(ctx, &groupv1.GetGroupMembersRequest{
+		GroupID: groupID,
+		UserIDs: append([]int64{operatorID}, userIDs...),
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+
+	roles := langslice.ToMap(resp.GetMembers(), func(member *groupv1.GroupMember) (int64, groupv1.GroupRole) {
+		return member.GetUserID(), member.GetRole()
+	})
+	operatorRole := roles[operatorID]
+	if operatorRole < groupv1.GroupRole_GROUP_ROLE_ADMIN {
+		return operatorRole, nil, nil
+	}
+
+	var managedIDs []int64
+	for _, userID := range userIDs {
+		if roles[userID] < operatorRole {
+			managedIDs = append(managedIDs, userID)
+		}
+	}
+
+	return operatorRole, managedIDs, nil
+}
+
+// checkConversationAccess makes sure the caller takes part in the conversation.
+func (m *MessageApplicationService) checkConversationAccess(ctx context.Context, conversationID string) error {
+	if msgprocessor.IsGroupConversationID(conversationID) {
+		groupID, ok := msgprocessor.GetGroupIDByConversationID(conversationID)
+		if !ok {
+			return errorx.New(errno.ErrMessageInvalidParamCode, errorx.KV("msg", "invalid conversation id "+conversationID))
+		}
+		// Without user IDs the call only checks that the caller is a member.
+		ctx = ctxutil.WithOutgoingUserID(ctx, conv.Int64ToStr(ctxutil.MustGetUserIDFromCtx(ctx)))
+		_, err := m.groupCli.GetGroupMembers(ctx, &groupv1.GetGroupMembersRequest{GroupID: groupID})
+
+		return err
+	}
+
+	userA, userB, ok := msgprocessor.GetUserIDsByConversationID(conversationID)
+	if !ok {
+		return errorx.New(errno.ErrMessageInvalidParamCode, errorx.KV("msg", "invalid conversation id "+conversationID))
+	}
+	if err := ctxutil.CheckAccess(ctx, userA); err == nil {
+		return nil
+	}
+
+	return ctxutil.CheckAccess(ctx, userB)
+}
+
+// checkConversationsAccess checks every conversation like checkConversationAccess,
+// asking the group service once for all the groups.
+func (m *MessageApplicationService) checkConversationsAccess(ctx context.Context, conversationIDs []string) error {
+	var groupIDs []int64
+	for _, conversationID := range conversationIDs {
+		if !msgprocessor.IsGroupConversationID(conversationID) {
+			if err := m.checkConversationAccess(ctx, conversationID); err != nil {
+				return err
+			}
+			continue
+		}
+		groupID, ok := msgprocessor.GetGroupIDByConversationID(conversationID)
+		if !ok {
+			return errorx.New(errno.ErrMessageInvalidParamCode, errorx.KV("msg", "invalid conversation id "+conversationID))
+		}
+		groupIDs = append(groupIDs, groupID)
+	}
+	if len(groupIDs) == 0 {
+		return nil
+	}
+
+	ctx = ctxutil.WithOutgoingUserID(ctx, conv.Int64ToStr(ctxutil.MustGetUserIDFromCtx(ctx)))
+	resp, err := m.groupCli.GetJoinedGroupIDs(ctx, &groupv1.GetJoinedGroupIDsRequest{GroupIDs: groupIDs})
+	if err != nil {
+		return err
+	}
+	for _, groupID := range groupIDs {
+		if !slices.Contains(resp.GetGroupIDs(), groupID) {
+			return errorx.New(errno.ErrGroupNotMemberCode, errorx.KV("group_id", conv.Int64ToStr(groupID)))
+		}
+	}
+
+	return nil
+}
+
+// messageStatuses maps the statuses clients may ask for to the stored ones,
+// MESSAGE_STATUS_UNSPECIFIED is left out.
+var messageStatuses = map[messagev1.MessageStatus]int32{
+	messagev1.MessageStatus_MESSAGE_STATUS_SENT:      consts.MsgStatusSent,
+	messagev1.MessageStatus_MESSAGE_STATUS_REVOKED:   consts.MsgStatusRevoked,
+	messagev1.MessageStatus_MESSAGE_STATUS_SENDING:   consts.MsgStatusSending,
+	messagev1.MessageStatus_MESSAGE_STATUS_DELIVERED: consts.MsgStatusDelivered,
+	messagev1.MessageStatus_MESSAGE_STATUS_FAILED:    consts.MsgStatusFailed,
+	messagev1.MessageStatus_MESSAGE_STATUS_DELETED:   consts.MsgStatusDeleted,
+}
+
+// statusEventTypes maps a message status to the event announcing it.
+var statusEventTypes = map[int32]eventbus.EventType{
+	consts.MsgStatusSending:   eventbus.MessageSending,
+	consts.MsgStatusSent:      eventbus.MessageSent,
+	consts.MsgStatusDelivered: eventbus.MessageDelivered,
+	consts.MsgStatusFailed:    eventbus.MessageFailed,
+	consts.MsgStatusRevoked:   eventbus.MessageRevoked,
+	consts.MsgStatusDeleted:   eventbus.MessageDeleted,
+}
+
+func messageDO2DTO(msg *entity.Message) *messagev1.Message {
+	res := &messagev1.Message{
+		SendID:         msg.SendID,
+		RecvID:         msg.RecvID,
+		GroupID:        msg.GroupID,
+		ClientMsgID:    msg.ClientMsgID,
+		SessionType:    msg.SessionType,
+		MessageFrom:    msg.MessageFrom,
+		ContentType:    msg.ContentType,
+		SendTime:       msg.SendTime,
+		Content:        []byte(msg.Content),
+		ServerMsgID:    msg.MsgID,
+		Seq:            msg.Seq,
+		ConversationID: msg.ConversationID,
+		Status:         msg.Status,
+	}
+	if msg.Status == consts.MsgStatusRevoked || msg.Status == consts.MsgStatusDeleted {
+		// The content of a revoked or deleted message is never handed out again.
+		res.Content = nil
+	}
+
+	return res
 }
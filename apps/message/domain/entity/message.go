@@ -1,16 +1,40 @@
 package entity
 
+import (
+	"slices"
+
+	"github.com/crazyfrankie/goim/types/consts"
+)
+
 type Message struct {
-	MsgID       int64 // Server-generated message ID
-	SendID      int64
-	RecvID      int64
-	GroupID     int64  // Group ID (for group messages)
-	Seq         int64  // Message Sequence Number
-	ClientMsgID string // Client-generated message ID
-	Content     string // Message Content
-	SendTime    int64  // Send Time (Milliseconds)
-	Status      int32  // Message Status
-	IsRead      bool   // Read Status
-	CreatedTime int64  // Creation Time
-	UpdatedTime int64  // Updated Time
+	MsgID          int64 // Server-generated message ID
+	SendID         int64
+	RecvID         int64
+	GroupID        int64  // Group ID (for group messages)
+	ConversationID string // Conversation the message belongs to
+	Seq            int64  // Message Sequence Number
+	ClientMsgID    string // Client-generated message ID
+	SessionType    int32  // Session Type
+	MessageFrom    int32  // Message Source
+	ContentType    int32  // Message Content Type
+	Content        string // Message Content
+	SendTime       int64  // Send Time (Milliseconds)
+	Status         int32  // Message Status
+	IsRead         bool   // Read Status
+	CreatedTime    int64  // Creation Time
+	UpdatedTime    int64  // Updated Time
+}
+
+// messageStatusTransitions lists the statuses each status may move to.
+var messageStatusTransitions = map[int32][]int32{
+	consts.MsgStatusSending:   {consts.MsgStatusSent, consts.MsgStatusFailed},
+	consts.MsgStatusFailed:    {consts.MsgStatusSending},
+	consts.MsgStatusSent:      {consts.MsgStatusDelivered, consts.MsgStatusRevoked, consts.MsgStatusDeleted},
+	consts.MsgStatusDelivered: {consts.MsgStatusRevoked, consts.MsgStatusDeleted},
+	consts.MsgStatusRevoked:   {consts.MsgStatusDeleted},
+}
+
+// CanTransitStatus reports whether a message may move from status from to status to.
+func CanTransitStatus(from, to int32) bool {
+	return slices.Contains(messageStatusTransitions[from], to)
 }
@@ -0,0 +1,36 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/crazyfrankie/goim/types/consts"
+)
+
+func TestCanTransitStatus(t *testing.T) {
+	cases := []struct {
+		name     string
+		from, to int32
+		want     bool
+	}{
+		{name: "sending to sent", from: consts.MsgStatusSending, to: consts.MsgStatusSent, want: true},
+		{name: "sending to failed", from: consts.MsgStatusSending, to: consts.MsgStatusFailed, want: true},
+		{name: "failed to sending", from: consts.MsgStatusFailed, to: consts.MsgStatusSending, want: true},
+		{name: "sent to delivered", from: consts.MsgStatusSent, to: consts.MsgStatusDelivered, want: true},
+		{name: "sent to revoked", from: consts.MsgStatusSent, to: consts.MsgStatusRevoked, want: true},
+		{name: "delivered to deleted", from: consts.MsgStatusDelivered, to: consts.MsgStatusDeleted, want: true},
+		{name: "revoked to deleted", from: consts.MsgStatusRevoked, to: consts.MsgStatusDeleted, want: true},
+		{name: "sending to delivered", from: consts.MsgStatusSending, to: consts.MsgStatusDelivered},
+		{name: "failed to sent", from: consts.MsgStatusFailed, to: consts.MsgStatusSent},
+		{name: "delivered back to sent", from: consts.MsgStatusDelivered, to: consts.MsgStatusSent},
+		{name: "revoked to delivered", from: consts.MsgStatusRevoked, to: consts.MsgStatusDelivered},
+		{name: "deleted is final", from: consts.MsgStatusDeleted, to: consts.MsgStatusRevoked},
+		{name: "unknown status", from: 42, to: consts.MsgStatusSent},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := CanTransitStatus(tc.from, tc.to); got != tc.want {
+				t.Fatalf("CanTransitStatus(%d, %d) = %v, want %v", tc.from, tc.to, got, tc.want)
+			}
+		})
+	}
+}
@@ -2,6 +2,8 @@ package dal
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"gorm.io/gorm"
 
@@ -21,7 +23,112 @@ func (m *MessageDao) Create(ctx context.Context, message *model.Message) error {
 	return m.query.Message.WithContext(ctx).Create(message)
 }
 
-func (m *MessageDao) UpdateMessageStatus(ctx context.Context, status int32) error {
-	_, err := m.query.Message.WithContext(ctx).Update(m.query.Message.Status, status)
-	return err
+func (m *MessageDao) GetMessageByClientMsgID(ctx context.Context, sendID int64, clientMsgID string) (*model.Message, bool, error) {
+	msg := m.query.Message
+	res, err := msg.WithContext(ctx).Where(
+		msg.SendID.Eq(sendID),
+		msg.ClientMsgID.Eq(clientMsgID),
+	).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	return res, true, nil
+}
+
+// UpdateMessagesStatus moves the messages of the conversation to status.
+// fromStatus maps the seqs to the status each message is expected to be in, a
+// message that has changed in the meantime is left alone. It returns the seqs
+// of the messages that were moved.
+func (m *MessageDao) UpdateMessagesStatus(ctx context.Context, conversationID string, fromStatus map[int64]int32, status int32) ([]int64, error) {
+	changed := make([]int64, 0, len(fromStatus))
+	err := m.query.Transaction(func(tx *query.Query) error {
+		msg := tx.Message
+		now := time.Now().UnixMilli()
+		for seq, from := range fromStatus {
+			res, err := msg.WithContext(ctx).Where(
+				msg.ConversationID.Eq(conversationID),
+				msg.Seq.Eq(seq),
+				msg.Status.Eq(from),
+			).Updates(map[string]any{
+				msg.Status.ColumnName().String():      status,
+				msg.UpdatedTime.ColumnName().String(): now,
+			})
+			if err != nil {
+				return err
+			}
+			if res.RowsAffected > 0 {
+				changed = append(changed, seq)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return changed, nil
+}
+
+// GetMessagesBySeqs returns the messages with the given seqs, except those the
+// owner deleted for themselves.
+func (m *MessageDao) GetMessagesBySeqs(ctx context.Context, ownerID int64, conversationID string, seqs []int64) ([]*model.Message, error) {
+	msg := m.query.Message
+	return msg.WithContext(ctx).Where(
+		msg.ConversationID.Eq(conversationID),
+		msg.Seq.In(seqs...),
+		msg.Columns(msg.Seq).NotIn(m.deletedSeqs(ctx, ownerID, conversationID)),
+	).Order(msg.Seq).Find()
+}
+
+// GetMessagesByIDs returns the messages of the conversation with the given
+// server message IDs, except those the owner deleted for themselves.
+func (m *MessageDao) GetMessagesByIDs(ctx context.Context, ownerID int64, conversationID string, msgIDs []int64) ([]*model.Message, error) {
+	msg := m.query.Message
+	return msg.WithContext(ctx).Where(
+		msg.ConversationID.Eq(conversationID),
+		msg.ID.In(msgIDs...),
+		msg.Columns(msg.Seq).NotIn(m.deletedSeqs(ctx, ownerID, conversationID)),
+	).Order(msg.Seq).Find()
+}
+
+// GetMessagesBySeqRange returns at most limit messages with begin <= seq <= end,
+// starting from begin when asc and from end otherwise. Messages the owner
+// deleted for themselves are skipped.
+func (m *MessageDao) GetMessagesBySeqRange(ctx context.Context, ownerID int64, conversationID string, begin, end int64, limit int, asc bool) ([]*model.Message, error) {
+	msg := m.query.Message
+	order := msg.Seq.Desc()
+	if asc {
+		order = msg.Seq
+	}
+
+	return msg.WithContext(ctx).Where(
+		msg.ConversationID.Eq(conversationID),
+		msg.Seq.Between(begin, end),
+		msg.Columns(msg.Seq).NotIn(m.deletedSeqs(ctx, ownerID, conversationID)),
+	).Order(order).Limit(limit).Find()
+}
+
+// GetSenderIDs returns the distinct senders of the messages with begin <= seq <= end.
+func (m *MessageDao) GetSenderIDs(ctx context.Context, conversationID string, begin, end int64) ([]int64, error) {
+	msg := m.query.Message
+	var sendIDs []int64
+	err := msg.WithContext(ctx).Distinct(msg.SendID).Where(
+		msg.ConversationID.Eq(conversationID),
+		msg.Seq.Between(begin, end),
+	).Pluck(msg.SendID, &sendIDs)
+
+	return sendIDs, err
+}
+
+func (m *MessageDao) deletedSeqs(ctx context.Context, ownerID int64, conversationID string) query.IMessageUserDeleteDo {
+	del := m.query.MessageUserDelete
+	return del.WithContext(ctx).Select(del.Seq).Where(
+		del.OwnerID.Eq(ownerID),
+		del.ConversationID.Eq(conversationID),
+	)
 }
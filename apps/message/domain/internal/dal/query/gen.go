@@ -16,34 +16,54 @@ import (
 )
 
 var (
-	Q       = new(Query)
-	Message *message
+	Q                 = new(Query)
+	Message           *message
+	MessageReadSeq    *messageReadSeq
+	MessageUserClear  *messageUserClear
+	MessageUserDelete *messageUserDelete
+	SeqConversation   *seqConversation
 )
 
 func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 	*Q = *Use(db, opts...)
 	Message = &Q.Message
+	MessageReadSeq = &Q.MessageReadSeq
+	MessageUserClear = &Q.MessageUserClear
+	MessageUserDelete = &Q.MessageUserDelete
+	SeqConversation = &Q.SeqConversation
 }
 
 func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 	return &Query{
-		db:      db,
-		Message: newMessage(db, opts...),
+		db:                db,
+		Message:           newMessage(db, opts...),
+		MessageReadSeq:    newMessageReadSeq(db, opts...),
+		MessageUserClear:  newMessageUserClear(db, opts...),
+		MessageUserDelete: newMessageUserDelete(db, opts...),
+		SeqConversation:   newSeqConversation(db, opts...),
 	}
 }
 
 type Query struct {
 	db *gorm.DB
 
-	Message message
+	Message           message
+	MessageReadSeq    messageReadSeq
+	MessageUserClear  messageUserClear
+	MessageUserDelete messageUserDelete
+	SeqConversation   seqConversation
 }
 
 func (q *Query) Available() bool { return q.db != nil }
 
 func (q *Query) clone(db *gorm.DB) *Query {
 	return &Query{
-		db:      db,
-		Message: q.Message.clone(db),
+		db:                db,
+		Message:           q.Message.clone(db),
+		MessageReadSeq:    q.MessageReadSeq.clone(db),
+		MessageUserClear:  q.MessageUserClear.clone(db),
+		MessageUserDelete: q.MessageUserDelete.clone(db),
+		SeqConversation:   q.SeqConversation.clone(db),
 	}
 }
 
@@ -57,18 +77,30 @@ func (q *Query) WriteDB() *Query {
 
 func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 	return &Query{
-		db:      db,
-		Message: q.Message.replaceDB(db),
+		db:                db,
+		Message:           q.Message.replaceDB(db),
+		MessageReadSeq:    q.MessageReadSeq.replaceDB(db),
+		MessageUserClear:  q.MessageUserClear.replaceDB(db),
+		MessageUserDelete: q.MessageUserDelete.replaceDB(db),
+		SeqConversation:   q.SeqConversation.replaceDB(db),
 	}
 }
 
 type queryCtx struct {
-	Message IMessageDo
+	Message           IMessageDo
+	MessageReadSeq    IMessageReadSeqDo
+	MessageUserClear  IMessageUserClearDo
+	MessageUserDelete IMessageUserDeleteDo
+	SeqConversation   ISeqConversationDo
 }
 
 func (q *Query) WithContext(ctx context.Context) *queryCtx {
 	return &queryCtx{
-		Message: q.Message.WithContext(ctx),
+		Message:           q.Message.WithContext(ctx),
+		MessageReadSeq:    q.MessageReadSeq.WithContext(ctx),
+		MessageUserClear:  q.MessageUserClear.WithContext(ctx),
+		MessageUserDelete: q.MessageUserDelete.WithContext(ctx),
+		SeqConversation:   q.SeqConversation.WithContext(ctx),
 	}
 }
 
@@ -32,6 +32,7 @@ func newMessage(db *gorm.DB, opts ...gen.DOOption) message {
 	_message.SendID = field.NewInt64(tableName, "send_id")
 	_message.RecvID = field.NewInt64(tableName, "recv_id")
 	_message.GroupID = field.NewInt64(tableName, "group_id")
+	_message.ConversationID = field.NewString(tableName, "conversation_id")
 	_message.ClientMsgID = field.NewString(tableName, "client_msg_id")
 	_message.SessionType = field.NewInt32(tableName, "session_type")
 	_message.MessageFrom = field.NewInt32(tableName, "message_from")
@@ -53,22 +54,23 @@ func newMessage(db *gorm.DB, opts ...gen.DOOption) message {
 type message struct {
 	messageDo
 
-	ALL         field.Asterisk
-	ID          field.Int64  // Message ID
-	SendID      field.Int64  // Sender ID
-	RecvID      field.Int64  // Receiver ID
-	GroupID     field.Int64  // Group ID
-	ClientMsgID field.String // Client Message ID
-	SessionType field.Int32  // Session Type
-	MessageFrom field.Int32  // Message Source
-	ContentType field.Int32  // Message Content Type
-	Content     field.String // Message Content
-	Seq         field.Int64  // Message Sequence Number
-	SendTime    field.Int64  // Send Time (Milliseconds)
-	Status      field.Int32  // Message Status
-	IsRead      field.Bool   // Message Read Status
-	CreatedTime field.Int64  // Creation Time (Milliseconds)
-	UpdatedTime field.Int64  // Update Time (Milliseconds)
+	ALL            field.Asterisk
+	ID             field.Int64  // Message ID
+	SendID         field.Int64  // Sender ID
+	RecvID         field.Int64  // Receiver ID
+	GroupID        field.Int64  // Group ID
+	ConversationID field.String // Conversation ID
+	ClientMsgID    field.String // Client Message ID
+	SessionType    field.Int32  // Session Type
+	MessageFrom    field.Int32  // Message Source
+	ContentType    field.Int32  // Message Content Type
+	Content        field.String // Message Content
+	Seq            field.Int64  // Message Sequence Number
+	SendTime       field.Int64  // Send Time (Milliseconds)
+	Status         field.Int32  // Message Status
+	IsRead         field.Bool   // Message Read Status
+	CreatedTime    field.Int64  // Creation Time (Milliseconds)
+	UpdatedTime    field.Int64  // Update Time (Milliseconds)
 
 	fieldMap map[string]field.Expr
 }
@@ -89,6 +91,7 @@ func (m *message) updateTableName(table string) *message {
 	m.SendID = field.NewInt64(table, "send_id")
 	m.RecvID = field.NewInt64(table, "recv_id")
 	m.GroupID = field.NewInt64(table, "group_id")
+	m.ConversationID = field.NewString(table, "conversation_id")
 	m.ClientMsgID = field.NewString(table, "client_msg_id")
 	m.SessionType = field.NewInt32(table, "session_type")
 	m.MessageFrom = field.NewInt32(table, "message_from")
@@ -116,11 +119,12 @@ func (m *message) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (m *message) fillFieldMap() {
-	m.fieldMap = make(map[string]field.Expr, 15)
+	m.fieldMap = make(map[string]field.Expr, 16)
 	m.fieldMap["id"] = m.ID
 	m.fieldMap["send_id"] = m.SendID
 	m.fieldMap["recv_id"] = m.RecvID
 	m.fieldMap["group_id"] = m.GroupID
+	m.fieldMap["conversation_id"] = m.ConversationID
 	m.fieldMap["client_msg_id"] = m.ClientMsgID
 	m.fieldMap["session_type"] = m.SessionType
 	m.fieldMap["message_from"] = m.MessageFrom
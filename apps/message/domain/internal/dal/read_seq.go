@@ -0,0 +1,65 @@
+package dal
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/crazyfrankie/goim/apps/message/domain/internal/dal/model"
+	"github.com/crazyfrankie/goim/apps/message/domain/internal/dal/query"
+)
+
+// ReadSeqDao keeps the read cursor of every participant of a conversation.
+type ReadSeqDao struct {
+	query *query.Query
+}
+
+func NewReadSeqDao(db *gorm.DB) *ReadSeqDao {
+	return &ReadSeqDao{query: query.Use(db)}
+}
+
+// MarkAsRead moves the read cursor of the owner forward to hasReadSeq and
+// returns where it was before. The cursor never moves backwards, advanced is
+// false when it already reached hasReadSeq.
+func (r *ReadSeqDao) MarkAsRead(ctx context.Context, ownerID int64, conversationID string, hasReadSeq int64) (prevSeq int64, advanced bool, err error) {
+	err = r.query.Transaction(func(tx *query.Query) error {
+		read := tx.MessageReadSeq
+		cursor, err := read.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Where(
+			read.OwnerID.Eq(ownerID),
+			read.ConversationID.Eq(conversationID),
+		).First()
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		if cursor != nil {
+			prevSeq = cursor.HasReadSeq
+		}
+		if hasReadSeq <= prevSeq {
+			return nil
+		}
+		advanced = true
+
+		return read.WithContext(ctx).Save(&model.MessageReadSeq{
+			OwnerID:        ownerID,
+			ConversationID: conversationID,
+			HasReadSeq:     hasReadSeq,
+			UpdatedTime:    time.Now().UnixMilli(),
+		})
+	})
+
+	return prevSeq, advanced, err
+}
+
+// ListReaders returns a page of the users other than senderID whose read
+// cursor reached seq, the latest readers first.
+func (r *ReadSeqDao) ListReaders(ctx context.Context, conversationID string, seq, senderID int64, offset, limit int) ([]*model.MessageReadSeq, int64, error) {
+	read := r.query.MessageReadSeq
+	return read.WithContext(ctx).Where(
+		read.ConversationID.Eq(conversationID),
+		read.HasReadSeq.Gte(seq),
+		read.OwnerID.Neq(senderID),
+	).Order(read.UpdatedTime.Desc()).FindByPage(offset, limit)
+}
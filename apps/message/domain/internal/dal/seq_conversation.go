@@ -0,0 +1,73 @@
+package dal
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/crazyfrankie/goim/apps/message/domain/internal/dal/model"
+	"github.com/crazyfrankie/goim/apps/message/domain/internal/dal/query"
+)
+
+type SeqConversationDao struct {
+	query *query.Query
+}
+
+func NewSeqConversationDao(db *gorm.DB) *SeqConversationDao {
+	return &SeqConversationDao{query: query.Use(db)}
+}
+
+// Malloc raises the high-water mark of the conversation by size and returns
+// the previous value, so the caller owns the range (prev, prev+size].
+func (s *SeqConversationDao) Malloc(ctx context.Context, conversationID string, size int64) (int64, error) {
+	var maxSeq int64
+	err := s.query.Transaction(func(tx *query.Query) error {
+		seq := tx.SeqConversation
+		now := time.Now().UnixMilli()
+
+		err := seq.WithContext(ctx).Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: seq.ConversationID.ColumnName().String()}},
+			DoUpdates: clause.Assignments(map[string]any{
+				seq.MaxSeq.ColumnName().String():      gorm.Expr("max_seq + ?", size),
+				seq.UpdatedTime.ColumnName().String(): now,
+			}),
+		}).Create(&model.SeqConversation{
+			ConversationID: conversationID,
+			MaxSeq:         size,
+			UpdatedTime:    now,
+		})
+		if err != nil {
+			return err
+		}
+
+		res, err := seq.WithContext(ctx).Where(seq.ConversationID.Eq(conversationID)).First()
+		if err != nil {
+			return err
+		}
+		maxSeq = res.MaxSeq
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return maxSeq - size, nil
+}
+
+// GetMaxSeq returns the high-water mark of the conversation, 0 if none was reserved yet.
+func (s *SeqConversationDao) GetMaxSeq(ctx context.Context, conversationID string) (int64, error) {
+	seq := s.query.SeqConversation
+	res, err := seq.WithContext(ctx).Where(seq.ConversationID.Eq(conversationID)).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return res.MaxSeq, nil
+}
@@ -0,0 +1,75 @@
+package dal
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/crazyfrankie/goim/apps/message/domain/internal/dal/model"
+	"github.com/crazyfrankie/goim/apps/message/domain/internal/dal/query"
+)
+
+// VisibilityDao keeps track of the messages a user removed from their own view
+// of a conversation, the other participants are not affected.
+type VisibilityDao struct {
+	query *query.Query
+}
+
+func NewVisibilityDao(db *gorm.DB) *VisibilityDao {
+	return &VisibilityDao{query: query.Use(db)}
+}
+
+// DeleteMessages hides the given seqs of the conversation from the owner.
+func (v *VisibilityDao) DeleteMessages(ctx context.Context, ownerID int64, conversationID string, seqs []int64) error {
+	now := time.Now().UnixMilli()
+	tombstones := make([]*model.MessageUserDelete, 0, len(seqs))
+	for _, seq := range seqs {
+		tombstones = append(tombstones, &model.MessageUserDelete{
+			OwnerID:        ownerID,
+			ConversationID: conversationID,
+			Seq:            seq,
+			CreatedTime:    now,
+		})
+	}
+
+	return v.query.MessageUserDelete.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(tombstones...)
+}
+
+// ClearConversation hides every message up to seq from the owner. The cursor
+// never moves backwards, so messages once cleared stay hidden.
+func (v *VisibilityDao) ClearConversation(ctx context.Context, ownerID int64, conversationID string, seq int64) error {
+	clear := v.query.MessageUserClear
+	now := time.Now().UnixMilli()
+	return clear.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: clear.OwnerID.ColumnName().String()}, {Name: clear.ConversationID.ColumnName().String()}},
+		DoUpdates: clause.Assignments(map[string]any{
+			clear.ClearedSeq.ColumnName().String():  gorm.Expr("GREATEST(cleared_seq, VALUES(cleared_seq))"),
+			clear.UpdatedTime.ColumnName().String(): now,
+		}),
+	}).Create(&model.MessageUserClear{
+		OwnerID:        ownerID,
+		ConversationID: conversationID,
+		ClearedSeq:     seq,
+		UpdatedTime:    now,
+	})
+}
+
+// GetClearedSeq returns the seq up to which the owner cleared the conversation, 0 if never.
+func (v *VisibilityDao) GetClearedSeq(ctx context.Context, ownerID int64, conversationID string) (int64, error) {
+	clear := v.query.MessageUserClear
+	res, err := clear.WithContext(ctx).Where(
+		clear.OwnerID.Eq(ownerID),
+		clear.ConversationID.Eq(conversationID),
+	).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return res.ClearedSeq, nil
+}
@@ -15,5 +15,10 @@ func NewMessageRepository(db *gorm.DB) MessageRepository {
 
 type MessageRepository interface {
 	Create(ctx context.Context, message *model.Message) error
-	UpdateMessageStatus(ctx context.Context, status int32) error
+	GetMessageByClientMsgID(ctx context.Context, sendID int64, clientMsgID string) (*model.Message, bool, error)
+	UpdateMessagesStatus(ctx context.Context, conversationID string, fromStatus map[int64]int32, status int32) ([]int64, error)
+	GetMessagesBySeqs(ctx context.Context, ownerID int64, conversationID string, seqs []int64) ([]*model.Message, error)
+	GetMessagesByIDs(ctx context.Context, ownerID int64, conversationID string, msgIDs []int64) ([]*model.Message, error)
+	GetMessagesBySeqRange(ctx context.Context, ownerID int64, conversationID string, begin, end int64, limit int, asc bool) ([]*model.Message, error)
+	GetSenderIDs(ctx context.Context, conversationID string, begin, end int64) ([]int64, error)
 }
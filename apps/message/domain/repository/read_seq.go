@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/crazyfrankie/goim/apps/message/domain/internal/dal"
+	"github.com/crazyfrankie/goim/apps/message/domain/internal/dal/model"
+)
+
+func NewReadSeqRepository(db *gorm.DB) ReadSeqRepository {
+	return dal.NewReadSeqDao(db)
+}
+
+type ReadSeqRepository interface {
+	MarkAsRead(ctx context.Context, ownerID int64, conversationID string, hasReadSeq int64) (prevSeq int64, advanced bool, err error)
+	ListReaders(ctx context.Context, conversationID string, seq, senderID int64, offset, limit int) ([]*model.MessageReadSeq, int64, error)
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/crazyfrankie/goim/apps/message/domain/internal/dal"
+)
+
+func NewSeqRepository(db *gorm.DB) SeqRepository {
+	return dal.NewSeqConversationDao(db)
+}
+
+type SeqRepository interface {
+	Malloc(ctx context.Context, conversationID string, size int64) (int64, error)
+	GetMaxSeq(ctx context.Context, conversationID string) (int64, error)
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/crazyfrankie/goim/apps/message/domain/internal/dal"
+)
+
+func NewVisibilityRepository(db *gorm.DB) VisibilityRepository {
+	return dal.NewVisibilityDao(db)
+}
+
+type VisibilityRepository interface {
+	DeleteMessages(ctx context.Context, ownerID int64, conversationID string, seqs []int64) error
+	ClearConversation(ctx context.Context, ownerID int64, conversationID string, seq int64) error
+	GetClearedSeq(ctx context.Context, ownerID int64, conversationID string) (int64, error)
+}
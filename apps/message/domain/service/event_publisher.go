@@ -30,5 +30,11 @@ func (p *messageEventPublisher) PublishMessageEvent(ctx context.Context, event *
 		return err
 	}
 
-	return p.producer.Send(ctx, bytes)
+	var opts []eventbus.SendOpt
+	if event.ConversationID != "" {
+		// Keep the events of a conversation in order.
+		opts = append(opts, eventbus.WithShardingKey(event.ConversationID))
+	}
+
+	return p.producer.Send(ctx, bytes, opts...)
 }
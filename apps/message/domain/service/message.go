@@ -15,11 +15,70 @@ type CreateMessageRequest struct {
 	SessionType int32  // Session Type (1: private, 2: group)
 	MessageFrom int32  // Source of the message (e.g., user, system)
 	ContentType int32  // Type of content (e.g., text, image, etc.)
-	Seq         int64  // Message Sequence Number
 	SendTime    int64  // Send Time (Milliseconds)
 }
 
+type GetMessagesBySeqRangeRequest struct {
+	OwnerID        int64 // User pulling the messages, messages they deleted or cleared are skipped
+	ConversationID string
+	Begin          int64 // First seq of the range (inclusive)
+	End            int64 // Last seq of the range (inclusive), 0 means the newest seq
+	Limit          int32 // Max number of messages returned
+	Asc            bool  // Pull from Begin upwards, otherwise from End downwards
+}
+
+type GetMessagesBySeqRangeResponse struct {
+	Messages []*entity.Message
+	IsEnd    bool // No more messages in the range
+}
+
+type MarkAsReadResponse struct {
+	HasReadSeq int64   // Read cursor of the reader after the call
+	Advanced   bool    // The cursor moved forward
+	SenderIDs  []int64 // Senders of the newly read messages, the reader excluded
+}
+
+type GetMessageReadersRequest struct {
+	Msg  *entity.Message
+	Page int32
+	Size int32
+}
+
+type GetMessageReadersResponse struct {
+	ReaderIDs []int64
+	Total     int64
+}
+
+type UpdateStatusRequest struct {
+	Msgs       []*entity.Message
+	Status     int32 // Target status
+	OperatorID int64
+	ManagedIDs []int64 // Senders the operator manages, e.g. as a group admin, whose messages it may revoke or delete at any time
+}
+
 type Message interface {
-	Create(ctx context.Context, req *CreateMessageRequest) (*entity.Message, error)
-	UpdateMessageStatus(ctx context.Context, status int32) error
+	// Create stores the message. A retry with an already stored ClientMsgID returns
+	// the original message with duplicated set instead of storing it again.
+	Create(ctx context.Context, req *CreateMessageRequest) (msg *entity.Message, duplicated bool, err error)
+	// UpdateStatus moves the messages to req.Status on behalf of req.OperatorID and
+	// returns those that were not in the status already. Nothing changes when
+	// any of the transitions is illegal or not allowed for the operator.
+	UpdateStatus(ctx context.Context, req *UpdateStatusRequest) ([]*entity.Message, error)
+	// GetMessagesBySeqs returns the messages of the seqs that ownerID has not deleted or cleared.
+	GetMessagesBySeqs(ctx context.Context, ownerID int64, conversationID string, seqs []int64) ([]*entity.Message, error)
+	// GetMessagesByIDs is GetMessagesBySeqs by server message IDs.
+	GetMessagesByIDs(ctx context.Context, ownerID int64, conversationID string, msgIDs []int64) ([]*entity.Message, error)
+	GetMessagesBySeqRange(ctx context.Context, req *GetMessagesBySeqRangeRequest) (*GetMessagesBySeqRangeResponse, error)
+	GetMaxSeqs(ctx context.Context, conversationIDs []string) (map[string]int64, error)
+	// DeleteMessages hides the seqs from ownerID only.
+	DeleteMessages(ctx context.Context, ownerID int64, conversationID string, seqs []int64) error
+	// ClearConversation hides every message up to upToSeq from ownerID only and
+	// returns the seq the conversation is cleared up to. upToSeq <= 0 clears
+	// everything sent so far.
+	ClearConversation(ctx context.Context, ownerID int64, conversationID string, upToSeq int64) (int64, error)
+	// MarkAsRead advances the read cursor of ownerID up to hasReadSeq, capped to
+	// the newest seq. hasReadSeq <= 0 marks everything sent so far as read.
+	MarkAsRead(ctx context.Context, ownerID int64, conversationID string, hasReadSeq int64) (*MarkAsReadResponse, error)
+	// GetMessageReaders lists the participants other than the sender who have read the message.
+	GetMessageReaders(ctx context.Context, req *GetMessageReadersRequest) (*GetMessageReadersResponse, error)
 }
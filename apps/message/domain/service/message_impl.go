@@ -2,20 +2,51 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"strconv"
+	"time"
 
 	"gorm.io/gorm"
 
 	"github.com/crazyfrankie/goim/apps/message/domain/entity"
 	"github.com/crazyfrankie/goim/apps/message/domain/internal/dal/model"
 	"github.com/crazyfrankie/goim/apps/message/domain/repository"
+	"github.com/crazyfrankie/goim/infra/contract/cache"
 	"github.com/crazyfrankie/goim/infra/contract/idgen"
+	"github.com/crazyfrankie/goim/pkg/errorx"
+	"github.com/crazyfrankie/goim/pkg/lang/conv"
+	langslice "github.com/crazyfrankie/goim/pkg/lang/slice"
+	"github.com/crazyfrankie/goim/pkg/msgprocessor"
+	"github.com/crazyfrankie/goim/types/consts"
+	"github.com/crazyfrankie/goim/types/errno"
+)
+
+const (
+	sendDedupKeyPrefix   = "msg_dedup:"
+	sendDedupWindow      = 10 * time.Minute
+	maxClientMsgIDLength = 64
+
+	defaultPullLimit = 20
+	maxPullLimit     = 100
+	maxPullSeqs      = 100
+	maxDeleteSeqs    = 100
+
+	defaultReadersPageSize = 20
+	maxReadersPageSize     = 100
 )
 
 type Components struct {
-	MessageRepo repository.MessageRepository
-	DB          *gorm.DB
-	IDGen       idgen.IDGenerator
+	MessageRepo    repository.MessageRepository
+	VisibilityRepo repository.VisibilityRepository
+	ReadSeqRepo    repository.ReadSeqRepository
+	DB             *gorm.DB
+	Cache          cache.Cmdable
+	IDGen          idgen.IDGenerator
+	SeqAlloc       SeqAllocator
+	// RevokeWindow is how long senders may revoke their own messages.
+	RevokeWindow time.Duration
 }
 
 type messageImpl struct {
@@ -26,55 +57,387 @@ func NewMessageDomain(c *Components) Message {
 	return &messageImpl{c}
 }
 
-func (m *messageImpl) Create(ctx context.Context, req *CreateMessageRequest) (*entity.Message, error) {
+func (m *messageImpl) Create(ctx context.Context, req *CreateMessageRequest) (msg *entity.Message, duplicated bool, err error) {
+	if len(req.ClientMsgID) > maxClientMsgIDLength {
+		return nil, false, errorx.New(errno.ErrMessageInvalidParamCode, errorx.KV("msg", "client message id is too long"))
+	}
+	conversationID := msgprocessor.GetConversationIDBySessionType(req.SessionType, req.SendID, req.RecvID, req.GroupID)
+	if conversationID == "" {
+		return nil, false, fmt.Errorf("unsupported session type %d", req.SessionType)
+	}
+
+	if req.ClientMsgID != "" {
+		// Claim the ClientMsgID before any seq is spent, so a retry never leaves a gap.
+		key := sendDedupKey(req.SendID, req.ClientMsgID)
+		var claimed bool
+		claimed, err = m.Cache.SetNX(ctx, key, 1, sendDedupWindow).Result()
+		if err != nil {
+			return nil, false, fmt.Errorf("claim client message id error: %w", err)
+		}
+		if !claimed {
+			return m.getSentMessage(ctx, req.SendID, req.ClientMsgID)
+		}
+		defer func() {
+			if err != nil {
+				// Let the client retry a send that did not go through.
+				_ = m.Cache.Del(ctx, key).Err()
+			}
+		}()
+	}
+
 	msgID, err := m.IDGen.GenID(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("generate id error: %w", err)
+		return nil, false, fmt.Errorf("generate id error: %w", err)
+	}
+	clientMsgID := req.ClientMsgID
+	if clientMsgID == "" {
+		clientMsgID = conv.Int64ToStr(msgID)
+	}
+	seq, err := m.SeqAlloc.Malloc(ctx, conversationID, 1)
+	if err != nil {
+		return nil, false, fmt.Errorf("malloc seq error: %w", err)
 	}
+	now := time.Now().UnixMilli()
 	newMessage := &model.Message{
-		ID:          msgID,
-		SendID:      req.SendID,
-		RecvID:      req.RecvID,
-		GroupID:     req.GroupID,
-		ClientMsgID: req.ClientMsgID,
-		SessionType: req.SessionType,
-		MessageFrom: req.MessageFrom,
-		ContentType: req.ContentType,
-		Content:     req.Content,
-		Seq:         req.Seq,
-		SendTime:    req.SendTime,
+		ID:             msgID,
+		SendID:         req.SendID,
+		RecvID:         req.RecvID,
+		GroupID:        req.GroupID,
+		ConversationID: conversationID,
+		ClientMsgID:    clientMsgID,
+		SessionType:    req.SessionType,
+		MessageFrom:    req.MessageFrom,
+		ContentType:    req.ContentType,
+		Content:        req.Content,
+		Seq:            seq,
+		SendTime:       req.SendTime,
+		Status:         consts.MsgStatusSent,
+		CreatedTime:    now,
+		UpdatedTime:    now,
 	}
 
 	err = m.MessageRepo.Create(ctx, newMessage)
+	if errors.Is(err, gorm.ErrDuplicatedKey) && req.ClientMsgID != "" {
+		// The dedup window has passed but the unique index still knows the message.
+		sent, exist, getErr := m.MessageRepo.GetMessageByClientMsgID(ctx, req.SendID, req.ClientMsgID)
+		if getErr == nil && exist {
+			return messagePO2DO(sent), true, nil
+		}
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	return messagePO2DO(newMessage), false, nil
+}
+
+// getSentMessage returns the message already stored for a retried ClientMsgID.
+func (m *messageImpl) getSentMessage(ctx context.Context, sendID int64, clientMsgID string) (*entity.Message, bool, error) {
+	sent, exist, err := m.MessageRepo.GetMessageByClientMsgID(ctx, sendID, clientMsgID)
+	if err != nil {
+		return nil, false, err
+	}
+	if !exist {
+		// The first attempt holds the claim but has not been stored yet.
+		return nil, false, errorx.New(errno.ErrMessageSendingCode, errorx.KV("client_msg_id", clientMsgID))
+	}
+
+	return messagePO2DO(sent), true, nil
+}
+
+func (m *messageImpl) UpdateStatus(ctx context.Context, req *UpdateStatusRequest) ([]*entity.Message, error) {
+	fromStatus := make(map[int64]int32, len(req.Msgs))
+	conversationID := ""
+	for _, msg := range req.Msgs {
+		if msg.Status == req.Status {
+			continue
+		}
+		if conversationID != "" && msg.ConversationID != conversationID {
+			return nil, errorx.New(errno.ErrMessageInvalidParamCode, errorx.KV("msg", "messages must belong to one conversation"))
+		}
+		conversationID = msg.ConversationID
+
+		if !entity.CanTransitStatus(msg.Status, req.Status) {
+			// Devices confirm deliveries in batches, a message revoked or
+			// deleted in the meantime stays as it is without failing the rest.
+			if req.Status == consts.MsgStatusDelivered {
+				continue
+			}
+			return nil, errorx.New(errno.ErrMessageIllegalStatusTransitionCode,
+				errorx.KV("seq", conv.Int64ToStr(msg.Seq)),
+				errorx.KV("from", strconv.Itoa(int(msg.Status))),
+				errorx.KV("to", strconv.Itoa(int(req.Status))))
+		}
+		if err := m.checkStatusPermission(msg, req); err != nil {
+			return nil, err
+		}
+		fromStatus[msg.Seq] = msg.Status
+	}
+	if len(fromStatus) == 0 {
+		return nil, nil
+	}
+
+	changedSeqs, err := m.MessageRepo.UpdateMessagesStatus(ctx, conversationID, fromStatus, req.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	changed := make([]*entity.Message, 0, len(changedSeqs))
+	for _, msg := range req.Msgs {
+		if slices.Contains(changedSeqs, msg.Seq) {
+			updated := *msg
+			updated.Status = req.Status
+			changed = append(changed, &updated)
+		}
+	}
+
+	return changed, nil
+}
+
+// checkStatusPermission makes sure the operator may move msg to req.Status.
+// Only receivers confirm a delivery, while the delivery state of a send is up
+// to the sender. Revokes and deletes belong to the sender as well, unless the
+// operator manages the sender.
+func (m *messageImpl) checkStatusPermission(msg *entity.Message, req *UpdateStatusRequest) error {
+	isSender := req.OperatorID == msg.SendID
+	managed := slices.Contains(req.ManagedIDs, msg.SendID)
+
+	switch req.Status {
+	case consts.MsgStatusDelivered:
+		if !isSender {
+			return nil
+		}
+	case consts.MsgStatusSending, consts.MsgStatusSent, consts.MsgStatusFailed:
+		if isSender {
+			return nil
+		}
+	case consts.MsgStatusRevoked:
+		if managed {
+			return nil
+		}
+		if !isSender {
+			return errorx.New(errno.ErrMessageRevokeDeniedCode, errorx.KV("seq", conv.Int64ToStr(msg.Seq)))
+		}
+		// SendTime comes from the client, the window starts when the server stored the message.
+		if time.Since(time.UnixMilli(msg.CreatedTime)) > m.RevokeWindow {
+			return errorx.New(errno.ErrMessageRevokeExpiredCode, errorx.KV("window", m.RevokeWindow.String()))
+		}
+		return nil
+	case consts.MsgStatusDeleted:
+		if isSender || managed {
+			return nil
+		}
+	}
+
+	return errorx.New(errno.ErrMessageStatusDeniedCode,
+		errorx.KV("seq", conv.Int64ToStr(msg.Seq)),
+		errorx.KV("status", strconv.Itoa(int(req.Status))))
+}
+
+func (m *messageImpl) GetMessagesBySeqs(ctx context.Context, ownerID int64, conversationID string, seqs []int64) ([]*entity.Message, error) {
+	if len(seqs) == 0 {
+		return nil, nil
+	}
+	if len(seqs) > maxPullSeqs {
+		return nil, errorx.New(errno.ErrMessageInvalidParamCode, errorx.KV("msg", fmt.Sprintf("at most %d seqs can be pulled at once", maxPullSeqs)))
+	}
+
+	clearedSeq, err := m.VisibilityRepo.GetClearedSeq(ctx, ownerID, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	seqs = slices.DeleteFunc(langslice.Unique(seqs), func(seq int64) bool {
+		return seq <= clearedSeq
+	})
+	if len(seqs) == 0 {
+		return nil, nil
+	}
+
+	msgs, err := m.MessageRepo.GetMessagesBySeqs(ctx, ownerID, conversationID, seqs)
+	if err != nil {
+		return nil, err
+	}
+
+	return langslice.Transform(msgs, messagePO2DO), nil
+}
+
+func (m *messageImpl) GetMessagesByIDs(ctx context.Context, ownerID int64, conversationID string, msgIDs []int64) ([]*entity.Message, error) {
+	if len(msgIDs) == 0 {
+		return nil, nil
+	}
+	if len(msgIDs) > maxPullSeqs {
+		return nil, errorx.New(errno.ErrMessageInvalidParamCode, errorx.KV("msg", fmt.Sprintf("at most %d messages can be pulled at once", maxPullSeqs)))
+	}
+
+	clearedSeq, err := m.VisibilityRepo.GetClearedSeq(ctx, ownerID, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	msgs, err := m.MessageRepo.GetMessagesByIDs(ctx, ownerID, conversationID, langslice.Unique(msgIDs))
 	if err != nil {
 		return nil, err
 	}
+	msgs = slices.DeleteFunc(msgs, func(msg *model.Message) bool {
+		return msg.Seq <= clearedSeq
+	})
 
-	return messagePO2DO(newMessage), nil
+	return langslice.Transform(msgs, messagePO2DO), nil
 }
 
-func (m *messageImpl) UpdateMessageStatus(ctx context.Context, status int32) error {
-	err := m.MessageRepo.UpdateMessageStatus(ctx, status)
+func (m *messageImpl) GetMessagesBySeqRange(ctx context.Context, req *GetMessagesBySeqRangeRequest) (*GetMessagesBySeqRangeResponse, error) {
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultPullLimit
+	}
+	limit = min(limit, maxPullLimit)
+
+	clearedSeq, err := m.VisibilityRepo.GetClearedSeq(ctx, req.OwnerID, req.ConversationID)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	begin, end := max(req.Begin, clearedSeq+1, 1), req.End
+	if end <= 0 {
+		maxSeq, err := m.SeqAlloc.GetMaxSeq(ctx, req.ConversationID)
+		if err != nil {
+			return nil, err
+		}
+		end = maxSeq
+	}
+	if begin > end {
+		return &GetMessagesBySeqRangeResponse{IsEnd: true}, nil
 	}
 
-	return nil
+	msgs, err := m.MessageRepo.GetMessagesBySeqRange(ctx, req.OwnerID, req.ConversationID, begin, end, limit, req.Asc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetMessagesBySeqRangeResponse{
+		Messages: langslice.Transform(msgs, messagePO2DO),
+		IsEnd:    len(msgs) < limit,
+	}, nil
+}
+
+func (m *messageImpl) DeleteMessages(ctx context.Context, ownerID int64, conversationID string, seqs []int64) error {
+	seqs = slices.DeleteFunc(langslice.Unique(seqs), func(seq int64) bool {
+		return seq <= 0
+	})
+	if len(seqs) == 0 {
+		return errorx.New(errno.ErrMessageInvalidParamCode, errorx.KV("msg", "no seq to delete"))
+	}
+	if len(seqs) > maxDeleteSeqs {
+		return errorx.New(errno.ErrMessageInvalidParamCode, errorx.KV("msg", fmt.Sprintf("at most %d seqs can be deleted at once", maxDeleteSeqs)))
+	}
+
+	return m.VisibilityRepo.DeleteMessages(ctx, ownerID, conversationID, seqs)
+}
+
+func (m *messageImpl) ClearConversation(ctx context.Context, ownerID int64, conversationID string, upToSeq int64) (int64, error) {
+	maxSeq, err := m.SeqAlloc.GetMaxSeq(ctx, conversationID)
+	if err != nil {
+		return 0, err
+	}
+	// Messages that do not exist yet can never be cleared in advance.
+	if upToSeq <= 0 || upToSeq > maxSeq {
+		upToSeq = maxSeq
+	}
+	if upToSeq == 0 {
+		return 0, nil
+	}
+
+	if err := m.VisibilityRepo.ClearConversation(ctx, ownerID, conversationID, upToSeq); err != nil {
+		return 0, err
+	}
+
+	// An earlier clear may have gone further than upToSeq.
+	return m.VisibilityRepo.GetClearedSeq(ctx, ownerID, conversationID)
+}
+
+func (m *messageImpl) MarkAsRead(ctx context.Context, ownerID int64, conversationID string, hasReadSeq int64) (*MarkAsReadResponse, error) {
+	maxSeq, err := m.SeqAlloc.GetMaxSeq(ctx, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	if hasReadSeq <= 0 || hasReadSeq > maxSeq {
+		hasReadSeq = maxSeq
+	}
+
+	prevSeq, advanced, err := m.ReadSeqRepo.MarkAsRead(ctx, ownerID, conversationID, hasReadSeq)
+	if err != nil {
+		return nil, err
+	}
+	if !advanced {
+		return &MarkAsReadResponse{HasReadSeq: prevSeq}, nil
+	}
+
+	senderIDs, err := m.MessageRepo.GetSenderIDs(ctx, conversationID, prevSeq+1, hasReadSeq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MarkAsReadResponse{
+		HasReadSeq: hasReadSeq,
+		Advanced:   true,
+		SenderIDs: slices.DeleteFunc(senderIDs, func(id int64) bool {
+			return id == ownerID
+		}),
+	}, nil
+}
+
+func (m *messageImpl) GetMessageReaders(ctx context.Context, req *GetMessageReadersRequest) (*GetMessageReadersResponse, error) {
+	page, size := max(int(req.Page), 1), int(req.Size)
+	if size <= 0 {
+		size = defaultReadersPageSize
+	}
+	size = min(size, maxReadersPageSize)
+
+	readers, total, err := m.ReadSeqRepo.ListReaders(ctx, req.Msg.ConversationID, req.Msg.Seq, req.Msg.SendID, (page-1)*size, size)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetMessageReadersResponse{
+		ReaderIDs: langslice.Transform(readers, func(r *model.MessageReadSeq) int64 {
+			return r.OwnerID
+		}),
+		Total: total,
+	}, nil
+}
+
+func (m *messageImpl) GetMaxSeqs(ctx context.Context, conversationIDs []string) (map[string]int64, error) {
+	res := make(map[string]int64, len(conversationIDs))
+	for _, conversationID := range langslice.Unique(conversationIDs) {
+		maxSeq, err := m.SeqAlloc.GetMaxSeq(ctx, conversationID)
+		if err != nil {
+			return nil, err
+		}
+		res[conversationID] = maxSeq
+	}
+
+	return res, nil
 }
 
 func messagePO2DO(msgPO *model.Message) *entity.Message {
 	return &entity.Message{
-		MsgID:       msgPO.ID,
-		SendID:      msgPO.SendID,
-		RecvID:      msgPO.RecvID,
-		GroupID:     msgPO.GroupID,
-		ClientMsgID: msgPO.ClientMsgID,
-		Seq:         msgPO.Seq,
-		Content:     msgPO.Content,
-		SendTime:    msgPO.SendTime,
-		Status:      msgPO.Status,
-		IsRead:      msgPO.IsRead,
-		CreatedTime: msgPO.CreatedTime,
-		UpdatedTime: msgPO.UpdatedTime,
+		MsgID:          msgPO.ID,
+		SendID:         msgPO.SendID,
+		RecvID:         msgPO.RecvID,
+		GroupID:        msgPO.GroupID,
+		ConversationID: msgPO.ConversationID,
+		ClientMsgID:    msgPO.ClientMsgID,
+		SessionType:    msgPO.SessionType,
+		MessageFrom:    msgPO.MessageFrom,
+		ContentType:    msgPO.ContentType,
+		Seq:            msgPO.Seq,
+		Content:        msgPO.Content,
+		SendTime:       msgPO.SendTime,
+		Status:         msgPO.Status,
+		IsRead:         msgPO.IsRead,
+		CreatedTime:    msgPO.CreatedTime,
+		UpdatedTime:    msgPO.UpdatedTime,
 	}
 }
+
+func sendDedupKey(sendID int64, clientMsgID string) string {
+	return sendDedupKeyPrefix + conv.Int64ToStr(sendID) + ":" + clientMsgID
+}
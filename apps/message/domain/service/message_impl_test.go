@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/crazyfrankie/goim/apps/message/domain/entity"
+	"github.com/crazyfrankie/goim/apps/message/domain/repository"
+	"github.com/crazyfrankie/goim/pkg/errorx"
+	"github.com/crazyfrankie/goim/types/consts"
+	"github.com/crazyfrankie/goim/types/errno"
+)
+
+// fakeMessageRepo records the status updates, the methods the tests do not
+// use are left to the nil MessageRepository.
+type fakeMessageRepo struct {
+	repository.MessageRepository
+
+	fromStatus map[int64]int32
+}
+
+func (f *fakeMessageRepo) UpdateMessagesStatus(_ context.Context, _ string, fromStatus map[int64]int32, _ int32) ([]int64, error) {
+	f.fromStatus = fromStatus
+
+	seqs := make([]int64, 0, len(fromStatus))
+	for seq := range fromStatus {
+		seqs = append(seqs, seq)
+	}
+	return seqs, nil
+}
+
+func statusMsg(seq int64, status int32) *entity.Message {
+	return &entity.Message{SendID: 1001, RecvID: 1002, ConversationID: "si_1001_1002", Seq: seq, Status: status,
+		CreatedTime: time.Now().UnixMilli()}
+}
+
+func changedSeqs(msgs []*entity.Message) []int64 {
+	seqs := make([]int64, 0, len(msgs))
+	for _, msg := range msgs {
+		seqs = append(seqs, msg.Seq)
+	}
+	slices.Sort(seqs)
+	return seqs
+}
+
+func TestUpdateStatusDeliveredSkipsFinalMessages(t *testing.T) {
+	repo := &fakeMessageRepo{}
+	m := &messageImpl{&Components{MessageRepo: repo}}
+
+	changed, err := m.UpdateStatus(context.Background(), &UpdateStatusRequest{
+		Msgs: []*entity.Message{
+			statusMsg(1, consts.MsgStatusSent),
+			statusMsg(2, consts.MsgStatusDelivered),
+			statusMsg(3, consts.MsgStatusRevoked),
+			statusMsg(4, consts.MsgStatusDeleted),
+			statusMsg(5, consts.MsgStatusSent),
+		},
+		Status:     consts.MsgStatusDelivered,
+		OperatorID: 1002,
+	})
+	if err != nil {
+		t.Fatalf("update status: %v", err)
+	}
+	if got := changedSeqs(changed); !slices.Equal(got, []int64{1, 5}) {
+		t.Fatalf("got changed seqs %v, want [1 5]", got)
+	}
+	for _, msg := range changed {
+		if msg.Status != consts.MsgStatusDelivered {
+			t.Fatalf("seq %d has status %d, want delivered", msg.Seq, msg.Status)
+		}
+	}
+	if len(repo.fromStatus) != 2 {
+		t.Fatalf("got %d messages updated, want 2", len(repo.fromStatus))
+	}
+}
+
+func TestUpdateStatusRejectsIllegalTransitions(t *testing.T) {
+	m := &messageImpl{&Components{MessageRepo: &fakeMessageRepo{}, RevokeWindow: time.Minute}}
+
+	// Transitions other than deliveries fail as a whole.
+	_, err := m.UpdateStatus(context.Background(), &UpdateStatusRequest{
+		Msgs:       []*entity.Message{statusMsg(1, consts.MsgStatusSent), statusMsg(2, consts.MsgStatusDeleted)},
+		Status:     consts.MsgStatusRevoked,
+		OperatorID: 1001,
+	})
+	if errCode(err) != errno.ErrMessageIllegalStatusTransitionCode {
+		t.Fatalf("got %v, want errCode %d", err, errno.ErrMessageIllegalStatusTransitionCode)
+	}
+}
+
+// errCode returns the status code of err, 0 for nil.
+func errCode(err error) int32 {
+	var statusErr errorx.StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.Code()
+	}
+	if err != nil {
+		return -1
+	}
+	return 0
+}
+
+func TestCheckStatusPermission(t *testing.T) {
+	m := &messageImpl{&Components{RevokeWindow: time.Minute}}
+	const sender, receiver, manager = 1001, 1002, 1003
+
+	cases := []struct {
+		name     string
+		status   int32
+		operator int64
+		managed  []int64
+		want     int32
+	}{
+		{name: "receiver confirms delivery", status: consts.MsgStatusDelivered, operator: receiver},
+		{name: "sender confirms delivery", status: consts.MsgStatusDelivered, operator: sender, want: errno.ErrMessageStatusDeniedCode},
+		{name: "sender reports sent", status: consts.MsgStatusSent, operator: sender},
+		{name: "sender reports failed", status: consts.MsgStatusFailed, operator: sender},
+		{name: "receiver reports sending", status: consts.MsgStatusSending, operator: receiver, want: errno.ErrMessageStatusDeniedCode},
+		{name: "sender revokes", status: consts.MsgStatusRevoked, operator: sender},
+		{name: "receiver revokes", status: consts.MsgStatusRevoked, operator: receiver, want: errno.ErrMessageRevokeDeniedCode},
+		{name: "manager revokes", status: consts.MsgStatusRevoked, operator: manager, managed: []int64{sender}},
+		{name: "sender deletes", status: consts.MsgStatusDeleted, operator: sender},
+		{name: "manager deletes", status: consts.MsgStatusDeleted, operator: manager, managed: []int64{sender}},
+		{name: "receiver deletes", status: consts.MsgStatusDeleted, operator: receiver, want: errno.ErrMessageStatusDeniedCode},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := m.checkStatusPermission(statusMsg(1, consts.MsgStatusSent), &UpdateStatusRequest{
+				Status:     tc.status,
+				OperatorID: tc.operator,
+				ManagedIDs: tc.managed,
+			})
+			if got := errCode(err); got != tc.want {
+				t.Fatalf("got errCode %d (%v), want %d", got, err, tc.want)
+			}
+		})
+	}
+}
+
+func TestRevokeWindow(t *testing.T) {
+	m := &messageImpl{&Components{RevokeWindow: 2 * time.Minute}}
+
+	cases := []struct {
+		name    string
+		age     time.Duration
+		managed []int64
+		want    int32
+	}{
+		{name: "fresh", age: time.Second},
+		{name: "inside the window", age: 2*time.Minute - 5*time.Second},
+		{name: "beyond the window", age: 2*time.Minute + 5*time.Second, want: errno.ErrMessageRevokeExpiredCode},
+		{name: "long ago", age: 24 * time.Hour, want: errno.ErrMessageRevokeExpiredCode},
+		{name: "managed sender beyond the window", age: 24 * time.Hour, managed: []int64{1001}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := statusMsg(1, consts.MsgStatusSent)
+			// The window counts from when the server stored the message, not SendTime.
+			msg.SendTime = time.Now().UnixMilli()
+			msg.CreatedTime = time.Now().Add(-tc.age).UnixMilli()
+
+			err := m.checkStatusPermission(msg, &UpdateStatusRequest{
+				Status:     consts.MsgStatusRevoked,
+				OperatorID: 1001,
+				ManagedIDs: tc.managed,
+			})
+			if got := errCode(err); got != tc.want {
+				t.Fatalf("got errCode %d (%v), want %d", got, err, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,11 @@
+package service
+
+import "context"
+
+// SeqAllocator hands out strictly increasing sequence numbers per conversation.
+type SeqAllocator interface {
+	// Malloc reserves size consecutive seqs and returns the first one.
+	Malloc(ctx context.Context, conversationID string, size int64) (int64, error)
+	// GetMaxSeq returns the last seq handed out in the conversation.
+	GetMaxSeq(ctx context.Context, conversationID string) (int64, error)
+}
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/crazyfrankie/goim/apps/message/domain/repository"
+	"github.com/crazyfrankie/goim/infra/contract/cache"
+)
+
+const (
+	seqKeyPrefix = "seq_conversation:"
+	// seqBlockSize is how many seqs are reserved in MySQL at a time, so the
+	// database is only touched once per block rather than once per message.
+	seqBlockSize   = 100
+	seqMallocRetry = 5
+)
+
+const (
+	seqMallocOK = iota
+	seqMallocMissing
+	seqMallocExhausted
+)
+
+// mallocSeqScript advances curr by ARGV[1] as long as it stays within the block
+// reserved in MySQL (last). It returns {status, first seq}.
+const mallocSeqScript = `
+if redis.call("EXISTS", KEYS[1]) == 0 then
+	return {1, 0}
+end
+local curr = tonumber(redis.call("HGET", KEYS[1], "curr"))
+local last = tonumber(redis.call("HGET", KEYS[1], "last"))
+local size = tonumber(ARGV[1])
+if curr + size > last then
+	return {2, 0}
+end
+redis.call("HINCRBY", KEYS[1], "curr", size)
+return {0, curr + 1}
+`
+
+// reserveSeqScript publishes a freshly reserved block (ARGV[1], ARGV[2]]. When the
+// counter is missing it restarts from the previous high-water mark, otherwise only
+// last is raised so seqs already handed out are never reissued.
+const reserveSeqScript = `
+if redis.call("EXISTS", KEYS[1]) == 0 then
+	redis.call("HSET", KEYS[1], "curr", ARGV[1], "last", ARGV[2])
+	return 1
+end
+local last = tonumber(redis.call("HGET", KEYS[1], "last"))
+if tonumber(ARGV[2]) > last then
+	redis.call("HSET", KEYS[1], "last", ARGV[2])
+end
+return 0
+`
+
+type seqAllocatorImpl struct {
+	cache cache.Cmdable
+	repo  repository.SeqRepository
+}
+
+// NewSeqAllocator returns a SeqAllocator counting in Redis and persisting its
+// high-water mark in MySQL, so that a lost Redis counter resumes above every seq
+// that may have been handed out.
+func NewSeqAllocator(cache cache.Cmdable, repo repository.SeqRepository) SeqAllocator {
+	return &seqAllocatorImpl{cache: cache, repo: repo}
+}
+
+func (s *seqAllocatorImpl) Malloc(ctx context.Context, conversationID string, size int64) (int64, error) {
+	if size <= 0 {
+		return 0, fmt.Errorf("invalid seq malloc size %d", size)
+	}
+
+	key := seqKey(conversationID)
+	for i := 0; i < seqMallocRetry; i++ {
+		res, err := s.cache.Eval(ctx, mallocSeqScript, []string{key}, size).Int64Slice()
+		if err != nil {
+			return 0, fmt.Errorf("malloc seq from cache failed, err=%w", err)
+		}
+		if len(res) != 2 {
+			return 0, fmt.Errorf("malloc seq from cache got unexpected result %v", res)
+		}
+		if res[0] == seqMallocOK {
+			return res[1], nil
+		}
+
+		// The counter is missing or its block is used up, reserve a new one.
+		if err := s.reserve(ctx, conversationID, max(size, seqBlockSize)); err != nil {
+			return 0, err
+		}
+	}
+
+	return 0, fmt.Errorf("malloc seq for conversation %s failed after %d retries", conversationID, seqMallocRetry)
+}
+
+func (s *seqAllocatorImpl) reserve(ctx context.Context, conversationID string, size int64) error {
+	prev, err := s.repo.Malloc(ctx, conversationID, size)
+	if err != nil {
+		return fmt.Errorf("reserve seq block failed, err=%w", err)
+	}
+
+	err = s.cache.Eval(ctx, reserveSeqScript, []string{seqKey(conversationID)}, prev, prev+size).Err()
+	if err != nil {
+		return fmt.Errorf("publish seq block failed, err=%w", err)
+	}
+
+	return nil
+}
+
+func (s *seqAllocatorImpl) GetMaxSeq(ctx context.Context, conversationID string) (int64, error) {
+	seq, err := s.cache.HGet(ctx, seqKey(conversationID), "curr").Int64()
+	if errors.Is(err, cache.Nil) {
+		// Nothing cached, the high-water mark is an upper bound of what was handed out.
+		return s.repo.GetMaxSeq(ctx, conversationID)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return seq, nil
+}
+
+func seqKey(conversationID string) string {
+	return seqKeyPrefix + conversationID
+}
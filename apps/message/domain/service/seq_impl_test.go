@@ -0,0 +1,223 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/crazyfrankie/goim/apps/message/domain/repository"
+	"github.com/crazyfrankie/goim/infra/contract/cache"
+)
+
+func init() {
+	// The redis implementation installs its nil error; stand in for it here.
+	if cache.Nil == nil {
+		cache.SetDefaultNilError(errors.New("fake cache: nil"))
+	}
+}
+
+// fakeSeqCache runs the seq scripts in memory, standing in for the Redis
+// instance every allocator shares.
+type fakeSeqCache struct {
+	cache.Cmdable
+
+	mu   sync.Mutex
+	curr map[string]int64
+	last map[string]int64
+}
+
+type fakeCmd struct {
+	res []int64
+}
+
+func (c *fakeCmd) Err() error                   { return nil }
+func (c *fakeCmd) Result() (interface{}, error) { return c.res, nil }
+func (c *fakeCmd) Int64Slice() ([]int64, error) { return c.res, nil }
+
+type fakeStringCmd struct {
+	cache.StringCmd
+
+	val string
+	err error
+}
+
+func (c *fakeStringCmd) Int64() (int64, error) {
+	if c.err != nil {
+		return 0, c.err
+	}
+	return strconv.ParseInt(c.val, 10, 64)
+}
+
+func (f *fakeSeqCache) Eval(_ context.Context, script string, keys []string, args ...interface{}) cache.Cmd {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	key := keys[0]
+	curr, ok := f.curr[key]
+	switch script {
+	case mallocSeqScript:
+		size := args[0].(int64)
+		if !ok {
+			return &fakeCmd{res: []int64{seqMallocMissing, 0}}
+		}
+		if curr+size > f.last[key] {
+			return &fakeCmd{res: []int64{seqMallocExhausted, 0}}
+		}
+		f.curr[key] = curr + size
+		return &fakeCmd{res: []int64{seqMallocOK, curr + 1}}
+	case reserveSeqScript:
+		if !ok {
+			f.curr[key] = args[0].(int64)
+		}
+		f.last[key] = max(f.last[key], args[1].(int64))
+		return &fakeCmd{res: []int64{0}}
+	}
+	panic("unexpected script")
+}
+
+func (f *fakeSeqCache) HGet(_ context.Context, key, field string) cache.StringCmd {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	curr, ok := f.curr[key]
+	if !ok || field != "curr" {
+		return &fakeStringCmd{err: cache.Nil}
+	}
+	return &fakeStringCmd{val: strconv.FormatInt(curr, 10)}
+}
+
+// lose drops the conversation's hash, as if Redis had been flushed.
+func (f *fakeSeqCache) lose(conversationID string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delete(f.curr, seqKeyPrefix+conversationID)
+	delete(f.last, seqKeyPrefix+conversationID)
+}
+
+type fakeSeqRepo struct {
+	repository.SeqRepository
+
+	mu   sync.Mutex
+	last map[string]int64
+}
+
+func (f *fakeSeqRepo) Malloc(_ context.Context, conversationID string, size int64) (int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	prev := f.last[conversationID]
+	f.last[conversationID] = prev + size
+	return prev, nil
+}
+
+func (f *fakeSeqRepo) GetMaxSeq(_ context.Context, conversationID string) (int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.last[conversationID], nil
+}
+
+func newFakeSeqStores() (*fakeSeqCache, *fakeSeqRepo) {
+	return &fakeSeqCache{curr: make(map[string]int64), last: make(map[string]int64)},
+		&fakeSeqRepo{last: make(map[string]int64)}
+}
+
+func TestSeqAllocatorSharedCounter(t *testing.T) {
+	c, repo := newFakeSeqStores()
+	allocs := []SeqAllocator{NewSeqAllocator(c, repo), NewSeqAllocator(c, repo)}
+
+	const workers, perWorker = 8, 200
+	var (
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		seen  = make(map[int64]bool)
+		total int64
+	)
+	for w := range workers {
+		alloc := allocs[w%len(allocs)]
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			var prev int64
+			for i := range perWorker {
+				size := int64(1 + (w+i)%3)
+				seq, err := alloc.Malloc(context.Background(), "sg_1", size)
+				if err != nil {
+					t.Errorf("malloc: %v", err)
+					return
+				}
+				if seq <= prev {
+					t.Errorf("seq %d does not follow %d", seq, prev)
+					return
+				}
+				prev = seq + size - 1
+
+				mu.Lock()
+				for s := seq; s <= prev; s++ {
+					if seen[s] {
+						t.Errorf("seq %d handed out twice", s)
+					}
+					seen[s] = true
+				}
+				total += size
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	for s := int64(1); s <= total; s++ {
+		if !seen[s] {
+			t.Fatalf("seq %d was skipped", s)
+		}
+	}
+	for _, alloc := range allocs {
+		maxSeq, err := alloc.GetMaxSeq(context.Background(), "sg_1")
+		if err != nil {
+			t.Fatalf("get max seq: %v", err)
+		}
+		if maxSeq != total {
+			t.Fatalf("max seq = %d, want %d", maxSeq, total)
+		}
+	}
+}
+
+func TestSeqAllocatorRecoversFromRepository(t *testing.T) {
+	ctx := context.Background()
+	c, repo := newFakeSeqStores()
+	alloc := NewSeqAllocator(c, repo)
+
+	var last int64
+	for range 10 {
+		seq, err := alloc.Malloc(ctx, "si_1_2", 1)
+		if err != nil {
+			t.Fatalf("malloc: %v", err)
+		}
+		last = seq
+	}
+
+	c.lose("si_1_2")
+
+	maxSeq, err := alloc.GetMaxSeq(ctx, "si_1_2")
+	if err != nil {
+		t.Fatalf("get max seq: %v", err)
+	}
+	if maxSeq < last {
+		t.Fatalf("max seq after losing the cache = %d, below handed out %d", maxSeq, last)
+	}
+
+	seq, err := alloc.Malloc(ctx, "si_1_2", 1)
+	if err != nil {
+		t.Fatalf("malloc: %v", err)
+	}
+	if seq <= last {
+		t.Fatalf("seq %d after losing the cache reuses %d", seq, last)
+	}
+	if want := maxSeq + 1; seq != want {
+		t.Fatalf("seq = %d, want %d resumed from the high-water mark", seq, want)
+	}
+}
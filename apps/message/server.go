@@ -18,11 +18,17 @@ func Start(ctx context.Context, client discovery.SvcDiscoveryRegistry, srv grpc.
 		return err
 	}
 	messageRepo := repository.NewMessageRepository(basic.DB)
+	seqAlloc := service.NewSeqAllocator(basic.Cache, repository.NewSeqRepository(basic.DB))
 	messageDomain := service.NewMessageDomain(&service.Components{
-		MessageRepo: messageRepo,
-		IDGen:       basic.IDGen,
+		MessageRepo:    messageRepo,
+		VisibilityRepo: repository.NewVisibilityRepository(basic.DB),
+		ReadSeqRepo:    repository.NewReadSeqRepository(basic.DB),
+		Cache:          basic.Cache,
+		IDGen:          basic.IDGen,
+		SeqAlloc:       seqAlloc,
+		RevokeWindow:   basic.RevokeWindow,
 	})
-	appService := application.NewMessageApplicationService(messageDomain)
+	appService := application.NewMessageApplicationService(messageDomain, basic.MessageEventBus, basic.ConversationInternalCli, basic.GroupCli, basic.RelationCli)
 
 	messagev1.RegisterMessageServiceServer(srv, appService)
 
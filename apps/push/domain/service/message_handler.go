@@ -2,19 +2,43 @@ package service
 
 import (
 	"context"
+	"sync"
+	"time"
 
+	"google.golang.org/grpc"
+
+	"github.com/crazyfrankie/goim/infra/contract/discovery"
 	"github.com/crazyfrankie/goim/infra/contract/eventbus"
+	"github.com/crazyfrankie/goim/infra/contract/offlinepush"
 	"github.com/crazyfrankie/goim/internal/events/message"
+	"github.com/crazyfrankie/goim/internal/route"
+	"github.com/crazyfrankie/goim/pkg/lang/conv"
+	langslice "github.com/crazyfrankie/goim/pkg/lang/slice"
 	"github.com/crazyfrankie/goim/pkg/logs"
 	"github.com/crazyfrankie/goim/pkg/sonic"
+	conversationv1 "github.com/crazyfrankie/goim/protocol/conversation/v1"
+	gatewayv1 "github.com/crazyfrankie/goim/protocol/gateway/v1"
+	"github.com/crazyfrankie/goim/types/consts"
 )
 
+// gatewayPushTimeout bounds a push to a single gateway node.
+const gatewayPushTimeout = 5 * time.Second
+
 type MessageEventHandler struct {
-	// 推送相关的依赖
+	client          discovery.SvcDiscoveryRegistry
+	conversationCli conversationv1.ConversationServiceClient
+	offlinePusher   offlinepush.OfflinePusher
+	routeTable      *route.Table
 }
 
-func NewMessageEventHandler() *MessageEventHandler {
-	return &MessageEventHandler{}
+func NewMessageEventHandler(client discovery.SvcDiscoveryRegistry, conversationCli conversationv1.ConversationServiceClient,
+	offlinePusher offlinepush.OfflinePusher, routeTable *route.Table) *MessageEventHandler {
+	return &MessageEventHandler{
+		client:          client,
+		conversationCli: conversationCli,
+		offlinePusher:   offlinePusher,
+		routeTable:      routeTable,
+	}
 }
 
 func (h *MessageEventHandler) HandleMessage(ctx context.Context, msg *eventbus.Message) error {
@@ -31,6 +55,9 @@ func (h *MessageEventHandler) HandleMessage(ctx context.Context, msg *eventbus.M
 		return h.handleMessageRead(ctx, &event)
 	case message.MessageDeleted:
 		return h.handleMessageDeleted(ctx, &event)
+	case message.MessageSending, message.MessageDelivered, message.MessageFailed, message.MessageRevoked:
+		// Status changes carry a notification addressed to the users concerned.
+		return h.pushToGateways(ctx, &event)
 	default:
 		logs.Warnf("unknown event type: %d", event.EventType)
 		return nil
@@ -38,17 +65,146 @@ func (h *MessageEventHandler) HandleMessage(ctx context.Context, msg *eventbus.M
 }
 
 func (h *MessageEventHandler) handleMessageSent(ctx context.Context, event *message.MessageEvent) error {
-	// TODO , implement the message push logic
-	logs.Infof("pushing message %d to user %d", event.MessageID, event.UserID)
+	reached, err := h.pushOnline(ctx, event)
+	if err != nil {
+		return err
+	}
+
+	h.pushOffline(ctx, event, reached)
 	return nil
 }
 
+func (h *MessageEventHandler) pushToGateways(ctx context.Context, event *message.MessageEvent) error {
+	_, err := h.pushOnline(ctx, event)
+	return err
+}
+
+// pushOnline offers event.Msg to the gateway nodes holding connections of
+// the users in event.PushToUserIDs. It returns the users reached on a
+// connection in the foreground.
+func (h *MessageEventHandler) pushOnline(ctx context.Context, event *message.MessageEvent) (map[int64]bool, error) {
+	if event.Msg == nil || len(event.PushToUserIDs) == 0 {
+		return nil, nil
+	}
+
+	conns, err := h.client.GetConns(ctx, consts.MsgGatewayServiceName)
+	if err != nil {
+		return nil, err
+	}
+	userIDs := langslice.Transform(event.PushToUserIDs, conv.Int64ToStr)
+	targets := h.routeTargets(ctx, conns, userIDs)
+
+	// Online push is best effort, users that miss it pull the message by seq
+	// when they come back.
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		reached = make(map[int64]bool)
+	)
+	for _, t := range targets {
+		wg.Add(1)
+		go func(t *gatewayTarget) {
+			defer wg.Done()
+
+			pushCtx, cancel := context.WithTimeout(ctx, gatewayPushTimeout)
+			defer cancel()
+			resp, err := gatewayv1.NewGatewayServiceClient(t.conn).SuperGroupOnlineBatchPushOneMsg(pushCtx, &gatewayv1.OnlineBatchPushOneMsgRequest{
+				PushToUserIDs: t.userIDs,
+				MsgData:       event.Msg,
+			})
+			if err != nil {
+				logs.CtxWarnf(ctx, "push event %d of message %d to gateway failed, err=%v", event.EventType, event.MessageID, err)
+				return
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			for _, res := range resp.GetSinglePushResult() {
+				if reachedInForeground(res) {
+					userID, _ := conv.StrToInt64(res.GetUserID())
+					reached[userID] = true
+				}
+			}
+		}(t)
+	}
+	wg.Wait()
+
+	logs.CtxDebugf(ctx, "pushed event %d of message %d to %d users through %d gateways", event.EventType, event.MessageID, len(event.PushToUserIDs), len(targets))
+	return reached, nil
+}
+
+// gatewayTarget is a gateway node and the users to push to on it.
+type gatewayTarget struct {
+	conn    grpc.ClientConnInterface
+	userIDs []string
+}
+
+// routeTargets splits userIDs by the gateway nodes holding their connections.
+// Users without a route are not connected anywhere and are left out. When the
+// route table can not be read, every node is asked about every user, since
+// each node only pushes to the clients connected to it.
+func (h *MessageEventHandler) routeTargets(ctx context.Context, conns []grpc.ClientConnInterface, userIDs []string) []*gatewayTarget {
+	broadcast := func() []*gatewayTarget {
+		return langslice.Transform(conns, func(cc grpc.ClientConnInterface) *gatewayTarget {
+			return &gatewayTarget{conn: cc, userIDs: userIDs}
+		})
+	}
+	if h.routeTable == nil {
+		return broadcast()
+	}
+
+	routes, err := h.routeTable.Lookup(ctx, userIDs)
+	if err != nil {
+		logs.CtxWarnf(ctx, "lookup gateway routes failed, push to all gateways, err=%v", err)
+		return broadcast()
+	}
+
+	nodes := make(map[string]*gatewayTarget, len(conns))
+	for _, cc := range conns {
+		if cli, ok := cc.(*grpc.ClientConn); ok {
+			nodes[cli.Target()] = &gatewayTarget{conn: cc}
+		}
+	}
+	var targets []*gatewayTarget
+	for _, userID := range userIDs {
+		for _, r := range routes[userID] {
+			t, ok := nodes[r.NodeID]
+			if !ok {
+				// The node left discovery, its entries expire on their own.
+				continue
+			}
+			if len(t.userIDs) == 0 {
+				targets = append(targets, t)
+			}
+			t.userIDs = append(t.userIDs, userID)
+		}
+	}
+
+	return targets
+}
+
+// reachedInForeground reports whether the message got to a connection the
+// user is looking at. Backgrounded mobile apps may be suspended by the system
+// at any time, so they count as offline.
+func reachedInForeground(res *gatewayv1.SingleMsgToUserResults) bool {
+	for _, platform := range res.GetResp() {
+		if platform.GetResultCode() == 0 && !platform.GetIsBackground() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// handleMessageRead pushes a read receipt, event.Msg carries the new read
+// cursor of the reader.
 func (h *MessageEventHandler) handleMessageRead(ctx context.Context, event *message.MessageEvent) error {
-	// 实现已读状态推送逻辑
-	return nil
+	return h.pushToGateways(ctx, event)
 }
 
+// handleMessageDeleted pushes a deletion, either one a user made for themselves
+// to their other devices or a message deleted for everyone to the
+// participants. event.Msg carries the notification.
 func (h *MessageEventHandler) handleMessageDeleted(ctx context.Context, event *message.MessageEvent) error {
-	// TODO
-	return nil
+	return h.pushToGateways(ctx, event)
 }
@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"unicode/utf8"
+
+	"github.com/crazyfrankie/goim/internal/events/message"
+	"github.com/crazyfrankie/goim/pkg/apistruct"
+	"github.com/crazyfrankie/goim/pkg/grpc/ctxutil"
+	"github.com/crazyfrankie/goim/pkg/lang/conv"
+	"github.com/crazyfrankie/goim/pkg/logs"
+	"github.com/crazyfrankie/goim/pkg/sonic"
+	conversationv1 "github.com/crazyfrankie/goim/protocol/conversation/v1"
+	messagev1 "github.com/crazyfrankie/goim/protocol/message/v1"
+	"github.com/crazyfrankie/goim/types/consts"
+)
+
+const (
+	offlinePushTitle      = "New message"
+	maxOfflinePushBodyLen = 100
+)
+
+// offlinePushEx tells the device which message the notification is about.
+type offlinePushEx struct {
+	ConversationID string `json:"conversationID"`
+	ServerMsgID    string `json:"serverMsgID"`
+	Seq            int64  `json:"seq"`
+	SessionType    int32  `json:"sessionType"`
+}
+
+// offlinePushPlaceholders stands in for the content of messages that have no
+// text to show.
+var offlinePushPlaceholders = map[int32]string{
+	consts.PictureMessageType:  "[Picture]",
+	consts.VoiceMessageType:    "[Voice]",
+	consts.VideoMessageType:    "[Video]",
+	consts.FileMessageType:     "[File]",
+	consts.AtTextMessageType:   "[Mention]",
+	consts.MergerMessageType:   "[Chat History]",
+	consts.CardMessageType:     "[Card]",
+	consts.LocationMessageType: "[Location]",
+	consts.CustomMessageType:   "[Custom Message]",
+	consts.QuoteMessageType:    "[Quote]",
+	consts.OANotification:      "[Notification]",
+}
+
+// pushOffline notifies the receivers of event.Msg who were not reached in the
+// foreground through the offline pusher, unless they muted the conversation.
+// The message is stored already, so failures are only logged.
+func (h *MessageEventHandler) pushOffline(ctx context.Context, event *message.MessageEvent, reached map[int64]bool) {
+	msg := event.Msg
+	if msg == nil || msg.GetSeq() == 0 {
+		// Only stored messages are worth waking a device up for.
+		return
+	}
+	if msg.GetContentType() == consts.RevokeMessageType || msg.GetContentType() == consts.TypingMessageType {
+		return
+	}
+
+	userIDs := slices.DeleteFunc(slices.Clone(event.PushToUserIDs), func(id int64) bool {
+		// The sender wrote the message, its other devices need no notification.
+		return id == msg.GetSendID() || reached[id]
+	})
+	if len(userIDs) == 0 {
+		return
+	}
+
+	outCtx := ctxutil.WithOutgoingUserID(ctx, conv.Int64ToStr(msg.GetSendID()))
+	resp, err := h.conversationCli.GetMutedOwnerIDs(outCtx, &conversationv1.GetMutedOwnerIDsRequest{
+		ConversationID: msg.GetConversationID(),
+		OwnerIDs:       userIDs,
+	})
+	if err != nil {
+		logs.CtxErrorf(ctx, "get muted owners of conversation %s failed, err=%v", msg.GetConversationID(), err)
+		return
+	}
+	muted := resp.GetMutedOwnerIDs()
+	userIDs = slices.DeleteFunc(userIDs, func(id int64) bool {
+		return slices.Contains(muted, id)
+	})
+	if len(userIDs) == 0 {
+		return
+	}
+
+	ex, err := sonic.MarshalString(&offlinePushEx{
+		ConversationID: msg.GetConversationID(),
+		ServerMsgID:    conv.Int64ToStr(msg.GetServerMsgID()),
+		Seq:            msg.GetSeq(),
+		SessionType:    msg.GetSessionType(),
+	})
+	if err != nil {
+		logs.CtxErrorf(ctx, "marshal offline push of message %d failed, err=%v", msg.GetServerMsgID(), err)
+		return
+	}
+
+	if err := h.offlinePusher.Push(ctx, userIDs, offlinePushTitle, offlinePushBody(msg), ex); err != nil {
+		logs.CtxWarnf(ctx, "offline push of message %d to %d users failed, err=%v", msg.GetServerMsgID(), len(userIDs), err)
+	}
+}
+
+// offlinePushBody returns the text shown in the notification of msg.
+func offlinePushBody(msg *messagev1.Message) string {
+	if msg.GetContentType() != consts.TextMessageType {
+		if placeholder, ok := offlinePushPlaceholders[msg.GetContentType()]; ok {
+			return placeholder
+		}
+		return "[Message]"
+	}
+
+	var text apistruct.TextElem
+	if err := sonic.Unmarshal(msg.GetContent(), &text); err != nil || text.Content == "" {
+		return "[Message]"
+	}
+	if utf8.RuneCountInString(text.Content) > maxOfflinePushBodyLen {
+		return string([]rune(text.Content)[:maxOfflinePushBodyLen]) + "..."
+	}
+
+	return text.Content
+}
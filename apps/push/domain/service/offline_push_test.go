@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"google.golang.org/grpc"
+
+	"github.com/crazyfrankie/goim/infra/impl/offlinepush/mock"
+	"github.com/crazyfrankie/goim/internal/events/message"
+	conversationv1 "github.com/crazyfrankie/goim/protocol/conversation/v1"
+	gatewayv1 "github.com/crazyfrankie/goim/protocol/gateway/v1"
+	messagev1 "github.com/crazyfrankie/goim/protocol/message/v1"
+	"github.com/crazyfrankie/goim/types/consts"
+)
+
+type fakeConversationClient struct {
+	conversationv1.ConversationServiceClient
+
+	mutedIDs []int64
+}
+
+func (f *fakeConversationClient) GetMutedOwnerIDs(ctx context.Context, req *conversationv1.GetMutedOwnerIDsRequest, opts ...grpc.CallOption) (*conversationv1.GetMutedOwnerIDsResponse, error) {
+	var muted []int64
+	for _, id := range req.GetOwnerIDs() {
+		if slices.Contains(f.mutedIDs, id) {
+			muted = append(muted, id)
+		}
+	}
+
+	return &conversationv1.GetMutedOwnerIDsResponse{MutedOwnerIDs: muted}, nil
+}
+
+func TestPushOffline(t *testing.T) {
+	recorder := mock.NewRecorder()
+	h := NewMessageEventHandler(nil, &fakeConversationClient{mutedIDs: []int64{4}}, recorder, nil)
+
+	event := &message.MessageEvent{
+		EventType:     message.MessageSent,
+		PushToUserIDs: []int64{1, 2, 3, 4},
+		Msg: &messagev1.Message{
+			SendID:         1,
+			ConversationID: "sg_100",
+			SessionType:    consts.GroupChatType,
+			ContentType:    consts.TextMessageType,
+			Content:        []byte(`{"content":"hello"}`),
+			ServerMsgID:    10,
+			Seq:            5,
+		},
+	}
+	// 2 is online, 1 sent the message and 4 muted the conversation.
+	h.pushOffline(context.Background(), event, map[int64]bool{2: true})
+
+	pushes := recorder.Pushes()
+	if len(pushes) != 1 {
+		t.Fatalf("got %d pushes, want 1", len(pushes))
+	}
+	if !slices.Equal(pushes[0].UserIDs, []int64{3}) {
+		t.Errorf("pushed to %v, want [3]", pushes[0].UserIDs)
+	}
+	if pushes[0].Body != "hello" {
+		t.Errorf("body = %q, want %q", pushes[0].Body, "hello")
+	}
+}
+
+func TestPushOfflineSkipsUnstoredMessages(t *testing.T) {
+	recorder := mock.NewRecorder()
+	h := NewMessageEventHandler(nil, &fakeConversationClient{}, recorder, nil)
+
+	h.pushOffline(context.Background(), &message.MessageEvent{
+		PushToUserIDs: []int64{2},
+		Msg:           &messagev1.Message{SendID: 1, ContentType: consts.HasReadReceipt},
+	}, nil)
+
+	if pushes := recorder.Pushes(); len(pushes) != 0 {
+		t.Fatalf("got %d pushes for a message without seq, want 0", len(pushes))
+	}
+}
+
+func TestReachedInForeground(t *testing.T) {
+	tests := []struct {
+		name string
+		resp []*gatewayv1.SingleMsgToUserPlatform
+		want bool
+	}{
+		{"no connection", nil, false},
+		{"foreground", []*gatewayv1.SingleMsgToUserPlatform{{RecvPlatformID: 5}}, true},
+		{"background only", []*gatewayv1.SingleMsgToUserPlatform{
+			{RecvPlatformID: 3, IsBackground: true},
+			{RecvPlatformID: 2, ResultCode: 501, IsBackground: true},
+		}, false},
+		{"failed push", []*gatewayv1.SingleMsgToUserPlatform{{RecvPlatformID: 5, ResultCode: 500}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := reachedInForeground(&gatewayv1.SingleMsgToUserResults{UserID: "1", Resp: tt.resp})
+			if got != tt.want {
+				t.Errorf("reachedInForeground() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package push
+
+import (
+	"context"
+	"os"
+
+	"google.golang.org/grpc"
+
+	"github.com/crazyfrankie/goim/apps/push/domain/service"
+	"github.com/crazyfrankie/goim/infra/contract/discovery"
+	"github.com/crazyfrankie/goim/infra/impl/cache/redis"
+	"github.com/crazyfrankie/goim/infra/impl/eventbus"
+	"github.com/crazyfrankie/goim/infra/impl/offlinepush"
+	"github.com/crazyfrankie/goim/internal/route"
+	conversationv1 "github.com/crazyfrankie/goim/protocol/conversation/v1"
+	"github.com/crazyfrankie/goim/types/consts"
+)
+
+// Start subscribes the push worker to the message topic. The worker serves no
+// RPC of its own, it only uses the discovery client to reach the gateways and
+// the conversation service, and the route table to pick the gateways.
+func Start(ctx context.Context, client discovery.SvcDiscoveryRegistry, srv grpc.ServiceRegistrar) error {
+	conversationCC, err := client.GetConn(ctx, consts.ConversationServiceName)
+	if err != nil {
+		return err
+	}
+	offlinePusher, err := offlinepush.New()
+	if err != nil {
+		return err
+	}
+
+	handler := service.NewMessageEventHandler(client, conversationv1.NewConversationServiceClient(conversationCC),
+		offlinePusher, route.NewTable(redis.New()))
+	nameServer := os.Getenv(consts.MQServer)
+
+	return eventbus.NewConsumerService().RegisterConsumer(nameServer, consts.RMQTopicMessage, consts.RMQConsumeGroupMessage, handler)
+}
@@ -0,0 +1,26 @@
+package application
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/crazyfrankie/goim/infra/contract/discovery"
+	"github.com/crazyfrankie/goim/infra/impl/mysql"
+)
+
+type BasicServices struct {
+	DB *gorm.DB
+}
+
+func Init(ctx context.Context, client discovery.SvcDiscoveryRegistry) (*BasicServices, error) {
+	basic := &BasicServices{}
+	var err error
+
+	basic.DB, err = mysql.New("MYSQL_DSN")
+	if err != nil {
+		return nil, err
+	}
+
+	return basic, nil
+}
@@ -0,0 +1,155 @@
+package application
+
+import (
+	"context"
+
+	"github.com/crazyfrankie/goim/apps/relation/domain/entity"
+	relation "github.com/crazyfrankie/goim/apps/relation/domain/service"
+	"github.com/crazyfrankie/goim/pkg/grpc/ctxutil"
+	langslice "github.com/crazyfrankie/goim/pkg/lang/slice"
+	relationv1 "github.com/crazyfrankie/goim/protocol/relation/v1"
+)
+
+type RelationApplicationService struct {
+	relationDomain relation.Relation
+	relationv1.UnimplementedRelationServiceServer
+}
+
+func NewRelationApplicationService(relationDomain relation.Relation) relationv1.RelationServiceServer {
+	return &RelationApplicationService{relationDomain: relationDomain}
+}
+
+func (r *RelationApplicationService) ApplyToAddFriend(ctx context.Context, req *relationv1.ApplyToAddFriendRequest) (*relationv1.ApplyToAddFriendResponse, error) {
+	err := r.relationDomain.ApplyToAddFriend(ctx, ctxutil.MustGetUserIDFromCtx(ctx), req.GetToUserID(), req.GetGreeting())
+	if err != nil {
+		return nil, err
+	}
+
+	return &relationv1.ApplyToAddFriendResponse{}, nil
+}
+
+func (r *RelationApplicationService) ListFriendRequests(ctx context.Context, req *relationv1.ListFriendRequestsRequest) (*relationv1.ListFriendRequestsResponse, error) {
+	res, err := r.relationDomain.ListFriendRequests(ctx, &relation.ListFriendRequestsRequest{
+		UserID: ctxutil.MustGetUserIDFromCtx(ctx),
+		Sent:   req.GetSent(),
+		Page:   req.GetPage(),
+		Size:   req.GetSize(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &relationv1.ListFriendRequestsResponse{
+		Requests: langslice.Transform(res.Requests, friendRequestDO2DTO),
+		Total:    res.Total,
+	}, nil
+}
+
+func (r *RelationApplicationService) HandleFriendRequest(ctx context.Context, req *relationv1.HandleFriendRequestRequest) (*relationv1.HandleFriendRequestResponse, error) {
+	err := r.relationDomain.HandleFriendRequest(ctx, req.GetFromUserID(), ctxutil.MustGetUserIDFromCtx(ctx), req.GetAccept())
+	if err != nil {
+		return nil, err
+	}
+
+	return &relationv1.HandleFriendRequestResponse{}, nil
+}
+
+func (r *RelationApplicationService) ListFriends(ctx context.Context, req *relationv1.ListFriendsRequest) (*relationv1.ListFriendsResponse, error) {
+	res, err := r.relationDomain.ListFriends(ctx, ctxutil.MustGetUserIDFromCtx(ctx), req.GetPage(), req.GetSize())
+	if err != nil {
+		return nil, err
+	}
+
+	return &relationv1.ListFriendsResponse{
+		Friends: langslice.Transform(res.Friends, friendDO2DTO),
+		Total:   res.Total,
+	}, nil
+}
+
+func (r *RelationApplicationService) SetFriendRemark(ctx context.Context, req *relationv1.SetFriendRemarkRequest) (*relationv1.SetFriendRemarkResponse, error) {
+	err := r.relationDomain.SetFriendRemark(ctx, ctxutil.MustGetUserIDFromCtx(ctx), req.GetFriendID(), req.GetRemark())
+	if err != nil {
+		return nil, err
+	}
+
+	return &relationv1.SetFriendRemarkResponse{}, nil
+}
+
+func (r *RelationApplicationService) DeleteFriend(ctx context.Context, req *relationv1.DeleteFriendRequest) (*relationv1.DeleteFriendResponse, error) {
+	err := r.relationDomain.DeleteFriend(ctx, ctxutil.MustGetUserIDFromCtx(ctx), req.GetFriendID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &relationv1.DeleteFriendResponse{}, nil
+}
+
+func (r *RelationApplicationService) AddBlock(ctx context.Context, req *relationv1.AddBlockRequest) (*relationv1.AddBlockResponse, error) {
+	err := r.relationDomain.AddBlock(ctx, ctxutil.MustGetUserIDFromCtx(ctx), req.GetUserID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &relationv1.AddBlockResponse{}, nil
+}
+
+func (r *RelationApplicationService) RemoveBlock(ctx context.Context, req *relationv1.RemoveBlockRequest) (*relationv1.RemoveBlockResponse, error) {
+	err := r.relationDomain.RemoveBlock(ctx, ctxutil.MustGetUserIDFromCtx(ctx), req.GetUserID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &relationv1.RemoveBlockResponse{}, nil
+}
+
+func (r *RelationApplicationService) ListBlocks(ctx context.Context, req *relationv1.ListBlocksRequest) (*relationv1.ListBlocksResponse, error) {
+	res, err := r.relationDomain.ListBlocks(ctx, ctxutil.MustGetUserIDFromCtx(ctx), req.GetPage(), req.GetSize())
+	if err != nil {
+		return nil, err
+	}
+
+	return &relationv1.ListBlocksResponse{
+		Users: langslice.Transform(res.Users, blockedUserDO2DTO),
+		Total: res.Total,
+	}, nil
+}
+
+func (r *RelationApplicationService) CheckSingleChat(ctx context.Context, req *relationv1.CheckSingleChatRequest) (*relationv1.CheckSingleChatResponse, error) {
+	// Only the sender may ask, otherwise anyone could probe other people's blocklists.
+	if err := ctxutil.CheckAccess(ctx, req.GetSendID()); err != nil {
+		return nil, err
+	}
+
+	err := r.relationDomain.CheckSingleChat(ctx, req.GetSendID(), req.GetRecvID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &relationv1.CheckSingleChatResponse{}, nil
+}
+
+func friendRequestDO2DTO(req *entity.FriendRequest) *relationv1.FriendRequest {
+	return &relationv1.FriendRequest{
+		FromUserID: req.FromUserID,
+		ToUserID:   req.ToUserID,
+		Greeting:   req.Greeting,
+		Status:     relationv1.FriendRequestStatus(req.Status),
+		HandleTime: req.HandledAt,
+		CreateTime: req.CreatedAt,
+	}
+}
+
+func friendDO2DTO(friend *entity.Friend) *relationv1.Friend {
+	return &relationv1.Friend{
+		FriendID:   friend.FriendID,
+		Remark:     friend.Remark,
+		CreateTime: friend.CreatedAt,
+	}
+}
+
+func blockedUserDO2DTO(block *entity.BlockedUser) *relationv1.BlockedUser {
+	return &relationv1.BlockedUser{
+		UserID:     block.BlockUserID,
+		CreateTime: block.CreatedAt,
+	}
+}
@@ -0,0 +1,31 @@
+package entity
+
+const (
+	FriendRequestPending int32 = iota
+	FriendRequestAccepted
+	FriendRequestRejected
+)
+
+type FriendRequest struct {
+	FromUserID int64
+	ToUserID   int64
+	Greeting   string // greeting message shown to the recipient
+	Status     int32  // request status
+	HandledAt  int64  // time the recipient accepted or rejected the request
+
+	CreatedAt int64 // creation time
+	UpdatedAt int64 // update time
+}
+
+type Friend struct {
+	OwnerID   int64
+	FriendID  int64
+	Remark    string // remark name given by the owner
+	CreatedAt int64  // time the friendship was established
+}
+
+type BlockedUser struct {
+	OwnerID     int64
+	BlockUserID int64
+	CreatedAt   int64 // time the user was blocked
+}
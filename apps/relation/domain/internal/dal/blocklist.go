@@ -0,0 +1,65 @@
+package dal
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/crazyfrankie/goim/apps/relation/domain/internal/dal/model"
+	"github.com/crazyfrankie/goim/apps/relation/domain/internal/dal/query"
+)
+
+type BlocklistDao struct {
+	query *query.Query
+}
+
+func NewBlocklistDao(db *gorm.DB) *BlocklistDao {
+	return &BlocklistDao{query: query.Use(db)}
+}
+
+// AddBlock blocks blockUserID for the owner, blocking twice is a no-op.
+func (b *BlocklistDao) AddBlock(ctx context.Context, ownerID, blockUserID int64) error {
+	return b.query.Blocklist.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&model.Blocklist{
+		OwnerID:     ownerID,
+		BlockUserID: blockUserID,
+	})
+}
+
+func (b *BlocklistDao) RemoveBlock(ctx context.Context, ownerID, blockUserID int64) (bool, error) {
+	block := b.query.Blocklist
+	res, err := block.WithContext(ctx).Where(
+		block.OwnerID.Eq(ownerID),
+		block.BlockUserID.Eq(blockUserID),
+	).Delete()
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected > 0, nil
+}
+
+func (b *BlocklistDao) IsBlocked(ctx context.Context, ownerID, blockUserID int64) (bool, error) {
+	block := b.query.Blocklist
+	_, err := block.WithContext(ctx).Where(
+		block.OwnerID.Eq(ownerID),
+		block.BlockUserID.Eq(blockUserID),
+	).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// ListBlocks returns a page of the users the owner blocked, the latest first.
+func (b *BlocklistDao) ListBlocks(ctx context.Context, ownerID int64, offset, limit int) ([]*model.Blocklist, int64, error) {
+	block := b.query.Blocklist
+	return block.WithContext(ctx).Where(
+		block.OwnerID.Eq(ownerID),
+	).Order(block.ID.Desc()).FindByPage(offset, limit)
+}
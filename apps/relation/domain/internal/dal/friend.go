@@ -0,0 +1,156 @@
+package dal
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/crazyfrankie/goim/apps/relation/domain/entity"
+	"github.com/crazyfrankie/goim/apps/relation/domain/internal/dal/model"
+	"github.com/crazyfrankie/goim/apps/relation/domain/internal/dal/query"
+)
+
+type FriendDao struct {
+	query *query.Query
+}
+
+func NewFriendDao(db *gorm.DB) *FriendDao {
+	return &FriendDao{query: query.Use(db)}
+}
+
+// UpsertRequest files a friend request, asking again replaces the greeting and
+// puts a handled request back to pending.
+func (f *FriendDao) UpsertRequest(ctx context.Context, fromUserID, toUserID int64, greeting string) error {
+	req := f.query.FriendRequest
+	return req.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: req.FromUserID.ColumnName().String()}, {Name: req.ToUserID.ColumnName().String()}},
+		DoUpdates: clause.Assignments(map[string]any{
+			req.Greeting.ColumnName().String():  greeting,
+			req.Status.ColumnName().String():    entity.FriendRequestPending,
+			req.HandledAt.ColumnName().String(): 0,
+			req.UpdatedAt.ColumnName().String(): time.Now().UnixMilli(),
+		}),
+	}).Create(&model.FriendRequest{
+		FromUserID: fromUserID,
+		ToUserID:   toUserID,
+		Greeting:   greeting,
+		Status:     entity.FriendRequestPending,
+	})
+}
+
+// ListRequests returns a page of the requests received by userID, or sent by
+// it when sent is true, the most recently updated first.
+func (f *FriendDao) ListRequests(ctx context.Context, userID int64, sent bool, offset, limit int) ([]*model.FriendRequest, int64, error) {
+	req := f.query.FriendRequest
+	cond := req.ToUserID.Eq(userID)
+	if sent {
+		cond = req.FromUserID.Eq(userID)
+	}
+
+	return req.WithContext(ctx).Where(cond).Order(req.UpdatedAt.Desc()).FindByPage(offset, limit)
+}
+
+// HandleRequest moves a pending request to status. Accepting it also makes the
+// two users friends and settles a pending request in the opposite direction.
+// It reports false when there is no pending request from fromUserID to toUserID.
+func (f *FriendDao) HandleRequest(ctx context.Context, fromUserID, toUserID int64, status int32) (bool, error) {
+	var ok bool
+	err := f.query.Transaction(func(tx *query.Query) error {
+		now := time.Now().UnixMilli()
+		req := tx.FriendRequest
+		res, err := req.WithContext(ctx).Where(
+			req.FromUserID.Eq(fromUserID),
+			req.ToUserID.Eq(toUserID),
+			req.Status.Eq(entity.FriendRequestPending),
+		).Updates(map[string]any{
+			req.Status.ColumnName().String():    status,
+			req.HandledAt.ColumnName().String(): now,
+		})
+		if err != nil {
+			return err
+		}
+		if res.RowsAffected == 0 {
+			return nil
+		}
+		ok = true
+		if status != entity.FriendRequestAccepted {
+			return nil
+		}
+
+		_, err = req.WithContext(ctx).Where(
+			req.FromUserID.Eq(toUserID),
+			req.ToUserID.Eq(fromUserID),
+			req.Status.Eq(entity.FriendRequestPending),
+		).Updates(map[string]any{
+			req.Status.ColumnName().String():    entity.FriendRequestAccepted,
+			req.HandledAt.ColumnName().String(): now,
+		})
+		if err != nil {
+			return err
+		}
+
+		return tx.Friend.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(
+			&model.Friend{OwnerID: fromUserID, FriendID: toUserID},
+			&model.Friend{OwnerID: toUserID, FriendID: fromUserID},
+		)
+	})
+
+	return ok, err
+}
+
+func (f *FriendDao) IsFriend(ctx context.Context, ownerID, friendID int64) (bool, error) {
+	friend := f.query.Friend
+	_, err := friend.WithContext(ctx).Where(
+		friend.OwnerID.Eq(ownerID),
+		friend.FriendID.Eq(friendID),
+	).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// ListFriends returns a page of the owner's friends, the newest first.
+func (f *FriendDao) ListFriends(ctx context.Context, ownerID int64, offset, limit int) ([]*model.Friend, int64, error) {
+	friend := f.query.Friend
+	return friend.WithContext(ctx).Where(
+		friend.OwnerID.Eq(ownerID),
+	).Order(friend.ID.Desc()).FindByPage(offset, limit)
+}
+
+func (f *FriendDao) UpdateRemark(ctx context.Context, ownerID, friendID int64, remark string) (bool, error) {
+	friend := f.query.Friend
+	res, err := friend.WithContext(ctx).Where(
+		friend.OwnerID.Eq(ownerID),
+		friend.FriendID.Eq(friendID),
+	).Update(friend.Remark, remark)
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected > 0, nil
+}
+
+// DeleteFriend ends the friendship on both sides.
+func (f *FriendDao) DeleteFriend(ctx context.Context, userA, userB int64) (bool, error) {
+	friend := f.query.Friend
+	res, err := friend.WithContext(ctx).Where(
+		friend.OwnerID.Eq(userA),
+		friend.FriendID.Eq(userB),
+	).Or(
+		friend.OwnerID.Eq(userB),
+		friend.FriendID.Eq(userA),
+	).Delete()
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected > 0, nil
+}
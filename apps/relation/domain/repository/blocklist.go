@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/crazyfrankie/goim/apps/relation/domain/internal/dal"
+	"github.com/crazyfrankie/goim/apps/relation/domain/internal/dal/model"
+)
+
+func NewBlocklistRepository(db *gorm.DB) BlocklistRepository {
+	return dal.NewBlocklistDao(db)
+}
+
+type BlocklistRepository interface {
+	AddBlock(ctx context.Context, ownerID, blockUserID int64) error
+	RemoveBlock(ctx context.Context, ownerID, blockUserID int64) (bool, error)
+	IsBlocked(ctx context.Context, ownerID, blockUserID int64) (bool, error)
+	ListBlocks(ctx context.Context, ownerID int64, offset, limit int) ([]*model.Blocklist, int64, error)
+}
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/crazyfrankie/goim/apps/relation/domain/internal/dal"
+	"github.com/crazyfrankie/goim/apps/relation/domain/internal/dal/model"
+)
+
+func NewFriendRepository(db *gorm.DB) FriendRepository {
+	return dal.NewFriendDao(db)
+}
+
+type FriendRepository interface {
+	UpsertRequest(ctx context.Context, fromUserID, toUserID int64, greeting string) error
+	ListRequests(ctx context.Context, userID int64, sent bool, offset, limit int) ([]*model.FriendRequest, int64, error)
+	HandleRequest(ctx context.Context, fromUserID, toUserID int64, status int32) (bool, error)
+	IsFriend(ctx context.Context, ownerID, friendID int64) (bool, error)
+	ListFriends(ctx context.Context, ownerID int64, offset, limit int) ([]*model.Friend, int64, error)
+	UpdateRemark(ctx context.Context, ownerID, friendID int64, remark string) (bool, error)
+	DeleteFriend(ctx context.Context, userA, userB int64) (bool, error)
+}
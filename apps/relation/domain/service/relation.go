@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+
+	"github.com/crazyfrankie/goim/apps/relation/domain/entity"
+)
+
+type ListFriendRequestsRequest struct {
+	UserID int64
+	Sent   bool // list the requests sent by the user instead of the received ones
+	Page   int32
+	Size   int32
+}
+
+type ListFriendRequestsResponse struct {
+	Requests []*entity.FriendRequest
+	Total    int64
+}
+
+type ListFriendsResponse struct {
+	Friends []*entity.Friend
+	Total   int64
+}
+
+type ListBlocksResponse struct {
+	Users []*entity.BlockedUser
+	Total int64
+}
+
+type Relation interface {
+	ApplyToAddFriend(ctx context.Context, fromUserID, toUserID int64, greeting string) error
+	ListFriendRequests(ctx context.Context, req *ListFriendRequestsRequest) (*ListFriendRequestsResponse, error)
+	HandleFriendRequest(ctx context.Context, fromUserID, toUserID int64, accept bool) error
+	ListFriends(ctx context.Context, ownerID int64, page, size int32) (*ListFriendsResponse, error)
+	SetFriendRemark(ctx context.Context, ownerID, friendID int64, remark string) error
+	DeleteFriend(ctx context.Context, ownerID, friendID int64) error
+	AddBlock(ctx context.Context, ownerID, blockUserID int64) error
+	RemoveBlock(ctx context.Context, ownerID, blockUserID int64) error
+	ListBlocks(ctx context.Context, ownerID int64, page, size int32) (*ListBlocksResponse, error)
+	// CheckSingleChat returns an error when sendID may not message recvID.
+	CheckSingleChat(ctx context.Context, sendID, recvID int64) error
+}
@@ -0,0 +1,220 @@
+package service
+
+import (
+	"context"
+	"unicode/utf8"
+
+	"github.com/crazyfrankie/goim/apps/relation/domain/entity"
+	"github.com/crazyfrankie/goim/apps/relation/domain/internal/dal/model"
+	"github.com/crazyfrankie/goim/apps/relation/domain/repository"
+	"github.com/crazyfrankie/goim/pkg/errorx"
+	"github.com/crazyfrankie/goim/pkg/lang/conv"
+	langslice "github.com/crazyfrankie/goim/pkg/lang/slice"
+	"github.com/crazyfrankie/goim/types/errno"
+)
+
+const (
+	defaultPageSize   = 20
+	maxPageSize       = 100
+	maxGreetingLength = 255
+	maxRemarkLength   = 64
+)
+
+type Components struct {
+	FriendRepo    repository.FriendRepository
+	BlocklistRepo repository.BlocklistRepository
+}
+
+type relationImpl struct {
+	*Components
+}
+
+func NewRelationDomain(c *Components) Relation {
+	return &relationImpl{c}
+}
+
+func (r *relationImpl) ApplyToAddFriend(ctx context.Context, fromUserID, toUserID int64, greeting string) error {
+	if toUserID <= 0 || toUserID == fromUserID {
+		return errorx.New(errno.ErrRelationInvalidParamCode, errorx.KV("msg", "invalid user to add"))
+	}
+	if utf8.RuneCountInString(greeting) > maxGreetingLength {
+		return errorx.New(errno.ErrRelationInvalidParamCode, errorx.KV("msg", "greeting is too long"))
+	}
+
+	isFriend, err := r.FriendRepo.IsFriend(ctx, fromUserID, toUserID)
+	if err != nil {
+		return err
+	}
+	if isFriend {
+		return errorx.New(errno.ErrRelationAlreadyFriendCode, errorx.KV("user_id", conv.Int64ToStr(toUserID)))
+	}
+
+	blocked, err := r.BlocklistRepo.IsBlocked(ctx, toUserID, fromUserID)
+	if err != nil {
+		return err
+	}
+	if blocked {
+		return errorx.New(errno.ErrRelationBlockedCode, errorx.KV("user_id", conv.Int64ToStr(toUserID)))
+	}
+
+	return r.FriendRepo.UpsertRequest(ctx, fromUserID, toUserID, greeting)
+}
+
+func (r *relationImpl) ListFriendRequests(ctx context.Context, req *ListFriendRequestsRequest) (*ListFriendRequestsResponse, error) {
+	offset, limit := pagination(req.Page, req.Size)
+	requests, total, err := r.FriendRepo.ListRequests(ctx, req.UserID, req.Sent, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListFriendRequestsResponse{
+		Requests: langslice.Transform(requests, friendRequestPO2DO),
+		Total:    total,
+	}, nil
+}
+
+func (r *relationImpl) HandleFriendRequest(ctx context.Context, fromUserID, toUserID int64, accept bool) error {
+	status := entity.FriendRequestRejected
+	if accept {
+		status = entity.FriendRequestAccepted
+	}
+
+	ok, err := r.FriendRepo.HandleRequest(ctx, fromUserID, toUserID, status)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errorx.New(errno.ErrRelationRequestNotFoundCode, errorx.KV("from_user_id", conv.Int64ToStr(fromUserID)))
+	}
+
+	return nil
+}
+
+func (r *relationImpl) ListFriends(ctx context.Context, ownerID int64, page, size int32) (*ListFriendsResponse, error) {
+	offset, limit := pagination(page, size)
+	friends, total, err := r.FriendRepo.ListFriends(ctx, ownerID, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListFriendsResponse{
+		Friends: langslice.Transform(friends, friendPO2DO),
+		Total:   total,
+	}, nil
+}
+
+func (r *relationImpl) SetFriendRemark(ctx context.Context, ownerID, friendID int64, remark string) error {
+	if utf8.RuneCountInString(remark) > maxRemarkLength {
+		return errorx.New(errno.ErrRelationInvalidParamCode, errorx.KV("msg", "remark is too long"))
+	}
+
+	isFriend, err := r.FriendRepo.IsFriend(ctx, ownerID, friendID)
+	if err != nil {
+		return err
+	}
+	if !isFriend {
+		return errorx.New(errno.ErrRelationNotFriendCode, errorx.KV("user_id", conv.Int64ToStr(friendID)))
+	}
+
+	// The row is known to exist, an unchanged remark simply affects no rows.
+	_, err = r.FriendRepo.UpdateRemark(ctx, ownerID, friendID, remark)
+
+	return err
+}
+
+func (r *relationImpl) DeleteFriend(ctx context.Context, ownerID, friendID int64) error {
+	ok, err := r.FriendRepo.DeleteFriend(ctx, ownerID, friendID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errorx.New(errno.ErrRelationNotFriendCode, errorx.KV("user_id", conv.Int64ToStr(friendID)))
+	}
+
+	return nil
+}
+
+func (r *relationImpl) AddBlock(ctx context.Context, ownerID, blockUserID int64) error {
+	if blockUserID <= 0 || blockUserID == ownerID {
+		return errorx.New(errno.ErrRelationInvalidParamCode, errorx.KV("msg", "invalid user to block"))
+	}
+
+	return r.BlocklistRepo.AddBlock(ctx, ownerID, blockUserID)
+}
+
+func (r *relationImpl) RemoveBlock(ctx context.Context, ownerID, blockUserID int64) error {
+	_, err := r.BlocklistRepo.RemoveBlock(ctx, ownerID, blockUserID)
+
+	return err
+}
+
+func (r *relationImpl) ListBlocks(ctx context.Context, ownerID int64, page, size int32) (*ListBlocksResponse, error) {
+	offset, limit := pagination(page, size)
+	blocks, total, err := r.BlocklistRepo.ListBlocks(ctx, ownerID, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListBlocksResponse{
+		Users: langslice.Transform(blocks, blockPO2DO),
+		Total: total,
+	}, nil
+}
+
+func (r *relationImpl) CheckSingleChat(ctx context.Context, sendID, recvID int64) error {
+	if sendID == recvID {
+		return nil
+	}
+
+	blocked, err := r.BlocklistRepo.IsBlocked(ctx, recvID, sendID)
+	if err != nil {
+		return err
+	}
+	if blocked {
+		return errorx.New(errno.ErrRelationBlockedCode, errorx.KV("user_id", conv.Int64ToStr(recvID)))
+	}
+
+	return nil
+}
+
+func pagination(page, size int32) (offset, limit int) {
+	p, s := int(page), int(size)
+	if p < 1 {
+		p = 1
+	}
+	if s <= 0 {
+		s = defaultPageSize
+	}
+	s = min(s, maxPageSize)
+
+	return (p - 1) * s, s
+}
+
+func friendRequestPO2DO(po *model.FriendRequest) *entity.FriendRequest {
+	return &entity.FriendRequest{
+		FromUserID: po.FromUserID,
+		ToUserID:   po.ToUserID,
+		Greeting:   po.Greeting,
+		Status:     po.Status,
+		HandledAt:  po.HandledAt,
+		CreatedAt:  po.CreatedAt,
+		UpdatedAt:  po.UpdatedAt,
+	}
+}
+
+func friendPO2DO(po *model.Friend) *entity.Friend {
+	return &entity.Friend{
+		OwnerID:   po.OwnerID,
+		FriendID:  po.FriendID,
+		Remark:    po.Remark,
+		CreatedAt: po.CreatedAt,
+	}
+}
+
+func blockPO2DO(po *model.Blocklist) *entity.BlockedUser {
+	return &entity.BlockedUser{
+		OwnerID:     po.OwnerID,
+		BlockUserID: po.BlockUserID,
+		CreatedAt:   po.CreatedAt,
+	}
+}
@@ -0,0 +1,29 @@
+package relation
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"github.com/crazyfrankie/goim/apps/relation/application"
+	"github.com/crazyfrankie/goim/apps/relation/domain/repository"
+	"github.com/crazyfrankie/goim/apps/relation/domain/service"
+	"github.com/crazyfrankie/goim/infra/contract/discovery"
+	relationv1 "github.com/crazyfrankie/goim/protocol/relation/v1"
+)
+
+func Start(ctx context.Context, client discovery.SvcDiscoveryRegistry, srv grpc.ServiceRegistrar) error {
+	basic, err := application.Init(ctx, client)
+	if err != nil {
+		return err
+	}
+	relationDomain := service.NewRelationDomain(&service.Components{
+		FriendRepo:    repository.NewFriendRepository(basic.DB),
+		BlocklistRepo: repository.NewBlocklistRepository(basic.DB),
+	})
+	appService := application.NewRelationApplicationService(relationDomain)
+
+	relationv1.RegisterRelationServiceServer(srv, appService)
+
+	return nil
+}
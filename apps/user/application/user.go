@@ -172,6 +172,17 @@ func (u *UserApplicationService) UpdateProfile(ctx context.Context, req *userv1.
 	return &userv1.UpdateProfileResponse{}, nil
 }
 
+func (u *UserApplicationService) GetConnToken(ctx context.Context, req *userv1.GetConnTokenRequest) (*userv1.GetConnTokenResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	tkRes, err := u.authCli.GenerateConnToken(ctx, &authv1.GenerateConnTokenRequest{UserID: userID})
+	if err != nil {
+		return nil, err
+	}
+
+	return &userv1.GetConnTokenResponse{ConnToken: tkRes.GetConnToken()}, nil
+}
+
 func userDO2DTO(userDo *entity.User) *userv1.User {
 	return &userv1.User{
 		UserID:         userDo.UserID,
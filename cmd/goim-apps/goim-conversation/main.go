@@ -0,0 +1,12 @@
+package main
+
+import (
+	"github.com/crazyfrankie/goim/pkg/cmd/rpc"
+	"github.com/crazyfrankie/goim/pkg/lang/program"
+)
+
+func main() {
+	if err := rpc.NewConversationCmd().Exec(); err != nil {
+		program.ExitWithError(err)
+	}
+}
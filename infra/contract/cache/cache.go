@@ -17,10 +17,12 @@ type Cmdable interface {
 	HashCmdable
 	GenericCmdable
 	ListCmdable
+	ScriptingCmdable
 }
 
 type StringCmdable interface {
 	Set(ctx context.Context, key string, value interface{}, expiration time.Duration) StatusCmd
+	SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) BoolCmd
 	Get(ctx context.Context, key string) StringCmd
 	IncrBy(ctx context.Context, key string, value int64) IntCmd
 	Incr(ctx context.Context, key string) IntCmd
@@ -28,7 +30,9 @@ type StringCmdable interface {
 
 type HashCmdable interface {
 	HSet(ctx context.Context, key string, values ...interface{}) IntCmd
+	HGet(ctx context.Context, key, field string) StringCmd
 	HGetAll(ctx context.Context, key string) MapStringStringCmd
+	HDel(ctx context.Context, key string, fields ...string) IntCmd
 }
 
 type GenericCmdable interface {
@@ -37,6 +41,10 @@ type GenericCmdable interface {
 	Expire(ctx context.Context, key string, expiration time.Duration) BoolCmd
 }
 
+type ScriptingCmdable interface {
+	Eval(ctx context.Context, script string, keys []string, args ...interface{}) Cmd
+}
+
 type Pipeliner interface {
 	StatefulCmdable
 	Exec(ctx context.Context) ([]Cmder, error)
@@ -62,6 +70,12 @@ type baseCmd interface {
 	Err() error
 }
 
+type Cmd interface {
+	baseCmd
+	Result() (interface{}, error)
+	Int64Slice() ([]int64, error)
+}
+
 type IntCmd interface {
 	baseCmd
 	Result() (int64, error)
@@ -0,0 +1,11 @@
+package offlinepush
+
+import "context"
+
+// OfflinePusher notifies users through a system push channel such as APNs or
+// FCM, for when they cannot be reached over a long connection.
+type OfflinePusher interface {
+	// Push sends the notification to every device of userIDs. ex is passed
+	// through to the devices, e.g. to open the conversation on tap.
+	Push(ctx context.Context, userIDs []int64, title, body, ex string) error
+}
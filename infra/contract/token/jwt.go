@@ -7,8 +7,19 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 )
 
+// Token types, a token is only accepted where its type is expected.
+const (
+	TypeAccess  = "access"
+	TypeRefresh = "refresh"
+	TypeConn    = "conn"
+)
+
 type Claims struct {
-	UID int64 `json:"uid"`
+	UID  int64  `json:"uid"`
+	Type string `json:"typ"`
+	// IssuedAtMilli is IssuedAt in milliseconds, IssuedAt itself only keeps
+	// seconds.
+	IssuedAtMilli int64 `json:"iat_ms,omitempty"`
 	jwt.RegisteredClaims
 }
 
@@ -25,6 +36,9 @@ type Token interface {
 	TryRefresh(refresh string) ([]string, int64, error)
 	CleanToken(ctx context.Context, uid int64) error
 	RevokeToken(ctx context.Context, uid int64) error
+	// RevokeSingleToken revokes tk alone, expiration should cover its remaining lifetime.
+	RevokeSingleToken(ctx context.Context, tk string, expiration time.Duration) error
+	IsRevoked(ctx context.Context, tk string, claims *Claims) (bool, error)
 }
 
 type ResetToken interface {
@@ -63,11 +63,26 @@ func (r *redisImpl) Get(ctx context.Context, key string) cache.StringCmd {
 	return r.client.Get(ctx, key)
 }
 
+// HGet implements cache.Cmdable.
+func (r *redisImpl) HGet(ctx context.Context, key, field string) cache.StringCmd {
+	return r.client.HGet(ctx, key, field)
+}
+
+// Eval implements cache.Cmdable.
+func (r *redisImpl) Eval(ctx context.Context, script string, keys []string, args ...interface{}) cache.Cmd {
+	return r.client.Eval(ctx, script, keys, args...)
+}
+
 // HGetAll implements cache.Cmdable.
 func (r *redisImpl) HGetAll(ctx context.Context, key string) cache.MapStringStringCmd {
 	return r.client.HGetAll(ctx, key)
 }
 
+// HDel implements cache.Cmdable.
+func (r *redisImpl) HDel(ctx context.Context, key string, fields ...string) cache.IntCmd {
+	return r.client.HDel(ctx, key, fields...)
+}
+
 // HSet implements cache.Cmdable.
 func (r *redisImpl) HSet(ctx context.Context, key string, values ...interface{}) cache.IntCmd {
 	return r.client.HSet(ctx, key, values...)
@@ -124,6 +139,11 @@ func (r *redisImpl) Set(ctx context.Context, key string, value interface{}, expi
 	return r.client.Set(ctx, key, value, expiration)
 }
 
+// SetNX implements cache.Cmdable.
+func (r *redisImpl) SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) cache.BoolCmd {
+	return r.client.SetNX(ctx, key, value, expiration)
+}
+
 type pipelineImpl struct {
 	p redis.Pipeliner
 }
@@ -173,11 +193,26 @@ func (p *pipelineImpl) Get(ctx context.Context, key string) cache.StringCmd {
 	return p.p.Get(ctx, key)
 }
 
+// HGet implements cache.Pipeliner.
+func (p *pipelineImpl) HGet(ctx context.Context, key, field string) cache.StringCmd {
+	return p.p.HGet(ctx, key, field)
+}
+
+// Eval implements cache.Pipeliner.
+func (p *pipelineImpl) Eval(ctx context.Context, script string, keys []string, args ...interface{}) cache.Cmd {
+	return p.p.Eval(ctx, script, keys, args...)
+}
+
 // HGetAll implements cache.Pipeliner.
 func (p *pipelineImpl) HGetAll(ctx context.Context, key string) cache.MapStringStringCmd {
 	return p.p.HGetAll(ctx, key)
 }
 
+// HDel implements cache.Pipeliner.
+func (p *pipelineImpl) HDel(ctx context.Context, key string, fields ...string) cache.IntCmd {
+	return p.p.HDel(ctx, key, fields...)
+}
+
 // HSet implements cache.Pipeliner.
 func (p *pipelineImpl) HSet(ctx context.Context, key string, values ...interface{}) cache.IntCmd {
 	return p.p.HSet(ctx, key, values...)
@@ -232,3 +267,8 @@ func (p *pipelineImpl) RPush(ctx context.Context, key string, values ...interfac
 func (p *pipelineImpl) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) cache.StatusCmd {
 	return p.p.Set(ctx, key, value, expiration)
 }
+
+// SetNX implements cache.Pipeliner.
+func (p *pipelineImpl) SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) cache.BoolCmd {
+	return p.p.SetNX(ctx, key, value, expiration)
+}
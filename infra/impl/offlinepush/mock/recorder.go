@@ -0,0 +1,62 @@
+package mock
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/crazyfrankie/goim/infra/contract/offlinepush"
+)
+
+// Push is a notification taken by the Recorder.
+type Push struct {
+	UserIDs []int64
+	Title   string
+	Body    string
+	Ex      string
+}
+
+// Recorder keeps offline pushes in memory instead of sending them, so that
+// tests and local setups can look at what would have been sent.
+type Recorder struct {
+	mu     sync.Mutex
+	pushes []Push
+}
+
+var _ offlinepush.OfflinePusher = (*Recorder)(nil)
+
+func NewRecorder() *Recorder {
+	return &Recorder{}
+}
+
+func (r *Recorder) Push(ctx context.Context, userIDs []int64, title, body, ex string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.pushes = append(r.pushes, Push{
+		UserIDs: slices.Clone(userIDs),
+		Title:   title,
+		Body:    body,
+		Ex:      ex,
+	})
+
+	return nil
+}
+
+// Pushes returns the pushes recorded so far, oldest first.
+func (r *Recorder) Pushes() []Push {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return slices.Clone(r.pushes)
+}
+
+// Reset drops the recorded pushes.
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.pushes = nil
+}
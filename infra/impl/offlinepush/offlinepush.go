@@ -0,0 +1,32 @@
+package offlinepush
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/crazyfrankie/goim/infra/contract/offlinepush"
+	"github.com/crazyfrankie/goim/infra/impl/offlinepush/mock"
+	"github.com/crazyfrankie/goim/infra/impl/offlinepush/webhook"
+	"github.com/crazyfrankie/goim/types/consts"
+)
+
+type OfflinePusher = offlinepush.OfflinePusher
+
+// New returns the pusher selected by OFFLINE_PUSH_TYPE. Without a type offline
+// pushes are only recorded in memory, which suits local setups.
+func New() (OfflinePusher, error) {
+	typ := os.Getenv(consts.OfflinePushType)
+
+	switch typ {
+	case "webhook":
+		url := os.Getenv(consts.OfflinePushWebhookURL)
+		if url == "" {
+			return nil, fmt.Errorf("%s is required by the webhook offline pusher", consts.OfflinePushWebhookURL)
+		}
+		return webhook.New(url), nil
+	case "", "mock":
+		return mock.NewRecorder(), nil
+	default:
+		return nil, fmt.Errorf("unsupported offline push type, %s", typ)
+	}
+}
@@ -0,0 +1,75 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/crazyfrankie/goim/infra/contract/offlinepush"
+	"github.com/crazyfrankie/goim/pkg/lang/conv"
+	langslice "github.com/crazyfrankie/goim/pkg/lang/slice"
+	"github.com/crazyfrankie/goim/pkg/sonic"
+)
+
+const defaultTimeout = 5 * time.Second
+
+// Request is the JSON body posted to the webhook. User IDs are strings so
+// that receivers in any language keep their precision.
+type Request struct {
+	UserIDs []string `json:"userIDs"`
+	Title   string   `json:"title"`
+	Body    string   `json:"body"`
+	Ex      string   `json:"ex,omitempty"`
+}
+
+// Pusher hands offline pushes to an HTTP endpoint, which forwards them to the
+// vendor push services. Any 2xx response counts as accepted.
+type Pusher struct {
+	url    string
+	client *http.Client
+}
+
+func New(url string) offlinepush.OfflinePusher {
+	return &Pusher{
+		url:    url,
+		client: &http.Client{Timeout: defaultTimeout},
+	}
+}
+
+func (p *Pusher) Push(ctx context.Context, userIDs []int64, title, body, ex string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	payload, err := sonic.Marshal(&Request{
+		UserIDs: langslice.Transform(userIDs, conv.Int64ToStr),
+		Title:   title,
+		Body:    body,
+		Ex:      ex,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("call offline push webhook failed: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("offline push webhook responded with status %d", resp.StatusCode)
+	}
+
+	return nil
+}
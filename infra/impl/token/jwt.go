@@ -3,6 +3,8 @@ package token
 import (
 	"context"
 	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"os"
@@ -16,8 +18,10 @@ import (
 )
 
 const (
-	RevokePrefix  = "user_access_revoked"
-	RefreshPrefix = "refresh_token"
+	RevokePrefix = "user_access_revoked"
+	// RevokedTokenPrefix marks a single revoked token, keyed by its digest
+	RevokedTokenPrefix = "revoked_token"
+	RefreshPrefix      = "refresh_token"
 )
 
 type TokenService struct {
@@ -49,12 +53,12 @@ func New(cmd cache.Cmdable) (token.Token, error) {
 
 func (s *TokenService) GenerateToken(uid int64) ([]string, error) {
 	res := make([]string, 2)
-	access, err := s.newToken(uid, time.Minute*15)
+	access, err := s.newToken(uid, token.TypeAccess, time.Minute*15)
 	if err != nil {
 		return res, err
 	}
 	res[0] = access
-	refresh, err := s.newToken(uid, time.Hour*24*30)
+	refresh, err := s.newToken(uid, token.TypeRefresh, time.Hour*24*30)
 	if err != nil {
 		return res, err
 	}
@@ -72,13 +76,15 @@ func (s *TokenService) GenerateToken(uid int64) ([]string, error) {
 }
 
 func (s *TokenService) GenerateConnToken(uid int64) (string, error) {
-	return s.newToken(uid, time.Hour*24)
+	return s.newToken(uid, token.TypeConn, time.Hour*24)
 }
 
-func (s *TokenService) newToken(uid int64, duration time.Duration) (string, error) {
+func (s *TokenService) newToken(uid int64, typ string, duration time.Duration) (string, error) {
 	now := time.Now()
 	claims := &token.Claims{
-		UID: uid,
+		UID:           uid,
+		Type:          typ,
+		IssuedAtMilli: now.UnixMilli(),
 		RegisteredClaims: jwt.RegisteredClaims{
 			IssuedAt:  jwt.NewNumericDate(now),
 			ExpiresAt: jwt.NewNumericDate(now.Add(duration)),
@@ -108,7 +114,7 @@ func (s *TokenService) ParseToken(tk string) (*token.Claims, error) {
 
 func (s *TokenService) TryRefresh(refresh string) ([]string, int64, error) {
 	refreshClaims, err := s.ParseToken(refresh)
-	if err != nil {
+	if err != nil || refreshClaims.Type != token.TypeRefresh {
 		return nil, 0, fmt.Errorf("invalid refresh jwt")
 	}
 
@@ -117,7 +123,7 @@ func (s *TokenService) TryRefresh(refresh string) ([]string, int64, error) {
 		return nil, 0, errors.New("jwt invalid or revoked")
 	}
 
-	access, err := s.newToken(refreshClaims.UID, time.Hour)
+	access, err := s.newToken(refreshClaims.UID, token.TypeAccess, time.Hour)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -127,7 +133,7 @@ func (s *TokenService) TryRefresh(refresh string) ([]string, int64, error) {
 	expire, _ := refreshClaims.GetExpirationTime()
 	if expire.Sub(now) < expire.Sub(issat.Time)/3 {
 		// try refresh
-		refresh, err = s.newToken(refreshClaims.UID, time.Hour*24*30)
+		refresh, err = s.newToken(refreshClaims.UID, token.TypeRefresh, time.Hour*24*30)
 		err = s.cmd.Set(context.Background(), refreshKey(refreshClaims.UID), refresh, time.Hour*24*30).Err()
 		if err != nil {
 			return nil, 0, err
@@ -143,13 +149,53 @@ func (s *TokenService) CleanToken(ctx context.Context, uid int64) error {
 
 func (s *TokenService) RevokeToken(ctx context.Context, uid int64) error {
 	key := revokeKey(uid)
-	return s.cmd.Set(ctx, key, time.Now().Unix(), time.Hour*24).Err()
+	return s.cmd.Set(ctx, key, time.Now().UnixMilli(), time.Hour*24).Err()
+}
+
+func (s *TokenService) RevokeSingleToken(ctx context.Context, tk string, expiration time.Duration) error {
+	return s.cmd.Set(ctx, revokedTokenKey(tk), 1, expiration).Err()
+}
+
+// IsRevoked reports whether tk was revoked on its own, or claims were issued
+// before the user's tokens got revoked.
+func (s *TokenService) IsRevoked(ctx context.Context, tk string, claims *token.Claims) (bool, error) {
+	n, err := s.cmd.Exists(ctx, revokedTokenKey(tk)).Result()
+	if err != nil {
+		return false, err
+	}
+	if n > 0 {
+		return true, nil
+	}
+
+	revokedAt, err := s.cmd.Get(ctx, revokeKey(claims.UID)).Int64()
+	if err != nil {
+		if errors.Is(err, cache.Nil) {
+			return false, nil
+		}
+		return false, err
+	}
+	// Revocations are kept in milliseconds, so that tokens issued right after
+	// one, e.g. by a new login, are not caught by it.
+	issuedAt := claims.IssuedAtMilli
+	if issuedAt == 0 {
+		if claims.IssuedAt == nil {
+			return true, nil
+		}
+		issuedAt = claims.IssuedAt.UnixMilli()
+	}
+
+	return issuedAt <= revokedAt, nil
 }
 
 func revokeKey(uid int64) string {
 	return fmt.Sprintf("%s:%d", RevokePrefix, uid)
 }
 
+func revokedTokenKey(tk string) string {
+	sum := sha256.Sum256([]byte(tk))
+	return fmt.Sprintf("%s:%s", RevokedTokenPrefix, hex.EncodeToString(sum[:]))
+}
+
 func refreshKey(uid int64) string {
 	return fmt.Sprintf("%s:%d", RefreshPrefix, uid)
 }
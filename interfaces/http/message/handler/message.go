@@ -1,6 +1,8 @@
 package handler
 
 import (
+	"fmt"
+
 	"github.com/gin-gonic/gin"
 
 	"github.com/mitchellh/mapstructure"
@@ -10,7 +12,6 @@ import (
 	"github.com/crazyfrankie/goim/pkg/errorx"
 	"github.com/crazyfrankie/goim/pkg/gin/response"
 	"github.com/crazyfrankie/goim/pkg/lang/conv"
-	"github.com/crazyfrankie/goim/pkg/lang/encrypt"
 	"github.com/crazyfrankie/goim/pkg/sonic"
 	messagev1 "github.com/crazyfrankie/goim/protocol/message/v1"
 	"github.com/crazyfrankie/goim/types/consts"
@@ -28,6 +29,12 @@ func (h *MessageHandler) RegisterRoute(r *gin.RouterGroup) {
 	messageGroup := r.Group("message")
 	{
 		messageGroup.POST("send", h.SendMessage())
+		messageGroup.POST("revoke", h.RevokeMessage())
+		messageGroup.POST("status", h.SetMessageStatus())
+		messageGroup.POST("delete", h.DeleteMessages())
+		messageGroup.POST("clear", h.ClearConversation())
+		messageGroup.POST("read", h.MarkConversationAsRead())
+		messageGroup.GET("readers", h.GetMessageReaders())
 	}
 }
 
@@ -55,6 +62,136 @@ func (h *MessageHandler) SendMessage() gin.HandlerFunc {
 	}
 }
 
+func (h *MessageHandler) RevokeMessage() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req model.RevokeMsgReq
+		if err := c.ShouldBind(&req); err != nil {
+			response.InvalidParamError(c, err.Error())
+			return
+		}
+
+		res, err := h.messageClient.RevokeMessage(c.Request.Context(), &messagev1.RevokeMessageRequest{
+			ConversationID: req.ConversationID,
+			Seq:            req.Seq,
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, res)
+	}
+}
+
+func (h *MessageHandler) SetMessageStatus() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req model.SetMsgStatusReq
+		if err := c.ShouldBind(&req); err != nil {
+			response.InvalidParamError(c, err.Error())
+			return
+		}
+
+		res, err := h.messageClient.SetMessageStatus(c.Request.Context(), &messagev1.SetMessageStatusRequest{
+			ConversationID: req.ConversationID,
+			Seqs:           req.Seqs,
+			ServerMsgIDs:   req.ServerMsgIDs,
+			Status:         messagev1.MessageStatus(req.Status),
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, res)
+	}
+}
+
+func (h *MessageHandler) DeleteMessages() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req model.DeleteMsgsReq
+		if err := c.ShouldBind(&req); err != nil {
+			response.InvalidParamError(c, err.Error())
+			return
+		}
+
+		res, err := h.messageClient.DeleteMessages(c.Request.Context(), &messagev1.DeleteMessagesRequest{
+			ConversationID: req.ConversationID,
+			Seqs:           req.Seqs,
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, res)
+	}
+}
+
+func (h *MessageHandler) ClearConversation() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req model.ClearConversationReq
+		if err := c.ShouldBind(&req); err != nil {
+			response.InvalidParamError(c, err.Error())
+			return
+		}
+
+		res, err := h.messageClient.ClearConversation(c.Request.Context(), &messagev1.ClearConversationRequest{
+			ConversationID: req.ConversationID,
+			UpToSeq:        req.UpToSeq,
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, res)
+	}
+}
+
+func (h *MessageHandler) MarkConversationAsRead() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req model.MarkAsReadReq
+		if err := c.ShouldBind(&req); err != nil {
+			response.InvalidParamError(c, err.Error())
+			return
+		}
+
+		res, err := h.messageClient.MarkConversationAsRead(c.Request.Context(), &messagev1.MarkConversationAsReadRequest{
+			ConversationID: req.ConversationID,
+			HasReadSeq:     req.HasReadSeq,
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, res)
+	}
+}
+
+func (h *MessageHandler) GetMessageReaders() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req model.GetMsgReadersReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			response.InvalidParamError(c, err.Error())
+			return
+		}
+
+		res, err := h.messageClient.GetMessageReaders(c.Request.Context(), &messagev1.GetMessageReadersRequest{
+			ConversationID: req.ConversationID,
+			Seq:            req.Seq,
+			Page:           req.Page,
+			Size:           req.Size,
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, res)
+	}
+}
+
 func (h *MessageHandler) getSendMsgReq(req *model.SendMsgReq) (*messagev1.SendMessageRequest, error) {
 	var data any
 	switch req.ContentType {
@@ -83,7 +220,7 @@ func (h *MessageHandler) getSendMsgReq(req *model.SendMsgReq) (*messagev1.SendMe
 		//	return nil, err
 		//}
 	default:
-		return nil, errorx.Wrapf(nil, "unsupported content type, contentType: %s", req.ContentType)
+		return nil, fmt.Errorf("unsupported content type, contentType: %d", req.ContentType)
 	}
 
 	if err := mapstructure.WeakDecode(req.Content, data); err != nil {
@@ -95,11 +232,13 @@ func (h *MessageHandler) getSendMsgReq(req *model.SendMsgReq) (*messagev1.SendMe
 
 func (h *MessageHandler) newUserSendMsgReq(req *model.SendMsgReq, data any) *messagev1.SendMessageRequest {
 	sendID, _ := conv.StrToInt64(req.SendID)
+	recvID, _ := conv.StrToInt64(req.RecvID)
 	groupID, _ := conv.StrToInt64(req.GroupID)
 	msgData := &messagev1.Message{
 		SendID:      sendID,
+		RecvID:      recvID,
 		GroupID:     groupID,
-		ClientMsgID: encrypt.Md5(req.SendID),
+		ClientMsgID: req.ClientMsgID,
 		SessionType: req.SessionType,
 		ContentType: req.ContentType,
 		SendTime:    req.SendTime,
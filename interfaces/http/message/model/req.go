@@ -4,8 +4,43 @@ type SendMsgReq struct {
 	RecvID      string         `json:"recv_id"`
 	SendID      string         `json:"sendID" binding:"required"`
 	GroupID     string         `json:"groupID" binding:"required_if=SessionType 2|required_if=SessionType 3"`
+	ClientMsgID string         `json:"clientMsgID" binding:"max=64"`
 	Content     map[string]any `json:"content" binding:"required" swaggerignore:"true"`
 	ContentType int32          `json:"contentType" binding:"required"`
 	SessionType int32          `json:"sessionType" binding:"required"`
 	SendTime    int64          `json:"sendTime"`
 }
+
+type RevokeMsgReq struct {
+	ConversationID string `json:"conversationID" binding:"required"`
+	Seq            int64  `json:"seq" binding:"required,min=1"`
+}
+
+type DeleteMsgsReq struct {
+	ConversationID string  `json:"conversationID" binding:"required"`
+	Seqs           []int64 `json:"seqs" binding:"required,min=1,max=100"`
+}
+
+type ClearConversationReq struct {
+	ConversationID string `json:"conversationID" binding:"required"`
+	UpToSeq        int64  `json:"upToSeq" binding:"min=0"`
+}
+
+type MarkAsReadReq struct {
+	ConversationID string `json:"conversationID" binding:"required"`
+	HasReadSeq     int64  `json:"hasReadSeq" binding:"min=0"`
+}
+
+type GetMsgReadersReq struct {
+	ConversationID string `form:"conversationID" binding:"required"`
+	Seq            int64  `form:"seq" binding:"required,min=1"`
+	Page           int32  `form:"page" binding:"min=0"`
+	Size           int32  `form:"size" binding:"min=0,max=100"`
+}
+
+type SetMsgStatusReq struct {
+	ConversationID string  `json:"conversationID" binding:"required"`
+	Seqs           []int64 `json:"seqs" binding:"required_without=ServerMsgIDs,max=100"`
+	ServerMsgIDs   []int64 `json:"serverMsgIDs" binding:"required_without=Seqs,max=100"`
+	Status         int32   `json:"status" binding:"required,min=1"` // A messagev1.MessageStatus
+}
@@ -31,6 +31,7 @@ func (h *UserHandler) RegisterRoute(r *gin.RouterGroup) {
 		userGroup.POST("avatar", h.UpdateAvatar())
 		userGroup.PUT("profile", h.UpdateProfile())
 		userGroup.POST("reset-password", h.ResetPassword())
+		userGroup.GET("conn-token", h.GetConnToken())
 	}
 }
 
@@ -190,6 +191,18 @@ func (h *UserHandler) ResetPassword() gin.HandlerFunc {
 	}
 }
 
+func (h *UserHandler) GetConnToken() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		res, err := h.userClient.GetConnToken(c.Request.Context(), &userv1.GetConnTokenRequest{})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, &model.UserConnTokenResp{ConnToken: res.GetConnToken()})
+	}
+}
+
 func userDTO2VO(userDto *userv1.User) *model.UserInfoResp {
 	return &model.UserInfoResp{
 		UserID:         conv.Int64ToStr(userDto.UserID),
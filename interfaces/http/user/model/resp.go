@@ -10,3 +10,7 @@ type UserInfoResp struct {
 	Avatar         string `json:"avatar"`
 	UserCreateTime int64  `json:"user_create_time"`
 }
+
+type UserConnTokenResp struct {
+	ConnToken string `json:"conn_token"`
+}
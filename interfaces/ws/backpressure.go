@@ -0,0 +1,176 @@
+package ws
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/crazyfrankie/goim/interfaces/ws/types"
+	"github.com/crazyfrankie/goim/pkg/logs"
+	"github.com/crazyfrankie/goim/pkg/safego"
+)
+
+// SendClass groups the frames sent to a client by how a full send queue
+// treats them.
+type SendClass int
+
+const (
+	// SendClassReply are the replies to the client's own requests
+	SendClassReply SendClass = iota
+	// SendClassChat are pushed messages the client must not miss silently
+	SendClassChat
+	// SendClassStatus are typing and online status notices, only the latest
+	// of them matters
+	SendClassStatus
+	// SendClassRoom are room messages, they are not persisted and members
+	// keeping up matter more than every one of them arriving
+	SendClassRoom
+)
+
+// BackpressurePolicy is what sending to a client with a full queue does.
+type BackpressurePolicy int
+
+const (
+	// BlockWithTimeout waits for room in the queue and gives up after the
+	// configured timeout
+	BlockWithTimeout BackpressurePolicy = iota
+	// DropOldest makes room by dropping the oldest frame of the same policy,
+	// these frames are queued apart so only lossy frames are ever dropped
+	DropOldest
+	// Disconnect closes the slow consumer, telling it to resync by seq
+	Disconnect
+)
+
+// Reasons a frame was not delivered, see SendDrops.
+const (
+	DropReasonEvicted      = "evicted"
+	DropReasonTimeout      = "timeout"
+	DropReasonSlowConsumer = "slow_consumer"
+)
+
+// Default capacity of the per-client queue of DropOldest frames.
+const defaultLossyQueueSize = 64
+
+type BackpressureConfig struct {
+	// Policies per send class, classes without an entry block
+	Policies map[SendClass]BackpressurePolicy
+	// How long BlockWithTimeout waits for room in the queue
+	BlockTimeout time.Duration
+}
+
+func DefaultBackpressureConfig() *BackpressureConfig {
+	return &BackpressureConfig{
+		Policies: map[SendClass]BackpressurePolicy{
+			SendClassReply:  BlockWithTimeout,
+			SendClassChat:   BlockWithTimeout,
+			SendClassStatus: DropOldest,
+			SendClassRoom:   DropOldest,
+		},
+		BlockTimeout: time.Second,
+	}
+}
+
+// backpressure applies the config of a server to its clients and counts the
+// frames they lost.
+type backpressure struct {
+	config *BackpressureConfig
+
+	evicted      atomic.Int64
+	timeout      atomic.Int64
+	slowConsumer atomic.Int64
+}
+
+// defaultBackpressure serves the clients not created by a server.
+var defaultBackpressure = newBackpressure(DefaultBackpressureConfig())
+
+func newBackpressure(config *BackpressureConfig) *backpressure {
+	return &backpressure{config: config}
+}
+
+func (b *backpressure) policy(class SendClass) BackpressurePolicy {
+	return b.config.Policies[class]
+}
+
+func (b *backpressure) drops() map[string]int64 {
+	return map[string]int64{
+		DropReasonEvicted:      b.evicted.Load(),
+		DropReasonTimeout:      b.timeout.Load(),
+		DropReasonSlowConsumer: b.slowConsumer.Load(),
+	}
+}
+
+// SendDrops returns how many frames were not delivered to clients of this
+// server, per reason.
+func (ws *WebsocketServer) SendDrops() map[string]int64 {
+	return ws.backpressure.drops()
+}
+
+// send queues data for the write loop, applying the backpressure policy of
+// class when the queue is full.
+func (c *Client) send(data []byte, class SendClass) error {
+	if c.closed.Load() {
+		return types.ErrClientClosed
+	}
+
+	bp := c.backpressure
+	if bp == nil {
+		bp = defaultBackpressure
+	}
+
+	switch bp.policy(class) {
+	case DropOldest:
+		for {
+			select {
+			case c.lossyCh <- data:
+				return nil
+			default:
+			}
+			select {
+			case <-c.lossyCh:
+				bp.evicted.Add(1)
+			default:
+			}
+		}
+	case Disconnect:
+		select {
+		case c.sendCh <- data:
+			return nil
+		default:
+		}
+		bp.slowConsumer.Add(1)
+		c.disconnectSlowConsumer()
+		return types.ErrSendQueueFull
+	default:
+		select {
+		case c.sendCh <- data:
+			return nil
+		default:
+		}
+
+		timer := time.NewTimer(bp.config.BlockTimeout)
+		defer timer.Stop()
+		select {
+		case c.sendCh <- data:
+			return nil
+		case <-timer.C:
+			bp.timeout.Add(1)
+			return types.ErrSendQueueFull
+		case <-c.clientCtx.Done():
+			return types.ErrClientClosed
+		}
+	}
+}
+
+// disconnectSlowConsumer closes the client once, telling it frames were lost
+// and it has to pull them by seq. It does not wait for the write, the caller
+// may hold locks the close needs.
+func (c *Client) disconnectSlowConsumer() {
+	if !c.slowConsumer.CompareAndSwap(false, true) {
+		return
+	}
+
+	logs.CtxWarnf(c.ctx, "disconnect slow consumer, userID: %s, connID: %s", c.UserID, c.ConnID)
+	safego.Go(c.clientCtx, func() {
+		c.writeCloseMessage(types.CloseSlowConsumer, "send queue overflow, resync by seq")
+		c.close()
+	})
+}
@@ -0,0 +1,125 @@
+package ws
+
+import (
+	"encoding/binary"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/crazyfrankie/goim/interfaces/ws/types"
+)
+
+// recordConn records the frames written to it, the methods the tests do not
+// use are left to the nil Conn.
+type recordConn struct {
+	Conn
+
+	mu     sync.Mutex
+	frames map[int][][]byte
+	closed chan struct{}
+}
+
+func newRecordConn() *recordConn {
+	return &recordConn{frames: make(map[int][][]byte), closed: make(chan struct{})}
+}
+
+func (r *recordConn) WriteMessage(messageType int, data []byte) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.frames[messageType] = append(r.frames[messageType], data)
+	return nil
+}
+
+func (r *recordConn) SetWriteDeadline(time.Duration) error {
+	return nil
+}
+
+func (r *recordConn) Close() error {
+	close(r.closed)
+	return nil
+}
+
+func newBackpressureClient(config *BackpressureConfig, queueSize int) *Client {
+	c := newTestClient("1001", 1, "conn-1")
+	c.sendCh = make(chan []byte, queueSize)
+	c.lossyCh = make(chan []byte, queueSize)
+	c.backpressure = newBackpressure(config)
+	return c
+}
+
+func TestSendDropOldest(t *testing.T) {
+	c := newBackpressureClient(&BackpressureConfig{
+		Policies: map[SendClass]BackpressurePolicy{SendClassStatus: DropOldest},
+	}, 2)
+	_ = c.send([]byte("chat"), SendClassChat)
+
+	for _, frame := range []string{"status-1", "status-2", "status-3"} {
+		if err := c.send([]byte(frame), SendClassStatus); err != nil {
+			t.Fatalf("send %s: %v", frame, err)
+		}
+	}
+	for _, want := range []string{"status-2", "status-3"} {
+		if got := string(<-c.lossyCh); got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+	}
+	// Chat frames are never evicted for status ones.
+	if got := string(<-c.sendCh); got != "chat" {
+		t.Fatalf("got %s, want chat", got)
+	}
+	if drops := c.backpressure.drops(); drops[DropReasonEvicted] != 1 {
+		t.Fatalf("got drops %v, want one evicted", drops)
+	}
+}
+
+func TestSendBlockWithTimeout(t *testing.T) {
+	c := newBackpressureClient(&BackpressureConfig{BlockTimeout: 20 * time.Millisecond}, 1)
+	_ = c.send([]byte("chat-1"), SendClassChat)
+
+	go func() {
+		time.Sleep(5 * time.Millisecond)
+		<-c.sendCh
+	}()
+	if err := c.send([]byte("chat-2"), SendClassChat); err != nil {
+		t.Fatalf("send after the queue drained: %v", err)
+	}
+
+	if err := c.send([]byte("chat-3"), SendClassChat); !errors.Is(err, types.ErrSendQueueFull) {
+		t.Fatalf("got %v, want %v", err, types.ErrSendQueueFull)
+	}
+	if drops := c.backpressure.drops(); drops[DropReasonTimeout] != 1 {
+		t.Fatalf("got drops %v, want one timeout", drops)
+	}
+}
+
+func TestSendDisconnectSlowConsumer(t *testing.T) {
+	c := newBackpressureClient(&BackpressureConfig{
+		Policies: map[SendClass]BackpressurePolicy{SendClassChat: Disconnect},
+	}, 1)
+	conn := newRecordConn()
+	c.conn = conn
+	_ = c.send([]byte("chat-1"), SendClassChat)
+
+	if err := c.send([]byte("chat-2"), SendClassChat); !errors.Is(err, types.ErrSendQueueFull) {
+		t.Fatalf("got %v, want %v", err, types.ErrSendQueueFull)
+	}
+
+	select {
+	case <-conn.closed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("slow consumer was not closed")
+	}
+	if err := c.send([]byte("chat-3"), SendClassChat); !errors.Is(err, types.ErrClientClosed) {
+		t.Fatalf("got %v, want %v", err, types.ErrClientClosed)
+	}
+	conn.mu.Lock()
+	defer conn.mu.Unlock()
+	closeFrames := conn.frames[CloseMessage]
+	if len(closeFrames) != 1 || binary.BigEndian.Uint16(closeFrames[0]) != types.CloseSlowConsumer {
+		t.Fatalf("got close frames %q, want one with code %d", closeFrames, types.CloseSlowConsumer)
+	}
+	if drops := c.backpressure.drops(); drops[DropReasonSlowConsumer] != 1 {
+		t.Fatalf("got drops %v, want one slow consumer", drops)
+	}
+}
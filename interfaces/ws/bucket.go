@@ -5,12 +5,19 @@ import (
 	"sync"
 	"sync/atomic"
 	"time"
+
+	"github.com/crazyfrankie/goim/interfaces/ws/types"
+	"github.com/crazyfrankie/goim/pkg/logs"
 )
 
 // BroadcastReq represents a broadcast request
 type BroadcastReq struct {
 	RoomID  string
 	Message []byte
+	Class   SendClass
+	// frames encodes a room message per connection encoding, it is sent
+	// instead of Message when set
+	frames *roomFrames
 }
 
 // BucketManager performs sharding based on city-hash, where the number of cities (bucketNum) can be specified.
@@ -19,21 +26,38 @@ type BroadcastReq struct {
 type BucketManager struct {
 	buckets   []*Bucket
 	bucketNum uint32
+	// ch merges the user state changes of all buckets
+	ch chan UserState
 }
 
+// userStateChanSize is the capacity of the merged user state channel, changes
+// beyond it are dropped and picked up again by the renewal.
+const userStateChanSize = 1024
+
 type BucketConfig struct {
 	ChannelSize   int
 	RoomSize      int
 	RoutineAmount int
 	RoutineSize   int
+	// How many rooms a client can be in at once
+	ClientRoomLimit int
 }
 
+// Default room broadcast workers of a bucket and the queue of each.
+const (
+	defaultRoutineAmount = 4
+	defaultRoutineSize   = 1024
+	// Default number of rooms a client can be in at once
+	defaultClientRoomLimit = 16
+)
+
 func DefaultBucketConfig() *BucketConfig {
 	return &BucketConfig{
-		ChannelSize:   0,
-		RoomSize:      0,
-		RoutineAmount: 0,
-		RoutineSize:   0,
+		ChannelSize:     0,
+		RoomSize:        0,
+		RoutineAmount:   defaultRoutineAmount,
+		RoutineSize:     defaultRoutineSize,
+		ClientRoomLimit: defaultClientRoomLimit,
 	}
 }
 
@@ -41,10 +65,11 @@ func NewBucketManager(bucketNum int, config *BucketConfig) *BucketManager {
 	bm := &BucketManager{
 		buckets:   make([]*Bucket, bucketNum),
 		bucketNum: uint32(bucketNum),
+		ch:        make(chan UserState, userStateChanSize),
 	}
 
 	for i := 0; i < bucketNum; i++ {
-		bm.buckets[i] = NewBucket(i, config)
+		bm.buckets[i] = NewBucket(i, config, bm.ch)
 	}
 
 	return bm
@@ -59,6 +84,22 @@ func (bm *BucketManager) GetAllBuckets() []*Bucket {
 	return bm.buckets
 }
 
+// IPConnCount returns the number of connections from ip on this node.
+func (bm *BucketManager) IPConnCount(ip string) int32 {
+	var n int32
+	for _, b := range bm.buckets {
+		b.lock.RLock()
+		n += b.ipCount[ip]
+		b.lock.RUnlock()
+	}
+	return n
+}
+
+// UserState returns the online and offline transitions of users on this node.
+func (bm *BucketManager) UserState() <-chan UserState {
+	return bm.ch
+}
+
 // Bucket Connecting Shards
 type Bucket struct {
 	id   int
@@ -72,6 +113,8 @@ type Bucket struct {
 	userMap map[string]*UserPlatforms
 	ipCount map[string]int32
 
+	clientRoomLimit int
+
 	// Asynchronous processing
 	routines   []chan *BroadcastReq
 	routineNum uint64
@@ -114,18 +157,35 @@ func (u *UserPlatforms) PlatformIDSet() map[int32]struct{} {
 	return platformIDs
 }
 
-func NewBucket(id int, config *BucketConfig) *Bucket {
+func NewBucket(id int, config *BucketConfig, ch chan UserState) *Bucket {
+	routineAmount := config.RoutineAmount
+	if routineAmount <= 0 {
+		routineAmount = defaultRoutineAmount
+	}
+	clientRoomLimit := config.ClientRoomLimit
+	if clientRoomLimit <= 0 {
+		clientRoomLimit = defaultClientRoomLimit
+	}
+	// An unbuffered queue would drop every broadcast a worker is not waiting for
+	routineSize := config.RoutineSize
+	if routineSize <= 0 {
+		routineSize = defaultRoutineSize
+	}
+
 	b := &Bucket{
-		id:       id,
-		clients:  make(map[string]*Client, config.ChannelSize),
-		rooms:    make(map[string]*Room, config.RoomSize),
-		userMap:  make(map[string]*UserPlatforms),
-		ipCount:  make(map[string]int32),
-		routines: make([]chan *BroadcastReq, config.RoutineAmount),
+		id:      id,
+		ch:      ch,
+		clients: make(map[string]*Client, config.ChannelSize),
+		rooms:   make(map[string]*Room, config.RoomSize),
+		userMap: make(map[string]*UserPlatforms),
+		ipCount: make(map[string]int32),
+
+		clientRoomLimit: clientRoomLimit,
+		routines:        make([]chan *BroadcastReq, routineAmount),
 	}
 
-	for i := 0; i < config.RoutineAmount; i++ {
-		ch := make(chan *BroadcastReq, config.RoutineSize)
+	for i := 0; i < routineAmount; i++ {
+		ch := make(chan *BroadcastReq, routineSize)
 		b.routines[i] = ch
 		go b.roomProcessor(ch)
 	}
@@ -175,12 +235,9 @@ func (b *Bucket) DelClient(client *Client) {
 		}
 	}
 
-	// 从房间中移除
-	if client.room != nil {
-		if client.room.DelClient(client) {
-			// 房间为空，删除房间
-			delete(b.rooms, client.room.ID)
-		}
+	// 从所有房间中移除
+	for _, room := range client.rooms {
+		b.leaveRoom(client, room)
 	}
 }
 
@@ -260,21 +317,34 @@ func (b *Bucket) push(userID string, userPlatform *UserPlatforms, offline []int3
 	}
 }
 
+// pushTransition reports that a platform of the user came online, or went
+// offline when offline is set. A full channel only delays the change until
+// the next renewal, so it must not block the bucket.
+func (b *Bucket) pushTransition(userID string, userPlatform *UserPlatforms, offline []int32) {
+	if !b.push(userID, userPlatform, offline) {
+		logs.Warnf("user state channel is full, drop change of user %s, offline: %v", userID, offline)
+	}
+}
+
 // UserState return User's State
 func (b *Bucket) UserState() chan<- UserState {
 	return b.ch
 }
 
-// JoinRoom Join the room
+// JoinRoom Join the room, only clients of the bucket can join its rooms. The
+// client stays in the rooms it is in, up to the client room limit.
 func (b *Bucket) JoinRoom(client *Client, roomID, roomType string) error {
 	b.lock.Lock()
 	defer b.lock.Unlock()
 
-	// 离开当前房间
-	if client.room != nil {
-		if client.room.DelClient(client) {
-			delete(b.rooms, client.room.ID)
-		}
+	if b.clients[client.Key()] != client {
+		return types.ErrClientNotFound
+	}
+	if _, ok := client.rooms[roomID]; ok {
+		return nil
+	}
+	if len(client.rooms) >= b.clientRoomLimit {
+		return types.ErrRoomLimitExceeded
 	}
 
 	// 加入新房间
@@ -283,19 +353,53 @@ func (b *Bucket) JoinRoom(client *Client, roomID, roomType string) error {
 		room = NewRoom(roomID, roomType)
 		b.rooms[roomID] = room
 	}
+	if err := room.AddClient(client); err != nil {
+		return err
+	}
+	if client.rooms == nil {
+		client.rooms = make(map[string]*Room)
+	}
+	client.rooms[roomID] = room
 
-	return room.AddClient(client)
+	return nil
 }
 
-// LeaveRoom Leave the room
-func (b *Bucket) LeaveRoom(client *Client) {
+// LeaveRoom Leave the room, it reports whether the client was in it
+func (b *Bucket) LeaveRoom(client *Client, roomID string) bool {
 	b.lock.Lock()
 	defer b.lock.Unlock()
 
-	if client.room != nil {
-		if client.room.DelClient(client) {
-			delete(b.rooms, client.room.ID)
-		}
+	room, ok := client.rooms[roomID]
+	if !ok {
+		return false
+	}
+	b.leaveRoom(client, room)
+	return true
+}
+
+// InRoom reports whether the client is in the room
+func (b *Bucket) InRoom(client *Client, roomID string) bool {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
+	_, ok := client.rooms[roomID]
+	return ok
+}
+
+// RoomCount returns how many rooms the client is in
+func (b *Bucket) RoomCount(client *Client) int {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
+	return len(client.rooms)
+}
+
+// leaveRoom removes the client from room and drops the room once empty, the
+// caller holds b.lock.
+func (b *Bucket) leaveRoom(client *Client, room *Room) {
+	delete(client.rooms, room.ID)
+	if room.DelClient(client) {
+		delete(b.rooms, room.ID)
 	}
 }
 
@@ -308,27 +412,31 @@ func (b *Bucket) GetRoom(roomID string) (*Room, bool) {
 	return room, ok
 }
 
-// BroadcastRoom Room Broadcast
+// BroadcastRoom Room Broadcast, buckets without members of the room skip it
 func (b *Bucket) BroadcastRoom(req *BroadcastReq) {
+	b.lock.RLock()
+	_, ok := b.rooms[req.RoomID]
+	b.lock.RUnlock()
+	if !ok {
+		return
+	}
+
 	idx := atomic.AddUint64(&b.routineNum, 1) % uint64(len(b.routines))
 	select {
 	case b.routines[idx] <- req:
 	default:
 		// 队列满时丢弃消息
+		logs.Warnf("room broadcast queue of bucket %d is full, drop message of room %s", b.id, req.RoomID)
 	}
 }
 
 // Broadcast Global Broadcast
-func (b *Bucket) Broadcast(msg []byte) {
+func (b *Bucket) Broadcast(msg []byte, class SendClass) {
 	b.lock.RLock()
 	defer b.lock.RUnlock()
 
 	for _, client := range b.clients {
-		select {
-		case client.sendCh <- msg:
-		default:
-			// 发送队列满时跳过
-		}
+		_ = client.send(msg, class)
 	}
 }
 
@@ -354,8 +462,12 @@ func (b *Bucket) updateUserPlatforms(client *Client, add bool) {
 	defer userPlatforms.mutex.Unlock()
 
 	if add {
+		online := len(userPlatforms.Platforms[platformID]) == 0
 		userPlatforms.Platforms[platformID] = append(userPlatforms.Platforms[platformID], client)
 		userPlatforms.lastTime = time.Now().Unix()
+		if online {
+			b.pushTransition(userID, userPlatforms, nil)
+		}
 	} else {
 		clients := userPlatforms.Platforms[platformID]
 		for i, c := range clients {
@@ -366,8 +478,9 @@ func (b *Bucket) updateUserPlatforms(client *Client, add bool) {
 		}
 
 		// 清理空平台
-		if len(userPlatforms.Platforms[platformID]) == 0 {
+		if _, ok := userPlatforms.Platforms[platformID]; ok && len(userPlatforms.Platforms[platformID]) == 0 {
 			delete(userPlatforms.Platforms, platformID)
+			b.pushTransition(userID, userPlatforms, []int32{platformID})
 		}
 
 		// 清理空用户
@@ -384,8 +497,13 @@ func (b *Bucket) roomProcessor(ch chan *BroadcastReq) {
 		room, ok := b.rooms[req.RoomID]
 		b.lock.RUnlock()
 
-		if ok {
-			room.Broadcast(req.Message)
+		if !ok {
+			continue
+		}
+		if req.frames != nil {
+			room.broadcastFrames(req.frames, req.Class)
+		} else {
+			room.Broadcast(req.Message, req.Class)
 		}
 	}
 }
@@ -0,0 +1,154 @@
+package ws
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"slices"
+	"testing"
+	"time"
+
+	wsctx "github.com/crazyfrankie/goim/interfaces/ws/context"
+	"github.com/crazyfrankie/goim/interfaces/ws/encoding"
+	"github.com/crazyfrankie/goim/interfaces/ws/types"
+	"github.com/crazyfrankie/goim/pkg/sonic"
+	gatewayv1 "github.com/crazyfrankie/goim/protocol/gateway/v1"
+)
+
+func newTestClient(userID string, platformID int32, connID string) *Client {
+	clientCtx, cancel := context.WithCancel(context.Background())
+	return &Client{
+		ctx:           &wsctx.Context{Request: &http.Request{URL: &url.URL{}}, RemoteAddr: connID, ConnID: connID},
+		UserID:        userID,
+		PlatformID:    platformID,
+		ConnID:        connID,
+		sendCh:        make(chan []byte, 8),
+		lossyCh:       make(chan []byte, 8),
+		subscriptions: make(map[string]struct{}),
+		encoder:       encoding.NewJSONEncoder(),
+		dataEncoder:   encoding.NewJSONEncoder(),
+		rateLimits:    make(tokenBuckets),
+		clientCtx:     clientCtx,
+		cancel:        cancel,
+	}
+}
+
+func expectState(t *testing.T, bm *BucketManager, online, offline []int32) {
+	t.Helper()
+
+	select {
+	case state := <-bm.UserState():
+		slices.Sort(state.Online)
+		if !slices.Equal(state.Online, online) || !slices.Equal(state.Offline, offline) {
+			t.Fatalf("got online %v offline %v, want online %v offline %v", state.Online, state.Offline, online, offline)
+		}
+	default:
+		t.Fatalf("no state change, want online %v offline %v", online, offline)
+	}
+}
+
+func expectNoState(t *testing.T, bm *BucketManager) {
+	t.Helper()
+
+	select {
+	case state := <-bm.UserState():
+		t.Fatalf("unexpected state change %+v", state)
+	default:
+	}
+}
+
+func TestBucketUserStateTransitions(t *testing.T) {
+	bm := NewBucketManager(4, DefaultBucketConfig())
+	bucket := bm.GetBucket("1001")
+	phone := newTestClient("1001", 1, "conn-1")
+	phone2 := newTestClient("1001", 1, "conn-2")
+	pc := newTestClient("1001", 3, "conn-3")
+
+	_ = bucket.PutClient(phone)
+	expectState(t, bm, []int32{1}, nil)
+
+	// A second connection on an online platform is no transition.
+	_ = bucket.PutClient(phone2)
+	expectNoState(t, bm)
+
+	_ = bucket.PutClient(pc)
+	expectState(t, bm, []int32{1, 3}, nil)
+
+	bucket.DelClient(phone)
+	expectNoState(t, bm)
+
+	bucket.DelClient(phone2)
+	expectState(t, bm, []int32{3}, []int32{1})
+
+	bucket.DelClient(pc)
+	expectState(t, bm, nil, []int32{3})
+
+	// Removing a client twice must not report the platform offline again.
+	bucket.DelClient(pc)
+	expectNoState(t, bm)
+}
+
+func TestBucketUserStateChannelFull(t *testing.T) {
+	ch := make(chan UserState, 1)
+	bucket := NewBucket(0, DefaultBucketConfig(), ch)
+
+	_ = bucket.PutClient(newTestClient("1001", 1, "conn-1"))
+	// The channel is full, the change is dropped instead of blocking the bucket.
+	_ = bucket.PutClient(newTestClient("1002", 1, "conn-2"))
+
+	if state := <-ch; state.UserID != "1001" {
+		t.Fatalf("got change of user %s, want 1001", state.UserID)
+	}
+	if _, ok := bucket.GetClient("conn-2"); !ok {
+		t.Fatal("client was not added while the channel was full")
+	}
+}
+
+func readOnlineStatus(t *testing.T, c *Client) *gatewayv1.SubUserOnlineStatusElem {
+	t.Helper()
+
+	select {
+	case raw := <-c.lossyCh:
+		var frame Resp
+		if err := sonic.Unmarshal(raw, &frame); err != nil {
+			t.Fatalf("unmarshal frame: %v", err)
+		}
+		if frame.ReqIdentifier != types.WsSubUserOnlineStatus {
+			t.Fatalf("got reqIdentifier %d, want %d", frame.ReqIdentifier, types.WsSubUserOnlineStatus)
+		}
+		var tips gatewayv1.SubUserOnlineStatusTips
+		if err := sonic.Unmarshal(frame.Data, &tips); err != nil {
+			t.Fatalf("unmarshal tips: %v", err)
+		}
+		if len(tips.Subscribers) != 1 {
+			t.Fatalf("got %d subscribers, want 1", len(tips.Subscribers))
+		}
+		return tips.Subscribers[0]
+	case <-time.After(5 * time.Second):
+		t.Fatal("subscriber was not notified")
+		return nil
+	}
+}
+
+func TestChangeOnlineStatusNotifiesSubscribers(t *testing.T) {
+	wsSrv := NewWebsocketServer(WithBucketNum(4))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go wsSrv.ChangeOnlineStatus(ctx, 1)
+
+	subscriber := newTestClient("1002", 1, "conn-sub")
+	wsSrv.subscription.Sub(subscriber, []string{"1001"}, nil)
+
+	phone := newTestClient("1001", 1, "conn-1")
+	wsSrv.registerClient(phone)
+	elem := readOnlineStatus(t, subscriber)
+	if elem.UserID != "1001" || !slices.Equal(elem.OnlinePlatformIDs, []int32{1}) {
+		t.Fatalf("unexpected online status %+v", elem)
+	}
+
+	wsSrv.unregisterClient(phone)
+	elem = readOnlineStatus(t, subscriber)
+	if elem.UserID != "1001" || len(elem.OnlinePlatformIDs) != 0 {
+		t.Fatalf("unexpected offline status %+v", elem)
+	}
+}
@@ -2,12 +2,19 @@ package ws
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
 	"runtime/debug"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"github.com/gorilla/websocket"
+
 	wsctx "github.com/crazyfrankie/goim/interfaces/ws/context"
 	"github.com/crazyfrankie/goim/interfaces/ws/encoding"
 	"github.com/crazyfrankie/goim/interfaces/ws/types"
@@ -15,8 +22,10 @@ import (
 	"github.com/crazyfrankie/goim/pkg/errorx"
 	"github.com/crazyfrankie/goim/pkg/gin/response"
 	"github.com/crazyfrankie/goim/pkg/logs"
+	"github.com/crazyfrankie/goim/pkg/msgprocessor"
 	"github.com/crazyfrankie/goim/pkg/safego"
 	"github.com/crazyfrankie/goim/pkg/sonic"
+	gatewayv1 "github.com/crazyfrankie/goim/protocol/gateway/v1"
 	messagev1 "github.com/crazyfrankie/goim/protocol/message/v1"
 	"github.com/crazyfrankie/goim/types/consts"
 )
@@ -52,6 +61,10 @@ const (
 
 	// Maximum message size allowed from peer.
 	maxMessageSize = 51200
+
+	// Default capacity of the per-client send queue and receive ring.
+	defaultSendQueueSize = 256
+	defaultRecvRingSize  = 64
 )
 
 type PingPongHandler func(string) error
@@ -66,6 +79,7 @@ type ClientConfig struct {
 type Client struct {
 	conn Conn
 	ctx  *wsctx.Context
+	ip   string
 
 	UserID       string
 	PlatformID   int32
@@ -77,19 +91,36 @@ type Client struct {
 
 	sendCh   chan []byte
 	recvRing *Ring
+	// lossyCh queues the frames of DropOldest classes apart from sendCh
+	lossyCh      chan []byte
+	backpressure *backpressure
+	slowConsumer atomic.Bool
+
+	// session numbers and keeps the pushes for a resume, nil when disabled
+	session *session
+	// acks keeps the pushes not acked yet, nil unless the client asked for ACKs
+	acks *ackTracker
 
 	subscriptions map[string]struct{}
 	subLock       sync.RWMutex
 
-	encoder encoding.Encoder
+	// encoder encodes the Req/Resp envelope, dataEncoder the payload it carries
+	encoding    string
+	encoder     encoding.Encoder
+	dataEncoder encoding.Encoder
+
+	// rate limit buckets of this connection and its recent violations, only
+	// touched by the read loop
+	rateLimits     tokenBuckets
+	violations     int
+	violationStart time.Time
 
 	closed     atomic.Bool
 	closedErr  error
-	lastActive int64
+	lastActive atomic.Int64
 
-	room *Room
-	Next *Client
-	Prev *Client
+	// rooms the client is in by ID, guarded by the lock of its bucket
+	rooms map[string]*Room
 
 	clientCtx context.Context
 	cancel    context.CancelFunc
@@ -102,6 +133,7 @@ type Client struct {
 
 func NewClient(conn Conn, ctx *wsctx.Context, config *ClientConfig, connServer LongConnServer) *Client {
 	clientCtx, cancel := context.WithCancel(context.Background())
+	encoder, dataEncoder := newEncoders(ctx.GetEncoding())
 
 	client := &Client{
 		conn:          conn,
@@ -112,14 +144,18 @@ func NewClient(conn Conn, ctx *wsctx.Context, config *ClientConfig, connServer L
 		SDKType:       ctx.GetSDKType(),
 		ConnID:        ctx.GetConnID(),
 		sendCh:        make(chan []byte, config.SendQueueSize),
+		lossyCh:       make(chan []byte, defaultLossyQueueSize),
 		recvRing:      NewRing(config.RecvRingSize),
 		subscriptions: make(map[string]struct{}),
-		encoder:       encoding.NewJSONEncoder(),
+		encoding:      ctx.GetEncoding(),
+		encoder:       encoder,
+		dataEncoder:   dataEncoder,
+		rateLimits:    make(tokenBuckets),
 		clientCtx:     clientCtx,
 		cancel:        cancel,
-		lastActive:    time.Now().Unix(),
 		ConnServer:    connServer,
 	}
+	client.lastActive.Store(time.Now().Unix())
 
 	return client
 }
@@ -136,15 +172,23 @@ func (c *Client) Reset(ctx *wsctx.Context, conn Conn, wsSrv LongConnServer) {
 	c.IsCompress = ctx.GetCompression()
 	c.IsBackground = false
 
+	c.rateLimits = make(tokenBuckets)
+	c.violations = 0
+	c.violationStart = time.Time{}
+
+	c.slowConsumer.Store(false)
+	c.session = nil
+	c.acks = nil
 	c.closed.Store(false)
 	c.closedErr = nil
-	c.lastActive = 0
+	c.lastActive.Store(0)
 
-	c.room = nil
-	c.Next = nil
-	c.Prev = nil
+	c.rooms = nil
 
 	c.subLock.Lock()
+	if c.subscriptions == nil {
+		c.subscriptions = make(map[string]struct{})
+	}
 	for k := range c.subscriptions {
 		delete(c.subscriptions, k)
 	}
@@ -152,90 +196,182 @@ func (c *Client) Reset(ctx *wsctx.Context, conn Conn, wsSrv LongConnServer) {
 
 	if c.recvRing != nil {
 		c.recvRing.Reset()
-	}
-
-	if c.SDKType == types.GoSDK {
-		c.encoder = encoding.NewGobEncoder()
 	} else {
-		c.encoder = encoding.NewJSONEncoder()
+		c.recvRing = NewRing(defaultRecvRingSize)
 	}
 
-	if c.sendCh != nil {
-		for {
-			select {
-			case <-c.sendCh:
-			default:
-				goto done
-			}
+	c.encoding = ctx.GetEncoding()
+	c.encoder, c.dataEncoder = newEncoders(c.encoding)
+
+	if c.sendCh == nil {
+		c.sendCh = make(chan []byte, defaultSendQueueSize)
+	}
+	if c.lossyCh == nil {
+		c.lossyCh = make(chan []byte, defaultLossyQueueSize)
+	}
+	for {
+		select {
+		case <-c.sendCh:
+		case <-c.lossyCh:
+		default:
+			goto done
 		}
-	done:
 	}
+done:
 
 	c.ConnServer = wsSrv
 
-	// 取消上下文
-	if c.cancel != nil {
-		c.cancel()
-		c.cancel = nil
-	}
-	c.clientCtx = nil
+	c.clientCtx, c.cancel = context.WithCancel(context.Background())
 }
 
 func (c *Client) Key() string {
 	return c.ConnID
 }
 
+// IP returns the remote IP of the connection, see clientIP.
 func (c *Client) IP() string {
-	return c.ctx.GetRemoteAddr()
+	return c.ip
+}
+
+// clientIP returns the IP the request came from. X-Forwarded-For is only
+// believed as far as it was written by trusted proxies, hops are appended on
+// the right so its rightmost untrusted entry is the client, everything left
+// of it may be made up by the client.
+func clientIP(r *http.Request, trusted []netip.Prefix) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	peer, err := netip.ParseAddr(host)
+	if err != nil || !isTrustedProxy(peer, trusted) {
+		return host
+	}
+
+	var hops []string
+	for _, header := range r.Header.Values("X-Forwarded-For") {
+		for hop := range strings.SplitSeq(header, ",") {
+			hops = append(hops, strings.TrimSpace(hop))
+		}
+	}
+	ip := peer
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop, err := netip.ParseAddr(hops[i])
+		if err != nil {
+			break
+		}
+		ip = hop
+		if !isTrustedProxy(hop, trusted) {
+			break
+		}
+	}
+
+	return ip.Unmap().String()
+}
+
+func isTrustedProxy(ip netip.Addr, trusted []netip.Prefix) bool {
+	ip = ip.Unmap()
+	for _, prefix := range trusted {
+		if prefix.Contains(ip) {
+			return true
+		}
+	}
+	return false
 }
 
 func (c *Client) Start() {
+	// Taken before the loops start, the client may be recycled as soon as
+	// they return.
+	acks, ctx := c.acks, c.clientCtx
 	c.wg.Add(2)
+	if acks != nil {
+		c.wg.Add(1)
+	}
 	go c.readLoop()
 	go c.writeLoop()
+	if acks != nil {
+		go c.retransmitLoop(ctx, acks)
+	}
 }
 
+// KickOnlineMessage tells the client it was kicked and closes it. The frame
+// is written directly rather than queued, closing stops the write loop before
+// it may drain the queue.
 func (c *Client) KickOnlineMessage() error {
 	resp := &Resp{
 		ReqIdentifier: types.WSKickOnlineMsg,
 	}
 	logs.CtxDebugf(c.ctx, "KickOnlineMessage debug")
-	err := c.sendResp(resp)
+	data, err := c.encoder.Encode(resp)
+	if err == nil {
+		err = c.writeMessage(data)
+	}
 	c.close()
 	return err
 }
 
-func (c *Client) PushUserOnlineStatus(data []byte) error {
+func (c *Client) PushUserOnlineStatus(tips *gatewayv1.SubUserOnlineStatusTips) error {
+	data, err := c.dataEncoder.Encode(tips)
+	if err != nil {
+		return err
+	}
 	resp := &Resp{
 		ReqIdentifier: types.WsSubUserOnlineStatus,
 		Data:          data,
 	}
-	return c.sendResp(resp)
+	return c.sendResp(resp, SendClassStatus)
 }
 
+// PushMessage writes msgData to the client as a WSPushMsg frame. Pushes other
+// than typing go through the session, which numbers them for a resume.
 func (c *Client) PushMessage(ctx context.Context, msgData *messagev1.Message) error {
-	//var msg *messagev1.Message
-	//conversationID := msgprocessor.GetConversationIDByMsg(msgData)
-	//m := map[string]*sdkws.PullMsgs{conversationID: {Msgs: []*sdkws.MsgData{msgData}}}
-	//if msgprocessor.IsNotification(conversationID) {
-	//	msg.NotificationMsgs = m
-	//} else {
-	//	msg.Msgs = m
-	//}
-	//log.ZDebug(ctx, "PushMessage", "msg", &msg)
-	//data, err := proto.Marshal(&msg)
-	//if err != nil {
-	//	return err
-	//}
-	//resp := Resp{
-	//	ReqIdentifier: WSPushMsg,
-	//	OperationID:   mcontext.GetOperationID(ctx),
-	//	Data:          data,
-	//}
-	//return c.writeBinaryMsg(resp)
+	logs.CtxDebugf(ctx, "PushMessage, userID: %s, conversationID: %s, seq: %d", c.UserID, msgData.GetConversationID(), msgData.GetSeq())
+
+	resp, err := newPushResp(c.dataEncoder, msgData)
+	if err != nil {
+		return err
+	}
+
+	if msgData.GetContentType() == consts.TypingMessageType {
+		return c.sendResp(resp, SendClassStatus)
+	}
+	if c.session != nil {
+		err = c.session.push(c, resp)
+	} else {
+		err = c.sendResp(resp, SendClassChat)
+	}
+	if err != nil {
+		return err
+	}
+	c.trackPush(msgData, resp)
+
 	return nil
 }
 
+// newPushResp builds the WSPushMsg frame of msgData, grouped under its
+// conversation the same way pulled messages are.
+func newPushResp(dataEncoder encoding.Encoder, msgData *messagev1.Message) (*Resp, error) {
+	var msg gatewayv1.PushMessages
+	conversationID := msgData.GetConversationID()
+	if conversationID == "" {
+		conversationID = msgprocessor.GetConversationIDByMsg(msgData)
+	}
+	m := map[string]*gatewayv1.PullMsgs{conversationID: {Msgs: []*messagev1.Message{msgData}}}
+	if msgprocessor.IsNotification(conversationID) {
+		msg.NotificationMsgs = m
+	} else {
+		msg.Msgs = m
+	}
+
+	data, err := dataEncoder.Encode(&msg)
+	if err != nil {
+		return nil, err
+	}
+	return &Resp{
+		ReqIdentifier: types.WSPushMsg,
+		Data:          data,
+	}, nil
+}
+
 func (c *Client) readLoop() {
 	defer func() {
 		if r := recover(); r != nil {
@@ -266,7 +402,7 @@ func (c *Client) readLoop() {
 			return
 		}
 
-		c.lastActive = time.Now().Unix()
+		c.lastActive.Store(time.Now().Unix())
 
 		switch messageType {
 		case MessageBinary:
@@ -325,6 +461,13 @@ func (c *Client) writeLoop() {
 				batch = batch[:0]
 			}
 
+		case msgData := <-c.lossyCh:
+			batch = append(batch, msgData)
+			if len(batch) >= maxBatchSize {
+				c.flushBatch(batch)
+				batch = batch[:0]
+			}
+
 		case <-ticker.C:
 			if len(batch) > 0 {
 				c.flushBatch(batch)
@@ -377,12 +520,21 @@ func (c *Client) processMessage(message []byte) error {
 	}
 
 	if binaryReq.SendID != c.UserID {
-		return errorx.Wrapf(nil, "exception conn userID not same to req userID, binaryReq: %s", binaryReq.String())
+		return fmt.Errorf("exception conn userID not same to req userID, binaryReq: %s", binaryReq.String())
+	}
+
+	if err := c.ConnServer.RateLimit(c, binaryReq.ReqIdentifier); err != nil {
+		if errors.Is(err, types.ErrRateLimitExceeded) {
+			c.writeCloseMessage(types.CloseRateLimited, err.Error())
+			return err
+		}
+		return c.replyMessage(ctx, binaryReq, err, nil)
 	}
 
 	ctxcache.StoreM(ctx,
+		dataEncoderKey{}, c.dataEncoder,
 		types.OperationID, binaryReq.OperationID,
-		types.WsUserID, binaryReq.SendID,
+		types.WsUserID, c.UserID,
 		types.PlatformID, consts.PlatformIDToName(c.PlatformID),
 		types.ConnID, c.ctx.GetConnID())
 
@@ -414,6 +566,14 @@ func (c *Client) processMessage(message []byte) error {
 		resp, messageErr = c.setAppBackgroundStatus(ctx, binaryReq)
 	case types.WsSubUserOnlineStatus:
 		resp, messageErr = c.ConnServer.SubUserOnlineStatus(ctx, c, binaryReq)
+	case types.WsMsgAck:
+		resp, messageErr = c.ConnServer.MsgAck(ctx, c, binaryReq)
+	case types.WsJoinRoom:
+		resp, messageErr = c.ConnServer.JoinRoom(ctx, c, binaryReq)
+	case types.WsLeaveRoom:
+		resp, messageErr = c.ConnServer.LeaveRoom(ctx, c, binaryReq)
+	case types.WsSendRoomMsg:
+		resp, messageErr = c.ConnServer.SendRoomMessage(ctx, c, binaryReq)
 	default:
 		return fmt.Errorf(
 			"ReqIdentifier failed,sendID:%s,msgIncr:%s,reqIdentifier:%d",
@@ -463,18 +623,18 @@ func (c *Client) replyMessage(ctx context.Context, binaryReq *Req, err error, re
 	}
 	t := time.Now()
 	logs.CtxDebugf(ctx, "gateway reply message, resp: %s", mReply.String())
-	err = c.sendResp(mReply)
+	err = c.sendResp(mReply, SendClassReply)
 	if err != nil {
 		logs.CtxWarnf(ctx, "wireBinaryMsg replyMessage, err: %v, resp: %s", err, mReply.String())
 	}
 	logs.CtxDebugf(ctx, "wireBinaryMsg end, time cost: %v", time.Since(t))
 
 	if binaryReq.ReqIdentifier == types.WsLogoutMsg {
+		// give writeLoop a chance to flush the reply before the connection goes away
 		go func() {
 			time.Sleep(time.Millisecond * 100)
 			c.close()
 		}()
-		return errorx.Wrapf(nil, "user logout, operationID: %s", binaryReq.OperationID)
 	}
 
 	return nil
@@ -489,15 +649,11 @@ func (c *Client) close() error {
 		c.ConnServer.UnRegister(c)
 	}
 
+	// sendCh is left open: writers may still race with close, and writeLoop
+	// exits through clientCtx instead.
 	c.cancel()
-	if c.sendCh != nil {
-		close(c.sendCh)
-	}
 
-	err := c.conn.Close()
-	c.wg.Wait()
-
-	return err
+	return c.conn.Close()
 }
 
 func (c *Client) activeHeartBeat(ctx context.Context) {
@@ -513,7 +669,8 @@ func (c *Client) activeHeartBeat(ctx context.Context) {
 					logs.CtxWarnf(c.ctx, "send Ping Message error, %v", err)
 					return
 				}
-			case <-c.clientCtx.Done():
+			// Not c.clientCtx, a recycled client gets a new one.
+			case <-ctx.Done():
 				return
 			}
 		}
@@ -552,6 +709,24 @@ func (c *Client) writePingMsg() error {
 	return c.conn.WriteMessage(PingMessage, nil)
 }
 
+// writeCloseMessage tells the peer why the server is about to close the
+// connection.
+func (c *Client) writeCloseMessage(code int, text string) {
+	if c.closed.Load() {
+		return
+	}
+
+	c.writeMutex.Lock()
+	defer c.writeMutex.Unlock()
+
+	if err := c.conn.SetWriteDeadline(writeWait); err != nil {
+		return
+	}
+	if err := c.conn.WriteMessage(CloseMessage, websocket.FormatCloseMessage(code, text)); err != nil {
+		logs.CtxWarnf(c.ctx, "write close message failed, code: %d, err: %v", code, err)
+	}
+}
+
 func (c *Client) writePongMsg(appData string) error {
 	logs.CtxDebugf(c.ctx, "write Pong Msg in Server, appData: %v", appData)
 	if c.closed.Load() {
@@ -575,22 +750,13 @@ func (c *Client) writePongMsg(appData string) error {
 	return errorx.Wrapf(err, "")
 }
 
-func (c *Client) sendResp(resp *Resp) error {
+func (c *Client) sendResp(resp *Resp, class SendClass) error {
 	data, err := c.encoder.Encode(resp)
 	if err != nil {
 		return err
 	}
 
-	if c.closed.Load() {
-		return types.ErrClientClosed
-	}
-
-	select {
-	case c.sendCh <- data:
-		return nil
-	default:
-		return types.ErrSendQueueFull
-	}
+	return c.send(data, class)
 }
 
 func (c *Client) writeMessage(data []byte) error {
@@ -620,7 +786,7 @@ func (c *Client) writeMessage(data []byte) error {
 		return err
 	}
 
-	c.lastActive = time.Now().Unix()
+	c.lastActive.Store(time.Now().Unix())
 
 	return nil
 }
@@ -643,6 +809,20 @@ func (c *Client) setAppBackgroundStatus(ctx context.Context, binaryReq *Req) ([]
 	return resp, nil
 }
 
+// newEncoders returns the envelope and payload encoders of a connection using
+// the given wire encoding. Gob only covers the envelope, the payloads of gob
+// connections have always been JSON.
+func newEncoders(name string) (envelope, payload encoding.Encoder) {
+	switch name {
+	case types.ProtobufEncoding:
+		return encoding.NewProtobufEncoder(), encoding.NewProtobufEncoder()
+	case types.GobEncoding:
+		return encoding.NewGobEncoder(), encoding.NewJSONEncoder()
+	default:
+		return encoding.NewJSONEncoder(), encoding.NewJSONEncoder()
+	}
+}
+
 func stringToInt(s string) int32 {
 	var result int32
 	for _, char := range s {
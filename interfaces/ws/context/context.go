@@ -20,6 +20,10 @@ type Context struct {
 	Method     string
 	RemoteAddr string
 	ConnID     string
+
+	// userID is the identity verified from the connection token, it takes
+	// precedence over the sendID query parameter once set.
+	userID string
 }
 
 func (c *Context) Deadline() (deadline time.Time, ok bool) {
@@ -99,9 +103,16 @@ func (c *Context) GetConnID() string {
 }
 
 func (c *Context) GetUserID() string {
+	if c.userID != "" {
+		return c.userID
+	}
 	return c.Request.URL.Query().Get(types.WsUserID)
 }
 
+func (c *Context) SetUserID(userID string) {
+	c.userID = userID
+}
+
 func (c *Context) GetPlatformID() string {
 	return c.Request.URL.Query().Get(types.PlatformID)
 }
@@ -145,6 +156,35 @@ func (c *Context) GetSDKType() string {
 	return sdkType
 }
 
+// GetEncoding returns the wire encoding asked for in the handshake, falling
+// back to the default one of the SDK type.
+func (c *Context) GetEncoding() string {
+	if encoding, ok := c.Query(types.Encoding); ok {
+		return encoding
+	}
+	if c.GetSDKType() == types.GoSDK {
+		return types.GobEncoding
+	}
+	return types.JSONEncoding
+}
+
+// GetResumeToken returns the token of the session the client asks to resume.
+func (c *Context) GetResumeToken() string {
+	return c.Request.URL.Query().Get(types.Resume)
+}
+
+// GetLastSeq returns the push seq the resumed session was received up to.
+func (c *Context) GetLastSeq() int64 {
+	lastSeq, _ := strconv.ParseInt(c.Request.URL.Query().Get(types.LastSeq), 10, 64)
+	return lastSeq
+}
+
+// GetMsgAck reports whether the client acks the pushes it receives.
+func (c *Context) GetMsgAck() bool {
+	msgAck, _ := strconv.ParseBool(c.Request.URL.Query().Get(types.MsgAck))
+	return msgAck
+}
+
 func (c *Context) ShouldSendResp() bool {
 	errResp, exists := c.Query(types.SendResponse)
 	if exists {
@@ -180,6 +220,16 @@ func (c *Context) ParseEssentialArgs() error {
 	default:
 		return errorx.New(errno.ErrConnArgsCode, errorx.KV("cause", "sdkType is not go or js"))
 	}
+	switch encoding, _ := c.Query(types.Encoding); encoding {
+	case "", types.JSONEncoding, types.GobEncoding, types.ProtobufEncoding:
+	default:
+		return errorx.New(errno.ErrConnArgsCode, errorx.KV("cause", "encoding is not json, gob or protobuf"))
+	}
+	if lastSeq, exists := c.Query(types.LastSeq); exists {
+		if _, err := strconv.ParseInt(lastSeq, 10, 64); err != nil {
+			return errorx.New(errno.ErrConnArgsCode, errorx.KV("cause", "lastSeq is not int"))
+		}
+	}
 	return nil
 }
 
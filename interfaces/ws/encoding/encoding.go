@@ -3,6 +3,9 @@ package encoding
 import (
 	"bytes"
 	"encoding/gob"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
 
 	"github.com/crazyfrankie/goim/pkg/errorx"
 	"github.com/crazyfrankie/goim/pkg/sonic"
@@ -60,3 +63,59 @@ func (j *jsonEncoder) Decode(encodeData []byte, decodeData any) error {
 
 	return nil
 }
+
+// ProtoMarshaler is implemented by values that are not protobuf messages
+// themselves but have a protobuf wire form.
+type ProtoMarshaler interface {
+	MarshalProto() ([]byte, error)
+}
+
+// ProtoUnmarshaler is the decoding counterpart of ProtoMarshaler.
+type ProtoUnmarshaler interface {
+	UnmarshalProto(data []byte) error
+}
+
+type protobufEncoder struct{}
+
+// NewProtobufEncoder returns an Encoder for protobuf messages and values
+// implementing ProtoMarshaler or ProtoUnmarshaler.
+func NewProtobufEncoder() Encoder {
+	return &protobufEncoder{}
+}
+
+func (p *protobufEncoder) Encode(data any) ([]byte, error) {
+	var (
+		encodeData []byte
+		err        error
+	)
+	switch v := data.(type) {
+	case proto.Message:
+		encodeData, err = proto.Marshal(v)
+	case ProtoMarshaler:
+		encodeData, err = v.MarshalProto()
+	default:
+		err = fmt.Errorf("%T has no protobuf form", data)
+	}
+	if err != nil {
+		return nil, errorx.Wrapf(err, "ProtobufEncoder.Encode failed")
+	}
+
+	return encodeData, nil
+}
+
+func (p *protobufEncoder) Decode(encodeData []byte, decodeData any) error {
+	var err error
+	switch v := decodeData.(type) {
+	case proto.Message:
+		err = proto.Unmarshal(encodeData, v)
+	case ProtoUnmarshaler:
+		err = v.UnmarshalProto(encodeData)
+	default:
+		err = fmt.Errorf("%T has no protobuf form", decodeData)
+	}
+	if err != nil {
+		return errorx.Wrapf(err, "ProtobufEncoder.Decode failed")
+	}
+
+	return nil
+}
@@ -10,17 +10,21 @@ import (
 )
 
 func httpError(ctx *wsctx.Context, err error) {
+	httpErrorWithStatus(ctx, err, http.StatusOK)
+}
+
+func httpErrorWithStatus(ctx *wsctx.Context, err error, statusCode int) {
 	logs.CtxWarnf(ctx, "ws connection error, err: %v", err)
-	httpJson(ctx.Writer, response.ParseError(err))
+	httpJson(ctx.Writer, statusCode, response.ParseError(err))
 }
 
-func httpJson(w http.ResponseWriter, data any) {
+func httpJson(w http.ResponseWriter, statusCode int, data any) {
 	body, err := sonic.Marshal(data)
 	if err != nil {
 		http.Error(w, "json marshal error: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
-	w.WriteHeader(http.StatusOK)
+	w.WriteHeader(statusCode)
 	_, _ = w.Write(body)
 }
@@ -4,28 +4,44 @@ import (
 	"context"
 	"sync/atomic"
 
+	"google.golang.org/grpc"
+
+	"github.com/crazyfrankie/goim/infra/contract/discovery"
 	wsctx "github.com/crazyfrankie/goim/interfaces/ws/context"
+	"github.com/crazyfrankie/goim/pkg/errorx"
 	"github.com/crazyfrankie/goim/pkg/logs"
+	gatewayv1 "github.com/crazyfrankie/goim/protocol/gateway/v1"
 	messagev1 "github.com/crazyfrankie/goim/protocol/message/v1"
+	"github.com/crazyfrankie/goim/types/errno"
 )
 
-func (s *Server) InitServer(ctx context.Context) error {
-	// 初始化服务器
-	logs.Infof("InitServer completed")
+// InitServer registers the hub as the gateway gRPC service and hands the
+// discovery client to the ready callback.
+func (s *Server) InitServer(ctx context.Context, client discovery.SvcDiscoveryRegistry, srv grpc.ServiceRegistrar) error {
+	gatewayv1.RegisterGatewayServiceServer(srv, s)
+
+	if s.ready != nil {
+		if err := s.ready(ctx, client); err != nil {
+			return err
+		}
+	}
+
+	logs.CtxInfof(ctx, "InitServer completed")
 	return nil
 }
 
 type Server struct {
 	LongConnServer LongConnServer
 	pushTerminal   map[int]struct{}
-	ready          func(srv *Server) error
+	ready          func(ctx context.Context, client discovery.SvcDiscoveryRegistry) error
+	gatewayv1.UnimplementedGatewayServiceServer
 }
 
 func (s *Server) SetLongConnServer(LongConnServer LongConnServer) {
 	s.LongConnServer = LongConnServer
 }
 
-func NewServer(longConnServer LongConnServer, ready func(srv *Server) error) *Server {
+func NewServer(longConnServer LongConnServer, ready func(ctx context.Context, client discovery.SvcDiscoveryRegistry) error) *Server {
 	s := &Server{
 		LongConnServer: longConnServer,
 		pushTerminal:   make(map[int]struct{}),
@@ -37,22 +53,22 @@ func NewServer(longConnServer LongConnServer, ready func(srv *Server) error) *Se
 	return s
 }
 
-func (s *Server) GetUsersOnlineStatus(ctx context.Context, req *GetUsersOnlineStatusReq) (*GetUsersOnlineStatusResp, error) {
-	var resp GetUsersOnlineStatusResp
+func (s *Server) GetUsersOnlineStatus(ctx context.Context, req *gatewayv1.GetUsersOnlineStatusRequest) (*gatewayv1.GetUsersOnlineStatusResponse, error) {
+	var resp gatewayv1.GetUsersOnlineStatusResponse
 	for _, userID := range req.UserIDs {
 		clients, ok := s.LongConnServer.GetUserAllCons(userID)
 		if !ok {
 			continue
 		}
 
-		uresp := new(GetUsersOnlineStatusResp_SuccessResult)
+		uresp := new(gatewayv1.GetUsersOnlineStatusResponse_SuccessResult)
 		uresp.UserID = userID
 		for _, client := range clients {
 			if client == nil {
 				continue
 			}
 
-			ps := new(GetUsersOnlineStatusResp_SuccessDetail)
+			ps := new(gatewayv1.GetUsersOnlineStatusResponse_SuccessDetail)
 			ps.PlatformID = int32(client.PlatformID)
 			ps.ConnID = client.ctx.GetConnID()
 			ps.Token = client.Token
@@ -67,27 +83,37 @@ func (s *Server) GetUsersOnlineStatus(ctx context.Context, req *GetUsersOnlineSt
 	return &resp, nil
 }
 
-func (s *Server) pushToUser(ctx context.Context, userID string, msgData *messagev1.Message) *SingleMsgToUserResults {
+func (s *Server) pushToUser(ctx context.Context, userID string, msgData *messagev1.Message) *gatewayv1.SingleMsgToUserResults {
+	// Taken before the live clients, a session resumed in between delivers
+	// the push itself rather than losing it. Kept pushes do not count as
+	// delivered, the offline push still goes out.
+	for _, sess := range s.LongConnServer.detachedSessions(userID) {
+		if err := sess.pushMessage(msgData); err != nil {
+			logs.Warnf("keep push for session failed, userID: %s, err: %v", userID, err)
+		}
+	}
+
 	clients, ok := s.LongConnServer.GetUserAllCons(userID)
 	if !ok {
 		logs.Debugf("push user not online, userID: %s", userID)
-		return &SingleMsgToUserResults{
+		return &gatewayv1.SingleMsgToUserResults{
 			UserID: userID,
 		}
 	}
 
 	logs.Debugf("push user online, clients count: %d, userID: %s", len(clients), userID)
-	result := &SingleMsgToUserResults{
+	result := &gatewayv1.SingleMsgToUserResults{
 		UserID: userID,
-		Resp:   make([]*SingleMsgToUserPlatform, 0, len(clients)),
+		Resp:   make([]*gatewayv1.SingleMsgToUserPlatform, 0, len(clients)),
 	}
 
 	for _, client := range clients {
 		if client == nil {
 			continue
 		}
-		userPlatform := &SingleMsgToUserPlatform{
-			RecvPlatFormID: int32(client.PlatformID),
+		userPlatform := &gatewayv1.SingleMsgToUserPlatform{
+			RecvPlatformID: int32(client.PlatformID),
+			IsBackground:   client.IsBackground,
 		}
 
 		if !client.IsBackground || (client.IsBackground && client.PlatformID != 2) { // iOS平台ID为2
@@ -108,12 +134,12 @@ func (s *Server) pushToUser(ctx context.Context, userID string, msgData *message
 	return result
 }
 
-func (s *Server) SuperGroupOnlineBatchPushOneMsg(ctx context.Context, req *OnlineBatchPushOneMsgReq) (*OnlineBatchPushOneMsgResp, error) {
+func (s *Server) SuperGroupOnlineBatchPushOneMsg(ctx context.Context, req *gatewayv1.OnlineBatchPushOneMsgRequest) (*gatewayv1.OnlineBatchPushOneMsgResponse, error) {
 	if len(req.PushToUserIDs) == 0 {
-		return &OnlineBatchPushOneMsgResp{}, nil
+		return &gatewayv1.OnlineBatchPushOneMsgResponse{}, nil
 	}
 
-	ch := make(chan *SingleMsgToUserResults, len(req.PushToUserIDs))
+	ch := make(chan *gatewayv1.SingleMsgToUserResults, len(req.PushToUserIDs))
 	var count atomic.Int64
 	count.Add(int64(len(req.PushToUserIDs)))
 
@@ -127,8 +153,8 @@ func (s *Server) SuperGroupOnlineBatchPushOneMsg(ctx context.Context, req *Onlin
 		}(userID)
 	}
 
-	resp := &OnlineBatchPushOneMsgResp{
-		SinglePushResult: make([]*SingleMsgToUserResults, 0, len(req.PushToUserIDs)),
+	resp := &gatewayv1.OnlineBatchPushOneMsgResponse{
+		SinglePushResult: make([]*gatewayv1.SingleMsgToUserResults, 0, len(req.PushToUserIDs)),
 	}
 
 	for {
@@ -145,7 +171,7 @@ func (s *Server) SuperGroupOnlineBatchPushOneMsg(ctx context.Context, req *Onlin
 	}
 }
 
-func (s *Server) KickUserOffline(ctx context.Context, req *KickUserOfflineReq) (*KickUserOfflineResp, error) {
+func (s *Server) KickUserOffline(ctx context.Context, req *gatewayv1.KickUserOfflineRequest) (*gatewayv1.KickUserOfflineResponse, error) {
 	for _, v := range req.KickUserIDList {
 		clients, _, ok := s.LongConnServer.GetUserPlatformCons(v, int(req.PlatformID))
 		if !ok {
@@ -161,11 +187,22 @@ func (s *Server) KickUserOffline(ctx context.Context, req *KickUserOfflineReq) (
 		}
 	}
 
-	return &KickUserOfflineResp{}, nil
+	return &gatewayv1.KickUserOfflineResponse{}, nil
+}
+
+// BroadcastRoom sends a room message to the room's members on this node, and
+// to those on the other nodes unless it was forwarded by one of them.
+func (s *Server) BroadcastRoom(ctx context.Context, req *gatewayv1.BroadcastRoomRequest) (*gatewayv1.BroadcastRoomResponse, error) {
+	if req.GetMsg().GetRoomID() == "" {
+		return nil, errorx.New(errno.ErrReqDataCode, errorx.KV("msg", "roomID is empty"))
+	}
+	s.LongConnServer.BroadcastRoomMessage(ctx, req.GetMsg(), !req.GetForwarded())
+
+	return &gatewayv1.BroadcastRoomResponse{}, nil
 }
 
-func (s *Server) MultiTerminalLoginCheck(ctx context.Context, req *MultiTerminalLoginCheckReq) (*MultiTerminalLoginCheckResp, error) {
-	if oldClients, userOK, clientOK := s.LongConnServer.GetUserPlatformCons(req.UserID, int(req.PlatformID)); userOK {
+func (s *Server) MultiTerminalLoginCheck(ctx context.Context, req *gatewayv1.MultiTerminalLoginCheckRequest) (*gatewayv1.MultiTerminalLoginCheckResponse, error) {
+	if _, userOK := s.LongConnServer.GetUserAllCons(req.UserID); userOK {
 		tempUserCtx := wsctx.NewTempContext()
 		tempUserCtx.SetToken(req.Token)
 		client := &Client{}
@@ -173,69 +210,7 @@ func (s *Server) MultiTerminalLoginCheck(ctx context.Context, req *MultiTerminal
 		client.Token = req.Token
 		client.UserID = req.UserID
 		client.PlatformID = req.PlatformID
-		i := &kickHandler{
-			clientOK:   clientOK,
-			oldClients: oldClients,
-			newClient:  client,
-		}
-		s.LongConnServer.SetKickHandlerInfo(i)
+		s.LongConnServer.SetKickHandlerInfo(&kickHandler{newClient: client})
 	}
-	return &MultiTerminalLoginCheckResp{}, nil
+	return &gatewayv1.MultiTerminalLoginCheckResponse{}, nil
 }
-
-// 相关数据结构
-type GetUsersOnlineStatusReq struct {
-	UserIDs []string `json:"userIDs"`
-}
-
-type GetUsersOnlineStatusResp struct {
-	SuccessResult []*GetUsersOnlineStatusResp_SuccessResult `json:"successResult"`
-}
-
-type GetUsersOnlineStatusResp_SuccessResult struct {
-	UserID               string                                    `json:"userID"`
-	Status               int32                                     `json:"status"`
-	DetailPlatformStatus []*GetUsersOnlineStatusResp_SuccessDetail `json:"detailPlatformStatus"`
-}
-
-type GetUsersOnlineStatusResp_SuccessDetail struct {
-	PlatformID   int32  `json:"platformID"`
-	ConnID       string `json:"connID"`
-	Token        string `json:"token"`
-	IsBackground bool   `json:"isBackground"`
-}
-
-type OnlineBatchPushOneMsgReq struct {
-	PushToUserIDs []string           `json:"pushToUserIDs"`
-	MsgData       *messagev1.Message `json:"msgData"`
-}
-
-type OnlineBatchPushOneMsgResp struct {
-	SinglePushResult []*SingleMsgToUserResults `json:"singlePushResult"`
-}
-
-type SingleMsgToUserResults struct {
-	UserID     string                     `json:"userID"`
-	Resp       []*SingleMsgToUserPlatform `json:"resp"`
-	OnlinePush bool                       `json:"onlinePush"`
-}
-
-type SingleMsgToUserPlatform struct {
-	RecvPlatFormID int32 `json:"recvPlatFormID"`
-	ResultCode     int64 `json:"resultCode"`
-}
-
-type KickUserOfflineReq struct {
-	KickUserIDList []string `json:"kickUserIDList"`
-	PlatformID     int32    `json:"platformID"`
-}
-
-type KickUserOfflineResp struct{}
-
-type MultiTerminalLoginCheckReq struct {
-	UserID     string `json:"userID"`
-	PlatformID int32  `json:"platformID"`
-	Token      string `json:"token"`
-}
-
-type MultiTerminalLoginCheckResp struct{}
@@ -4,8 +4,24 @@ import (
 	"context"
 	"sync"
 
-	"github.com/crazyfrankie/goim/pkg/sonic"
 	"github.com/go-playground/validator/v10"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/crazyfrankie/goim/infra/contract/discovery"
+	"github.com/crazyfrankie/goim/interfaces/ws/encoding"
+	"github.com/crazyfrankie/goim/interfaces/ws/types"
+	"github.com/crazyfrankie/goim/pkg/ctxcache"
+	"github.com/crazyfrankie/goim/pkg/errorx"
+	"github.com/crazyfrankie/goim/pkg/grpc/ctxutil"
+	"github.com/crazyfrankie/goim/pkg/sonic"
+	conversationv1 "github.com/crazyfrankie/goim/protocol/conversation/v1"
+	gatewayv1 "github.com/crazyfrankie/goim/protocol/gateway/v1"
+	messagev1 "github.com/crazyfrankie/goim/protocol/message/v1"
+	userv1 "github.com/crazyfrankie/goim/protocol/user/v1"
+	"github.com/crazyfrankie/goim/types/consts"
+	"github.com/crazyfrankie/goim/types/errno"
 )
 
 const (
@@ -28,6 +44,7 @@ type MessageHandler interface {
 	UserLogout(ctx context.Context, data *Req) ([]byte, error)
 	SetUserDeviceBackground(ctx context.Context, data *Req) ([]byte, bool, error)
 	GetLastMessage(ctx context.Context, data *Req) ([]byte, error)
+	MarkMessagesDelivered(ctx context.Context, conversationID string, seqs []int64) error
 }
 
 type Req struct {
@@ -49,6 +66,34 @@ func (r *Req) String() string {
 	return structToJSONStr(tReq)
 }
 
+// MarshalProto implements encoding.ProtoMarshaler.
+func (r *Req) MarshalProto() ([]byte, error) {
+	return proto.Marshal(&gatewayv1.Req{
+		ReqIdentifier: r.ReqIdentifier,
+		Token:         r.Token,
+		SendID:        r.SendID,
+		OperationID:   r.OperationID,
+		MsgIncr:       r.MsgIncr,
+		Data:          r.Data,
+	})
+}
+
+// UnmarshalProto implements encoding.ProtoUnmarshaler.
+func (r *Req) UnmarshalProto(data []byte) error {
+	var pb gatewayv1.Req
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return err
+	}
+	r.ReqIdentifier = pb.GetReqIdentifier()
+	r.Token = pb.GetToken()
+	r.SendID = pb.GetSendID()
+	r.OperationID = pb.GetOperationID()
+	r.MsgIncr = pb.GetMsgIncr()
+	r.Data = pb.GetData()
+
+	return nil
+}
+
 var reqPool = sync.Pool{
 	New: func() any {
 		return new(Req)
@@ -71,12 +116,43 @@ func freeReq(req *Req) {
 }
 
 type Resp struct {
-	ReqIdentifier int32       `json:"reqIdentifier"`
-	MsgIncr       string      `json:"msgIncr"`
-	OperationID   string      `json:"operationID"`
-	ErrCode       int32       `json:"errCode"`
-	ErrMsg        string      `json:"errMsg"`
-	Data          interface{} `json:"data"`
+	ReqIdentifier int32  `json:"reqIdentifier"`
+	MsgIncr       string `json:"msgIncr"`
+	OperationID   string `json:"operationID"`
+	ErrCode       int32  `json:"errCode"`
+	ErrMsg        string `json:"errMsg"`
+	Data          []byte `json:"data"`
+	PushSeq       int64  `json:"pushSeq,omitempty"`
+}
+
+// MarshalProto implements encoding.ProtoMarshaler.
+func (r *Resp) MarshalProto() ([]byte, error) {
+	return proto.Marshal(&gatewayv1.Resp{
+		ReqIdentifier: r.ReqIdentifier,
+		MsgIncr:       r.MsgIncr,
+		OperationID:   r.OperationID,
+		ErrCode:       r.ErrCode,
+		ErrMsg:        r.ErrMsg,
+		Data:          r.Data,
+		PushSeq:       r.PushSeq,
+	})
+}
+
+// UnmarshalProto implements encoding.ProtoUnmarshaler.
+func (r *Resp) UnmarshalProto(data []byte) error {
+	var pb gatewayv1.Resp
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return err
+	}
+	r.ReqIdentifier = pb.GetReqIdentifier()
+	r.MsgIncr = pb.GetMsgIncr()
+	r.OperationID = pb.GetOperationID()
+	r.ErrCode = pb.GetErrCode()
+	r.ErrMsg = pb.GetErrMsg()
+	r.Data = pb.GetData()
+	r.PushSeq = pb.GetPushSeq()
+
+	return nil
 }
 
 func (r *Resp) String() string {
@@ -90,61 +166,193 @@ func (r *Resp) String() string {
 }
 
 type GrpcHandler struct {
-	validate *validator.Validate
-	// msgClient    pb.MsgClient
-	// pushClient   pb.PushClient
-	// userClient   pb.UserClient
+	validate           *validator.Validate
+	msgClient          messagev1.MessageServiceClient
+	userClient         userv1.UserServiceClient
+	conversationClient conversationv1.ConversationServiceClient
 }
 
-func NewGrpcHandler(validate *validator.Validate) *GrpcHandler {
-	return &GrpcHandler{
-		validate: validate,
+func NewGrpcHandler(ctx context.Context, validate *validator.Validate, client discovery.SvcDiscoveryRegistry) (*GrpcHandler, error) {
+	msgConn, err := client.GetConn(ctx, consts.MessageServiceName)
+	if err != nil {
+		return nil, err
+	}
+	userConn, err := client.GetConn(ctx, consts.UserServiceName)
+	if err != nil {
+		return nil, err
 	}
+	conversationConn, err := client.GetConn(ctx, consts.ConversationServiceName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GrpcHandler{
+		validate:           validate,
+		msgClient:          messagev1.NewMessageServiceClient(msgConn),
+		userClient:         userv1.NewUserServiceClient(userConn),
+		conversationClient: conversationv1.NewConversationServiceClient(conversationConn),
+	}, nil
 }
 
 func (g *GrpcHandler) GetSeq(ctx context.Context, data *Req) ([]byte, error) {
-	//TODO implement me
-	panic("implement me")
+	var req messagev1.GetNewestSeqRequest
+	if err := decodeData(ctx, data, &req); err != nil {
+		return nil, err
+	}
+
+	resp, err := g.msgClient.GetNewestSeq(outgoingCtx(ctx), &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return encodeData(ctx, resp)
 }
 
 func (g *GrpcHandler) SendMessage(ctx context.Context, data *Req) ([]byte, error) {
-	//TODO implement me
-	panic("implement me")
+	var msg messagev1.Message
+	if err := decodeData(ctx, data, &msg); err != nil {
+		return nil, err
+	}
+
+	resp, err := g.msgClient.SendMessage(outgoingCtx(ctx), &messagev1.SendMessageRequest{Data: &msg})
+	if err != nil {
+		return nil, err
+	}
+
+	return encodeData(ctx, resp)
 }
 
 func (g *GrpcHandler) SendSignalMessage(ctx context.Context, data *Req) ([]byte, error) {
-	//TODO implement me
-	panic("implement me")
+	return nil, unsupportedReq(data)
 }
 
 func (g *GrpcHandler) PullMessageBySeqList(ctx context.Context, data *Req) ([]byte, error) {
-	//TODO implement me
-	panic("implement me")
+	var req messagev1.PullMessagesBySeqsRequest
+	if err := decodeData(ctx, data, &req); err != nil {
+		return nil, err
+	}
+
+	resp, err := g.msgClient.PullMessagesBySeqs(outgoingCtx(ctx), &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return encodeData(ctx, resp)
 }
 
 func (g *GrpcHandler) UserLogout(ctx context.Context, data *Req) ([]byte, error) {
-	//TODO implement me
-	panic("implement me")
+	resp, err := g.userClient.Logout(outgoingCtx(ctx), &userv1.LogoutRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return encodeData(ctx, resp)
 }
 
 func (g *GrpcHandler) SetUserDeviceBackground(ctx context.Context, data *Req) ([]byte, bool, error) {
-	//TODO implement me
-	panic("implement me")
+	var req gatewayv1.SetAppBackgroundStatusReq
+	if err := decodeData(ctx, data, &req); err != nil {
+		return nil, false, err
+	}
+
+	return nil, req.IsBackground, nil
 }
 
 func (g *GrpcHandler) GetConversationsHasReadAndMaxSeq(ctx context.Context, data *Req) ([]byte, error) {
-	//TODO implement me
-	panic("implement me")
+	var req conversationv1.GetConversationsHasReadAndMaxSeqRequest
+	if err := decodeData(ctx, data, &req); err != nil {
+		return nil, err
+	}
+
+	resp, err := g.conversationClient.GetConversationsHasReadAndMaxSeq(outgoingCtx(ctx), &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return encodeData(ctx, resp)
 }
 
 func (g *GrpcHandler) GetSeqMessage(ctx context.Context, data *Req) ([]byte, error) {
-	//TODO implement me
-	panic("implement me")
+	var req messagev1.PullMessagesBySeqRangeRequest
+	if err := decodeData(ctx, data, &req); err != nil {
+		return nil, err
+	}
+
+	resp, err := g.msgClient.PullMessagesBySeqRange(outgoingCtx(ctx), &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return encodeData(ctx, resp)
 }
 
+// GetLastMessage returns the last message of each of the user's conversations
+// asked for, as kept by the conversation service.
 func (g *GrpcHandler) GetLastMessage(ctx context.Context, data *Req) ([]byte, error) {
-	//TODO implement me
-	panic("implement me")
+	var req conversationv1.GetConversationsLastMessageRequest
+	if err := decodeData(ctx, data, &req); err != nil {
+		return nil, err
+	}
+
+	resp, err := g.conversationClient.GetConversationsLastMessage(outgoingCtx(ctx), &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return encodeData(ctx, resp)
+}
+
+// MarkMessagesDelivered marks the messages the connection's user acked
+// delivered, on the user's behalf.
+func (g *GrpcHandler) MarkMessagesDelivered(ctx context.Context, conversationID string, seqs []int64) error {
+	_, err := g.msgClient.SetMessageStatus(outgoingCtx(ctx), &messagev1.SetMessageStatusRequest{
+		ConversationID: conversationID,
+		Seqs:           seqs,
+		Status:         messagev1.MessageStatus_MESSAGE_STATUS_DELIVERED,
+	})
+
+	return err
+}
+
+// dataEncoderKey keeps the payload encoder of the connection a request came
+// from in the request context.
+type dataEncoderKey struct{}
+
+func dataEncoder(ctx context.Context) encoding.Encoder {
+	if enc, ok := ctxcache.Get[encoding.Encoder](ctx, dataEncoderKey{}); ok {
+		return enc
+	}
+	return encoding.NewJSONEncoder()
+}
+
+// decodeData unmarshals the payload carried in Req.Data into v, in the
+// encoding of the connection.
+func decodeData(ctx context.Context, data *Req, v any) error {
+	if len(data.Data) == 0 {
+		return errorx.New(errno.ErrReqDataCode, errorx.KV("msg", "data is empty"))
+	}
+	if err := dataEncoder(ctx).Decode(data.Data, v); err != nil {
+		return errorx.WrapByCode(err, errno.ErrReqDataCode, errorx.KV("msg", err.Error()))
+	}
+
+	return nil
+}
+
+// encodeData marshals a response payload in the encoding of the connection.
+func encodeData(ctx context.Context, v any) ([]byte, error) {
+	return dataEncoder(ctx).Encode(v)
+}
+
+// outgoingCtx carries the connection's user identity to the downstream services,
+// where CtxMDInterceptor exposes it to ctxutil.
+func outgoingCtx(ctx context.Context) context.Context {
+	userID, _ := ctxcache.Get[string](ctx, types.WsUserID)
+
+	return ctxutil.WithOutgoingUserID(ctx, userID)
+}
+
+func unsupportedReq(data *Req) error {
+	return status.Errorf(codes.Unimplemented, "reqIdentifier %d is not supported yet", data.ReqIdentifier)
 }
 
 func structToJSONStr(d any) string {
@@ -0,0 +1,526 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/crazyfrankie/goim/infra/contract/discovery"
+	tokentype "github.com/crazyfrankie/goim/infra/contract/token"
+	"github.com/crazyfrankie/goim/interfaces/ws/types"
+	"github.com/crazyfrankie/goim/pkg/errorx"
+	"github.com/crazyfrankie/goim/pkg/gin/response"
+	"github.com/crazyfrankie/goim/pkg/grpc/ctxutil"
+	"github.com/crazyfrankie/goim/pkg/grpc/interceptor"
+	"github.com/crazyfrankie/goim/pkg/sonic"
+	authv1 "github.com/crazyfrankie/goim/protocol/auth/v1"
+	conversationv1 "github.com/crazyfrankie/goim/protocol/conversation/v1"
+	gatewayv1 "github.com/crazyfrankie/goim/protocol/gateway/v1"
+	messagev1 "github.com/crazyfrankie/goim/protocol/message/v1"
+	userv1 "github.com/crazyfrankie/goim/protocol/user/v1"
+	"github.com/crazyfrankie/goim/types/consts"
+	"github.com/crazyfrankie/goim/types/errno"
+)
+
+type fakeMessageService struct {
+	messagev1.UnimplementedMessageServiceServer
+
+	mu        sync.Mutex
+	callerID  int64
+	received  *messagev1.Message
+	delivered []*messagev1.SetMessageStatusRequest
+}
+
+func (f *fakeMessageService) SendMessage(ctx context.Context, req *messagev1.SendMessageRequest) (*messagev1.SendMessageResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.callerID = ctxutil.MustGetUserIDFromCtx(ctx)
+	f.received = req.GetData()
+
+	return &messagev1.SendMessageResponse{
+		ServerMsgID: 42,
+		ClientMsgID: req.GetData().GetClientMsgID(),
+		SendTime:    req.GetData().GetSendTime(),
+	}, nil
+}
+
+func (f *fakeMessageService) SetMessageStatus(ctx context.Context, req *messagev1.SetMessageStatusRequest) (*messagev1.SetMessageStatusResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.delivered = append(f.delivered, req)
+
+	return &messagev1.SetMessageStatusResponse{}, nil
+}
+
+// fakeConversationService keeps the last message of conversation
+// si_1001_1002 for user 1001 only.
+type fakeConversationService struct {
+	conversationv1.UnimplementedConversationServiceServer
+}
+
+func (f *fakeConversationService) GetConversationsLastMessage(ctx context.Context, req *conversationv1.GetConversationsLastMessageRequest) (*conversationv1.GetConversationsLastMessageResponse, error) {
+	lastMessages := make(map[string]*conversationv1.LastMessage)
+	if ctxutil.MustGetUserIDFromCtx(ctx) == 1001 && slices.Contains(req.GetConversationIDs(), "si_1001_1002") {
+		lastMessages["si_1001_1002"] = &conversationv1.LastMessage{ServerMsgID: 42, SendID: 1002, Seq: 7, Content: []byte("bye")}
+	}
+
+	return &conversationv1.GetConversationsLastMessageResponse{LastMessages: lastMessages}, nil
+}
+
+type fakeUserService struct {
+	userv1.UnimplementedUserServiceServer
+}
+
+// fakeAuthService accepts tokens of the form "uid:<userID>", optionally
+// followed by "#<anything>" to tell tokens of the same user apart.
+type fakeAuthService struct {
+	authv1.UnimplementedAuthServiceServer
+
+	mu      sync.Mutex
+	revoked []string
+}
+
+func (f *fakeAuthService) ParseToken(_ context.Context, req *authv1.ParseTokenRequest) (*authv1.ParseTokenResponse, error) {
+	token, _, _ := strings.Cut(req.GetToken(), "#")
+	switch {
+	case token == "expired":
+		return nil, errorx.New(errno.ErrAuthTokenExpiredCode)
+	case token == "revoked":
+		return nil, errorx.New(errno.ErrAuthTokenRevokedCode)
+	case strings.HasPrefix(token, "access:"):
+		// Like the auth service, refuse tokens of another type than asked for.
+		if req.GetTokenType() == tokentype.TypeAccess {
+			return &authv1.ParseTokenResponse{UserID: 1}, nil
+		}
+	case strings.HasPrefix(token, "uid:"):
+		userID, err := strconv.ParseInt(strings.TrimPrefix(token, "uid:"), 10, 64)
+		if err == nil {
+			return &authv1.ParseTokenResponse{UserID: userID}, nil
+		}
+	}
+
+	return nil, errorx.New(errno.ErrAuthTokenInvalidCode)
+}
+
+func (f *fakeAuthService) RevokeTokens(_ context.Context, req *authv1.RevokeTokensRequest) (*authv1.RevokeTokensResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.revoked = append(f.revoked, req.GetTokens()...)
+
+	return &authv1.RevokeTokensResponse{}, nil
+}
+
+func (f *fakeAuthService) revokedTokens() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return slices.Clone(f.revoked)
+}
+
+// fakeRegistry resolves every service name to the same in-process connection.
+type fakeRegistry struct {
+	discovery.SvcDiscoveryRegistry
+	conn grpc.ClientConnInterface
+}
+
+func (r *fakeRegistry) GetConn(_ context.Context, _ string, _ ...grpc.DialOption) (grpc.ClientConnInterface, error) {
+	return r.conn, nil
+}
+
+// GetConns returns the in-process connection as the only gateway node, which
+// is this one.
+func (r *fakeRegistry) GetConns(_ context.Context, _ string, _ ...grpc.DialOption) ([]grpc.ClientConnInterface, error) {
+	return []grpc.ClientConnInterface{r.conn}, nil
+}
+
+func (r *fakeRegistry) IsSelfNode(grpc.ClientConnInterface) bool {
+	return true
+}
+
+func startGateway(t *testing.T, msgSvc *fakeMessageService) (*httptest.Server, *WebsocketServer) {
+	t.Helper()
+
+	return startGatewayWith(t, msgSvc, &fakeAuthService{})
+}
+
+func startGatewayWith(t *testing.T, msgSvc *fakeMessageService, authSvc *fakeAuthService, opts ...Option) (*httptest.Server, *WebsocketServer) {
+	t.Helper()
+
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		interceptor.CtxMDInterceptor(),
+		interceptor.ResponseInterceptor(),
+	))
+	messagev1.RegisterMessageServiceServer(srv, msgSvc)
+	userv1.RegisterUserServiceServer(srv, &fakeUserService{})
+	conversationv1.RegisterConversationServiceServer(srv, &fakeConversationService{})
+	authv1.RegisterAuthServiceServer(srv, authSvc)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	cc, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("dial bufconn: %v", err)
+	}
+	t.Cleanup(func() { _ = cc.Close() })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	wsSrv := NewWebsocketServer(append([]Option{WithPort(0), WithMaxConnNum(16)}, opts...)...)
+	if err := wsSrv.SetDiscoveryRegistry(ctx, &fakeRegistry{conn: cc}); err != nil {
+		t.Fatalf("set discovery registry: %v", err)
+	}
+	go func() { _ = wsSrv.Run(ctx) }()
+
+	httpSrv := httptest.NewServer(http.HandlerFunc(wsSrv.wsHandler))
+	t.Cleanup(httpSrv.Close)
+
+	return httpSrv, wsSrv
+}
+
+func gatewayURL(srv *httptest.Server, token, userID string) string {
+	return platformGatewayURL(srv, token, userID, consts.WebPlatformID)
+}
+
+func platformGatewayURL(srv *httptest.Server, token, userID string, platformID int) string {
+	query := url.Values{}
+	query.Set(types.Token, token)
+	query.Set(types.WsUserID, userID)
+	query.Set(types.PlatformID, strconv.Itoa(platformID))
+	query.Set(types.SDKType, types.JsSDK)
+
+	return "ws" + strings.TrimPrefix(srv.URL, "http") + "/?" + query.Encode()
+}
+
+func dialGateway(t *testing.T, srv *httptest.Server, userID string) *websocket.Conn {
+	t.Helper()
+
+	conn, _, err := websocket.DefaultDialer.Dial(gatewayURL(srv, "uid:"+userID, userID), nil)
+	if err != nil {
+		t.Fatalf("dial gateway: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return conn
+}
+
+func roundTrip(t *testing.T, conn *websocket.Conn, req *Req) *Resp {
+	t.Helper()
+
+	body, err := sonic.Marshal(req)
+	if err != nil {
+		t.Fatalf("marshal req: %v", err)
+	}
+	if err := conn.WriteMessage(websocket.BinaryMessage, body); err != nil {
+		t.Fatalf("write req: %v", err)
+	}
+
+	return readFrame(t, conn)
+}
+
+// readFrame reads the next JSON frame, skipping the session resume tips
+// sent on connect.
+func readFrame(t *testing.T, conn *websocket.Conn) *Resp {
+	t.Helper()
+
+	for {
+		_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+		_, raw, err := conn.ReadMessage()
+		if err != nil {
+			t.Fatalf("read frame: %v", err)
+		}
+		var resp Resp
+		if err := sonic.Unmarshal(raw, &resp); err != nil {
+			t.Fatalf("unmarshal frame: %v", err)
+		}
+		if resp.ReqIdentifier != types.WsSessionResume {
+			return &resp
+		}
+	}
+}
+
+func TestGrpcHandlerSendMessage(t *testing.T) {
+	msgSvc := &fakeMessageService{}
+	srv, _ := startGateway(t, msgSvc)
+	conn := dialGateway(t, srv, "1001")
+
+	msg := &messagev1.Message{
+		SendID:      1001,
+		RecvID:      1002,
+		ClientMsgID: "client-msg-1",
+		SessionType: consts.SingleChatType,
+		ContentType: consts.TextMessageType,
+		SendTime:    1700000000000,
+		Content:     []byte(`{"content":"hello"}`),
+	}
+	data, err := sonic.Marshal(msg)
+	if err != nil {
+		t.Fatalf("marshal msg: %v", err)
+	}
+
+	resp := roundTrip(t, conn, &Req{
+		ReqIdentifier: types.WSSendMsg,
+		SendID:        "1001",
+		OperationID:   "op-send",
+		MsgIncr:       "1",
+		Data:          data,
+	})
+	if resp.ErrCode != 0 {
+		t.Fatalf("unexpected errCode %d: %s", resp.ErrCode, resp.ErrMsg)
+	}
+	if resp.ReqIdentifier != types.WSSendMsg || resp.MsgIncr != "1" || resp.OperationID != "op-send" {
+		t.Fatalf("reply does not match request: %s", resp.String())
+	}
+
+	var sendResp messagev1.SendMessageResponse
+	if err := sonic.Unmarshal(resp.Data, &sendResp); err != nil {
+		t.Fatalf("unmarshal send resp: %v", err)
+	}
+	if sendResp.GetServerMsgID() != 42 || sendResp.GetClientMsgID() != "client-msg-1" {
+		t.Fatalf("unexpected send resp: %+v", &sendResp)
+	}
+
+	msgSvc.mu.Lock()
+	defer msgSvc.mu.Unlock()
+	if msgSvc.callerID != 1001 {
+		t.Fatalf("message service saw caller %d, want 1001", msgSvc.callerID)
+	}
+	if string(msgSvc.received.GetContent()) != `{"content":"hello"}` || msgSvc.received.GetRecvID() != 1002 {
+		t.Fatalf("message service received %+v", msgSvc.received)
+	}
+}
+
+func TestGrpcHandlerSendMessageProtobuf(t *testing.T) {
+	msgSvc := &fakeMessageService{}
+	srv, _ := startGateway(t, msgSvc)
+
+	query := url.Values{}
+	query.Set(types.Token, "uid:1001")
+	query.Set(types.WsUserID, "1001")
+	query.Set(types.PlatformID, strconv.Itoa(consts.WebPlatformID))
+	query.Set(types.SDKType, types.JsSDK)
+	query.Set(types.Encoding, types.ProtobufEncoding)
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http")+"/?"+query.Encode(), nil)
+	if err != nil {
+		t.Fatalf("dial gateway: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	data, err := proto.Marshal(&messagev1.Message{
+		SendID:      1001,
+		RecvID:      1002,
+		ClientMsgID: "client-msg-1",
+		SessionType: consts.SingleChatType,
+		ContentType: consts.TextMessageType,
+		Content:     []byte(`{"content":"hello"}`),
+	})
+	if err != nil {
+		t.Fatalf("marshal msg: %v", err)
+	}
+	body, err := proto.Marshal(&gatewayv1.Req{
+		ReqIdentifier: types.WSSendMsg,
+		SendID:        "1001",
+		MsgIncr:       "1",
+		Data:          data,
+	})
+	if err != nil {
+		t.Fatalf("marshal req: %v", err)
+	}
+	if err := conn.WriteMessage(websocket.BinaryMessage, body); err != nil {
+		t.Fatalf("write req: %v", err)
+	}
+
+	var resp gatewayv1.Resp
+	for resp.GetReqIdentifier() == 0 || resp.GetReqIdentifier() == types.WsSessionResume {
+		_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+		_, raw, err := conn.ReadMessage()
+		if err != nil {
+			t.Fatalf("read resp: %v", err)
+		}
+		if err := proto.Unmarshal(raw, &resp); err != nil {
+			t.Fatalf("unmarshal resp: %v", err)
+		}
+	}
+	if resp.GetErrCode() != 0 || resp.GetMsgIncr() != "1" {
+		t.Fatalf("unexpected resp: %+v", &resp)
+	}
+
+	var sendResp messagev1.SendMessageResponse
+	if err := proto.Unmarshal(resp.GetData(), &sendResp); err != nil {
+		t.Fatalf("unmarshal send resp: %v", err)
+	}
+	if sendResp.GetServerMsgID() != 42 || sendResp.GetClientMsgID() != "client-msg-1" {
+		t.Fatalf("unexpected send resp: %+v", &sendResp)
+	}
+}
+
+func TestGrpcHandlerGetLastMessage(t *testing.T) {
+	srv, _ := startGateway(t, &fakeMessageService{})
+	conn := dialGateway(t, srv, "1001")
+
+	data, err := sonic.Marshal(&conversationv1.GetConversationsLastMessageRequest{
+		ConversationIDs: []string{"si_1001_1002", "si_1001_1003"},
+	})
+	if err != nil {
+		t.Fatalf("marshal req: %v", err)
+	}
+	resp := roundTrip(t, conn, &Req{
+		ReqIdentifier: types.WsPullConvLastMessage,
+		SendID:        "1001",
+		MsgIncr:       "1",
+		Data:          data,
+	})
+	if resp.ErrCode != 0 {
+		t.Fatalf("unexpected errCode %d: %s", resp.ErrCode, resp.ErrMsg)
+	}
+
+	var lastResp conversationv1.GetConversationsLastMessageResponse
+	if err := sonic.Unmarshal(resp.Data, &lastResp); err != nil {
+		t.Fatalf("unmarshal last message resp: %v", err)
+	}
+	if len(lastResp.GetLastMessages()) != 1 {
+		t.Fatalf("got last messages of %d conversations, want 1", len(lastResp.GetLastMessages()))
+	}
+	msg := lastResp.GetLastMessages()["si_1001_1002"]
+	if msg.GetServerMsgID() != 42 || msg.GetSeq() != 7 || string(msg.GetContent()) != "bye" {
+		t.Fatalf("unexpected last message: %+v", msg)
+	}
+}
+
+func TestGrpcHandlerErrors(t *testing.T) {
+	srv, _ := startGateway(t, &fakeMessageService{})
+	conn := dialGateway(t, srv, "1001")
+
+	resp := roundTrip(t, conn, &Req{
+		ReqIdentifier: types.WSSendMsg,
+		SendID:        "1001",
+		MsgIncr:       "1",
+		Data:          []byte("not json"),
+	})
+	if resp.ErrCode != errno.ErrReqDataCode {
+		t.Fatalf("bad payload: got errCode %d, want %d", resp.ErrCode, errno.ErrReqDataCode)
+	}
+
+	resp = roundTrip(t, conn, &Req{
+		ReqIdentifier: types.WsLogoutMsg,
+		SendID:        "1001",
+		MsgIncr:       "2",
+	})
+	if resp.ErrCode != int32(codes.Unimplemented) {
+		t.Fatalf("logout: got errCode %d, want %d", resp.ErrCode, codes.Unimplemented)
+	}
+}
+
+func TestHandshakeAuthentication(t *testing.T) {
+	srv, _ := startGateway(t, &fakeMessageService{})
+
+	cases := []struct {
+		name   string
+		token  string
+		sendID string
+		code   int32
+	}{
+		{name: "expired", token: "expired", sendID: "1001", code: errno.ErrConnTokenExpiredCode},
+		{name: "revoked", token: "revoked", sendID: "1001", code: errno.ErrConnTokenRevokedCode},
+		{name: "invalid", token: "garbage", sendID: "1001", code: errno.ErrConnTokenInvalidCode},
+		{name: "mismatch", token: "uid:1002", sendID: "1001", code: errno.ErrConnTokenMismatchCode},
+		{name: "access token", token: "access:1001", sendID: "1001", code: errno.ErrConnTokenInvalidCode},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, resp, err := websocket.DefaultDialer.Dial(gatewayURL(srv, tc.token, tc.sendID), nil)
+			if err == nil {
+				t.Fatal("handshake should have been rejected")
+			}
+			if resp == nil || resp.StatusCode != http.StatusUnauthorized {
+				t.Fatalf("want HTTP 401, got %v", resp)
+			}
+			defer resp.Body.Close()
+
+			var body response.Response
+			if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+				t.Fatalf("decode body: %v", err)
+			}
+			if body.Code != tc.code {
+				t.Fatalf("got code %d, want %d", body.Code, tc.code)
+			}
+		})
+	}
+}
+
+func TestPushMessage(t *testing.T) {
+	srv, wsSrv := startGateway(t, &fakeMessageService{})
+	conn := dialGateway(t, srv, "1002")
+
+	// Registration happens asynchronously after the handshake.
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		if _, ok := wsSrv.GetUserAllCons("1002"); ok {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("client was not registered")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	msg := &messagev1.Message{
+		SendID:         1001,
+		RecvID:         1002,
+		SessionType:    consts.SingleChatType,
+		ContentType:    consts.TextMessageType,
+		Content:        []byte(`{"content":"hi"}`),
+		ServerMsgID:    42,
+		Seq:            7,
+		ConversationID: "si_1001_1002",
+	}
+	hub := NewServer(wsSrv, nil)
+	resp, err := hub.SuperGroupOnlineBatchPushOneMsg(context.Background(), &gatewayv1.OnlineBatchPushOneMsgRequest{
+		PushToUserIDs: []string{"1002", "1003"},
+		MsgData:       msg,
+	})
+	if err != nil {
+		t.Fatalf("push: %v", err)
+	}
+	for _, res := range resp.GetSinglePushResult() {
+		if res.GetUserID() == "1002" && len(res.GetResp()) != 1 {
+			t.Fatalf("online user got %d platform results", len(res.GetResp()))
+		}
+	}
+
+	frame := readFrame(t, conn)
+	if frame.ReqIdentifier != types.WSPushMsg {
+		t.Fatalf("got reqIdentifier %d, want %d", frame.ReqIdentifier, types.WSPushMsg)
+	}
+
+	var pushed gatewayv1.PushMessages
+	if err := sonic.Unmarshal(frame.Data, &pushed); err != nil {
+		t.Fatalf("unmarshal push payload: %v", err)
+	}
+	msgs := pushed.GetMsgs()["si_1001_1002"].GetMsgs()
+	if len(msgs) != 1 || msgs[0].GetSeq() != 7 || msgs[0].GetServerMsgID() != 42 {
+		t.Fatalf("unexpected push payload: %+v", &pushed)
+	}
+}
@@ -0,0 +1,170 @@
+package ws
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/crazyfrankie/goim/pkg/lang/conv"
+	"github.com/crazyfrankie/goim/pkg/logs"
+	"github.com/crazyfrankie/goim/pkg/msgprocessor"
+	gatewayv1 "github.com/crazyfrankie/goim/protocol/gateway/v1"
+	messagev1 "github.com/crazyfrankie/goim/protocol/message/v1"
+)
+
+const (
+	// How long a push waits for its ACK before it is sent again
+	defaultAckTimeout = 5 * time.Second
+	// How many times an unacked push is sent again before giving up
+	defaultAckRetries = 3
+	// How often the unacked pushes of a connection are checked at most
+	ackCheckInterval = time.Second
+)
+
+type ackKey struct {
+	conversationID string
+	seq            int64
+}
+
+type pendingPush struct {
+	resp     *Resp
+	sendID   int64
+	retries  int
+	deadline time.Time
+}
+
+// ackTracker keeps the pushes a connection has not acked yet. Only clients
+// asking for ACKs in the handshake get one, older ones never send them.
+type ackTracker struct {
+	timeout    time.Duration
+	maxRetries int
+
+	mu      sync.Mutex
+	pending map[ackKey]*pendingPush
+}
+
+func newAckTracker(timeout time.Duration, maxRetries int) *ackTracker {
+	return &ackTracker{
+		timeout:    timeout,
+		maxRetries: maxRetries,
+		pending:    make(map[ackKey]*pendingPush),
+	}
+}
+
+func (t *ackTracker) track(msgData *messagev1.Message, resp *Resp) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.pending[ackKey{conversationID: msgData.GetConversationID(), seq: msgData.GetSeq()}] = &pendingPush{
+		resp:     resp,
+		sendID:   msgData.GetSendID(),
+		deadline: time.Now().Add(t.timeout),
+	}
+}
+
+// ack forgets the acked pushes and returns the seqs of those userID did not
+// send, they are the ones delivered to the user.
+func (t *ackTracker) ack(conversationID string, seqs []int64, userID int64) []int64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var delivered []int64
+	for _, seq := range seqs {
+		key := ackKey{conversationID: conversationID, seq: seq}
+		p, ok := t.pending[key]
+		if !ok {
+			continue
+		}
+		delete(t.pending, key)
+		if p.sendID != userID {
+			delivered = append(delivered, seq)
+		}
+	}
+	return delivered
+}
+
+// due returns the pushes whose ACK timed out, dropping the ones out of
+// retries.
+func (t *ackTracker) due(now time.Time) (resend []*Resp, dropped []ackKey) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for key, p := range t.pending {
+		if now.Before(p.deadline) {
+			continue
+		}
+		if p.retries >= t.maxRetries {
+			delete(t.pending, key)
+			dropped = append(dropped, key)
+			continue
+		}
+		p.retries++
+		p.deadline = now.Add(t.timeout)
+		resend = append(resend, p.resp)
+	}
+	return resend, dropped
+}
+
+// trackPush waits for the client to ack a push it was sent. Typing frames
+// and messages without a seq can not be acked.
+func (c *Client) trackPush(msgData *messagev1.Message, resp *Resp) {
+	if c.acks == nil || msgData.GetSeq() == 0 {
+		return
+	}
+	c.acks.track(msgData, resp)
+}
+
+// retransmitLoop sends the pushes again whose ACK timed out, until the client
+// acks them or they run out of retries.
+func (c *Client) retransmitLoop(ctx context.Context, acks *ackTracker) {
+	defer c.wg.Done()
+
+	ticker := time.NewTicker(min(ackCheckInterval, acks.timeout))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case now := <-ticker.C:
+			resend, dropped := acks.due(now)
+			for _, resp := range resend {
+				if err := c.sendResp(resp, SendClassChat); err != nil {
+					logs.CtxWarnf(c.ctx, "retransmit push failed, userID: %s, err: %v", c.UserID, err)
+				}
+			}
+			for _, key := range dropped {
+				logs.CtxWarnf(c.ctx, "push was never acked, userID: %s, conversationID: %s, seq: %d",
+					c.UserID, key.conversationID, key.seq)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// MsgAck handles a client confirming pushes reached the app, and marks the
+// messages it did not send itself delivered. A failure to mark them does not
+// fail the ACK, the messages may have been revoked in the meantime.
+func (ws *WebsocketServer) MsgAck(ctx context.Context, client *Client, data *Req) ([]byte, error) {
+	var req gatewayv1.MsgAckReq
+	if err := decodeData(ctx, data, &req); err != nil {
+		return nil, err
+	}
+	if client.acks == nil {
+		return nil, nil
+	}
+
+	userID, err := conv.StrToInt64(client.UserID)
+	if err != nil {
+		return nil, err
+	}
+	delivered := client.acks.ack(req.GetConversationID(), req.GetSeqs(), userID)
+	// Notifications are not kept in the message store.
+	if len(delivered) == 0 || msgprocessor.IsNotification(req.GetConversationID()) {
+		return nil, nil
+	}
+	if err := ws.MarkMessagesDelivered(ctx, req.GetConversationID(), delivered); err != nil {
+		logs.CtxWarnf(ctx, "mark %d messages of %s delivered failed, err: %v", len(delivered), req.GetConversationID(), err)
+	}
+
+	return nil, nil
+}
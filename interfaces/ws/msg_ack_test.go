@@ -0,0 +1,86 @@
+package ws
+
+import (
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/crazyfrankie/goim/interfaces/ws/types"
+	"github.com/crazyfrankie/goim/pkg/sonic"
+	gatewayv1 "github.com/crazyfrankie/goim/protocol/gateway/v1"
+	messagev1 "github.com/crazyfrankie/goim/protocol/message/v1"
+	"github.com/crazyfrankie/goim/types/consts"
+)
+
+func dialAcking(t *testing.T, srv *httptest.Server) *websocket.Conn {
+	t.Helper()
+
+	addr := platformGatewayURL(srv, "uid:1002", "1002", consts.WebPlatformID) +
+		"&" + url.Values{types.MsgAck: {"true"}}.Encode()
+	conn, _, err := websocket.DefaultDialer.Dial(addr, nil)
+	if err != nil {
+		t.Fatalf("dial gateway: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return conn
+}
+
+func ackSeqs(t *testing.T, conn *websocket.Conn, seqs ...int64) *Resp {
+	t.Helper()
+
+	data, err := sonic.Marshal(&gatewayv1.MsgAckReq{ConversationID: "si_1001_1002", Seqs: seqs})
+	if err != nil {
+		t.Fatalf("marshal ack: %v", err)
+	}
+	return roundTrip(t, conn, &Req{ReqIdentifier: types.WsMsgAck, SendID: "1002", MsgIncr: "ack", Data: data})
+}
+
+func TestMsgAckMarksDelivered(t *testing.T) {
+	msgSvc := &fakeMessageService{}
+	srv, wsSrv := startGatewayWith(t, msgSvc, &fakeAuthService{}, WithMsgAck(time.Minute, 3))
+	hub := NewServer(wsSrv, nil)
+
+	conn := dialAcking(t, srv)
+	waitConns(t, wsSrv, "1002", 1)
+	pushSeqs(t, hub, 7, 8)
+	expectPush(t, conn, 1, 7)
+	expectPush(t, conn, 2, 8)
+
+	// Seq 9 was never pushed over this connection and is not marked.
+	if resp := ackSeqs(t, conn, 7, 8, 9); resp.ErrCode != 0 {
+		t.Fatalf("ack failed: %d %s", resp.ErrCode, resp.ErrMsg)
+	}
+	msgSvc.mu.Lock()
+	defer msgSvc.mu.Unlock()
+	if len(msgSvc.delivered) != 1 {
+		t.Fatalf("got %d status updates, want 1", len(msgSvc.delivered))
+	}
+	req := msgSvc.delivered[0]
+	if req.GetConversationID() != "si_1001_1002" || len(req.GetSeqs()) != 2 ||
+		req.GetStatus() != messagev1.MessageStatus_MESSAGE_STATUS_DELIVERED {
+		t.Fatalf("unexpected status update: %+v", req)
+	}
+}
+
+func TestMsgAckRetransmitsUntilRetryCap(t *testing.T) {
+	srv, wsSrv := startGatewayWith(t, &fakeMessageService{}, &fakeAuthService{}, WithMsgAck(50*time.Millisecond, 2))
+	hub := NewServer(wsSrv, nil)
+
+	conn := dialAcking(t, srv)
+	waitConns(t, wsSrv, "1002", 1)
+	pushSeqs(t, hub, 7)
+	expectPush(t, conn, 1, 7)
+
+	// The retransmissions carry the push seq of the original frame.
+	expectPush(t, conn, 1, 7)
+	expectPush(t, conn, 1, 7)
+
+	_ = conn.SetReadDeadline(time.Now().Add(300 * time.Millisecond))
+	if _, raw, err := conn.ReadMessage(); err == nil {
+		t.Fatalf("push retransmitted beyond the retry cap: %s", raw)
+	}
+}
@@ -0,0 +1,140 @@
+package ws
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"github.com/crazyfrankie/goim/pkg/lang/conv"
+	langslice "github.com/crazyfrankie/goim/pkg/lang/slice"
+	"github.com/crazyfrankie/goim/pkg/logs"
+	"github.com/crazyfrankie/goim/pkg/safego"
+	authv1 "github.com/crazyfrankie/goim/protocol/auth/v1"
+	gatewayv1 "github.com/crazyfrankie/goim/protocol/gateway/v1"
+	"github.com/crazyfrankie/goim/types/consts"
+)
+
+// loginConflicts reports whether, under policy, a login on newPlatform kicks
+// a connection on oldPlatform.
+func loginConflicts(policy int, newPlatform, oldPlatform int32) bool {
+	switch policy {
+	case consts.OnePerPlatformLogin:
+		return newPlatform == oldPlatform
+	case consts.OnePerClassLogin:
+		return consts.PlatformIDToClass(newPlatform) == consts.PlatformIDToClass(oldPlatform)
+	case consts.PCAndMobileLogin:
+		return isPCPlatform(newPlatform) == isPCPlatform(oldPlatform)
+	case consts.SingleDeviceLogin:
+		return true
+	default:
+		return false
+	}
+}
+
+func isPCPlatform(platformID int32) bool {
+	return consts.PlatformIDToClass(platformID) == consts.TerminalPC
+}
+
+// multiTerminalLoginChecker kicks the connections of newClient's user on this
+// node that the login policy does not allow next to it, and revokes their
+// tokens so they can not log back in with them.
+func (ws *WebsocketServer) multiTerminalLoginChecker(newClient *Client) {
+	if ws.multiLoginPolicy == consts.AllowAllLogin {
+		return
+	}
+
+	clients, _ := ws.GetUserAllCons(newClient.UserID)
+	var tokens []string
+	for _, c := range clients {
+		if c == newClient || !loginConflicts(ws.multiLoginPolicy, newClient.PlatformID, c.PlatformID) {
+			continue
+		}
+
+		logs.Debugf("kick conflicting login, userID: %s, platformID: %d, by platformID: %d", c.UserID, c.PlatformID, newClient.PlatformID)
+		// Leave the bucket at once, so the connection no longer counts
+		// against the policy while the kick frame is being written.
+		ws.bucketManager.GetBucket(c.UserID).DelClient(c)
+		userID, platformID := c.UserID, c.PlatformID
+		safego.Go(context.Background(), func() {
+			if err := c.KickOnlineMessage(); err != nil {
+				logs.Warnf("KickOnlineMessage failed, userID: %s, platformID: %d, err: %v", userID, platformID, err)
+			}
+		})
+		// A reconnect with the same token replaces the old connection, the
+		// token itself is still the one in use.
+		if c.Token != newClient.Token {
+			tokens = append(tokens, c.Token)
+		}
+	}
+
+	ws.revokeTokens(newClient.UserID, langslice.Unique(tokens))
+}
+
+func (ws *WebsocketServer) revokeTokens(userID string, tokens []string) {
+	if len(tokens) == 0 || ws.authClient == nil {
+		return
+	}
+	uid, err := conv.StrToInt64(userID)
+	if err != nil {
+		return
+	}
+
+	safego.Go(context.Background(), func() {
+		ctx, cancel := context.WithTimeout(context.Background(), authTimeout)
+		defer cancel()
+		if _, err := ws.authClient.RevokeTokens(ctx, &authv1.RevokeTokensRequest{UserID: uid, Tokens: tokens}); err != nil {
+			logs.CtxErrorf(ctx, "revoke %d kicked tokens of user %s failed, err=%v", len(tokens), userID, err)
+		}
+	})
+}
+
+// remoteMultiTerminalLoginCheck asks the other gateway nodes holding the
+// user's connections to apply the login policy against newClient.
+func (ws *WebsocketServer) remoteMultiTerminalLoginCheck(newClient *Client) {
+	if ws.multiLoginPolicy == consts.AllowAllLogin || ws.routeTable == nil || ws.discovery == nil {
+		return
+	}
+
+	req := &gatewayv1.MultiTerminalLoginCheckRequest{
+		UserID:     newClient.UserID,
+		PlatformID: newClient.PlatformID,
+		Token:      newClient.Token,
+	}
+	safego.Go(context.Background(), func() {
+		ctx, cancel := context.WithTimeout(context.Background(), authTimeout)
+		defer cancel()
+
+		routes, err := ws.routeTable.Lookup(ctx, []string{req.UserID})
+		if err != nil {
+			logs.CtxErrorf(ctx, "lookup routes of user %s failed, err=%v", req.UserID, err)
+			return
+		}
+		nodes := make(map[string]struct{})
+		for _, r := range routes[req.UserID] {
+			if r.NodeID != ws.nodeID {
+				nodes[r.NodeID] = struct{}{}
+			}
+		}
+		if len(nodes) == 0 {
+			return
+		}
+
+		conns, err := ws.discovery.GetConns(ctx, consts.MsgGatewayServiceName)
+		if err != nil {
+			logs.CtxErrorf(ctx, "get gateway conns failed, err=%v", err)
+			return
+		}
+		for _, cc := range conns {
+			cli, ok := cc.(*grpc.ClientConn)
+			if !ok {
+				continue
+			}
+			if _, ok := nodes[cli.Target()]; !ok {
+				continue
+			}
+			if _, err := gatewayv1.NewGatewayServiceClient(cc).MultiTerminalLoginCheck(ctx, req); err != nil {
+				logs.CtxWarnf(ctx, "multi terminal login check on gateway %s failed, err=%v", cli.Target(), err)
+			}
+		}
+	})
+}
@@ -0,0 +1,116 @@
+package ws
+
+import (
+	"context"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/crazyfrankie/goim/interfaces/ws/types"
+	gatewayv1 "github.com/crazyfrankie/goim/protocol/gateway/v1"
+	"github.com/crazyfrankie/goim/types/consts"
+)
+
+func TestLoginConflicts(t *testing.T) {
+	cases := []struct {
+		name     string
+		policy   int
+		newID    int32
+		oldID    int32
+		conflict bool
+	}{
+		{"allow all", consts.AllowAllLogin, consts.IOSPlatformID, consts.IOSPlatformID, false},
+		{"same platform", consts.OnePerPlatformLogin, consts.IOSPlatformID, consts.IOSPlatformID, true},
+		{"other platform", consts.OnePerPlatformLogin, consts.IOSPlatformID, consts.AndroidPlatformID, false},
+		{"same class", consts.OnePerClassLogin, consts.IOSPlatformID, consts.AndroidPlatformID, true},
+		{"other class", consts.OnePerClassLogin, consts.IOSPlatformID, consts.IPadPlatformID, false},
+		{"pc and pc", consts.PCAndMobileLogin, consts.WindowsPlatformID, consts.OSXPlatformID, true},
+		{"pc and mobile", consts.PCAndMobileLogin, consts.WindowsPlatformID, consts.IOSPlatformID, false},
+		{"mobile and web", consts.PCAndMobileLogin, consts.AndroidPlatformID, consts.WebPlatformID, true},
+		{"single device", consts.SingleDeviceLogin, consts.WindowsPlatformID, consts.IOSPlatformID, true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := loginConflicts(tc.policy, tc.newID, tc.oldID); got != tc.conflict {
+				t.Fatalf("got conflict %v, want %v", got, tc.conflict)
+			}
+		})
+	}
+}
+
+func dialPlatform(t *testing.T, srv *httptest.Server, token, userID string, platformID int) *websocket.Conn {
+	t.Helper()
+
+	conn, _, err := websocket.DefaultDialer.Dial(platformGatewayURL(srv, token, userID, platformID), nil)
+	if err != nil {
+		t.Fatalf("dial gateway: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return conn
+}
+
+func waitConns(t *testing.T, wsSrv *WebsocketServer, userID string, n int) {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		clients, _ := wsSrv.GetUserAllCons(userID)
+		if len(clients) == n {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("user %s has %d connections, want %d", userID, len(clients), n)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestMultiTerminalLoginKicksConflicts(t *testing.T) {
+	authSvc := &fakeAuthService{}
+	srv, wsSrv := startGatewayWith(t, &fakeMessageService{}, authSvc, WithMultiLoginPolicy(consts.OnePerPlatformLogin))
+
+	old := dialPlatform(t, srv, "uid:1001#old", "1001", consts.IOSPlatformID)
+	waitConns(t, wsSrv, "1001", 1)
+	// Another platform is allowed next to it.
+	dialPlatform(t, srv, "uid:1001#pc", "1001", consts.WindowsPlatformID)
+	waitConns(t, wsSrv, "1001", 2)
+
+	dialPlatform(t, srv, "uid:1001#new", "1001", consts.IOSPlatformID)
+
+	if frame := readFrame(t, old); frame.ReqIdentifier != types.WSKickOnlineMsg {
+		t.Fatalf("got reqIdentifier %d, want %d", frame.ReqIdentifier, types.WSKickOnlineMsg)
+	}
+	waitConns(t, wsSrv, "1001", 2)
+
+	deadline := time.Now().Add(5 * time.Second)
+	for !slices.Equal(authSvc.revokedTokens(), []string{"uid:1001#old"}) {
+		if time.Now().After(deadline) {
+			t.Fatalf("got revoked tokens %v, want the kicked one", authSvc.revokedTokens())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestMultiTerminalLoginCheckFromOtherNode(t *testing.T) {
+	authSvc := &fakeAuthService{}
+	srv, wsSrv := startGatewayWith(t, &fakeMessageService{}, authSvc, WithMultiLoginPolicy(consts.SingleDeviceLogin))
+
+	dialPlatform(t, srv, "uid:1001#old", "1001", consts.IOSPlatformID)
+	waitConns(t, wsSrv, "1001", 1)
+
+	// The user logged in on another gateway node.
+	hub := NewServer(wsSrv, nil)
+	_, err := hub.MultiTerminalLoginCheck(context.Background(), &gatewayv1.MultiTerminalLoginCheckRequest{
+		UserID:     "1001",
+		PlatformID: consts.WindowsPlatformID,
+		Token:      "uid:1001#remote",
+	})
+	if err != nil {
+		t.Fatalf("multi terminal login check: %v", err)
+	}
+	waitConns(t, wsSrv, "1001", 0)
+}
@@ -11,15 +11,20 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/crazyfrankie/goim/internal/route"
+	langslice "github.com/crazyfrankie/goim/pkg/lang/slice"
 	"github.com/crazyfrankie/goim/pkg/logs"
 )
 
-func (ws *WebsocketServer) ChangeOnlineStatus(concurrent int) {
+// ChangeOnlineStatus merges user online state changes and processes them in
+// batches until ctx is done.
+func (ws *WebsocketServer) ChangeOnlineStatus(ctx context.Context, concurrent int) {
 	if concurrent < 1 {
 		concurrent = 1
 	}
 	const renewalTime = time.Minute * 5 // 5分钟续期时间
 	renewalTicker := time.NewTicker(renewalTime)
+	defer renewalTicker.Stop()
 
 	requestChs := make([]chan *SetUserOnlineStatusReq, concurrent)
 	changeStatus := make([][]UserState, concurrent)
@@ -30,6 +35,7 @@ func (ws *WebsocketServer) ChangeOnlineStatus(concurrent int) {
 	}
 
 	mergeTicker := time.NewTicker(time.Second)
+	defer mergeTicker.Stop()
 
 	local2pb := func(u UserState) *UserOnlineStatus {
 		return &UserOnlineStatus{
@@ -90,9 +96,8 @@ func (ws *WebsocketServer) ChangeOnlineStatus(concurrent int) {
 		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
 		defer cancel()
 
-		// 这里应该调用用户服务更新在线状态
-		// 由于我们没有用户服务客户端，这里简化处理
-		logs.Debugf("update user online status, operationID: %s, count: %d", opID, len(req.Status))
+		logs.CtxDebugf(ctx, "update user online status, operationID: %s, count: %d", opID, len(req.Status))
+		ws.saveRoutes(ctx, req.Status)
 
 		for _, ss := range req.Status {
 			for _, online := range ss.Online {
@@ -119,8 +124,17 @@ func (ws *WebsocketServer) ChangeOnlineStatus(concurrent int) {
 		}(requestChs[i])
 	}
 
+	defer func() {
+		for _, ch := range requestChs {
+			close(ch)
+		}
+	}()
+
 	for {
 		select {
+		case <-ctx.Done():
+			pushAllUserState()
+			return
 		case <-mergeTicker.C:
 			pushAllUserState()
 		case now := <-renewalTicker.C:
@@ -128,13 +142,57 @@ func (ws *WebsocketServer) ChangeOnlineStatus(concurrent int) {
 			users := ws.getAllUserStatus(deadline, now)
 			logs.Debugf("renewal ticker, deadline: %v, nowtime: %v, num: %d", deadline, now, len(users))
 			pushUserState(users...)
-		case state := <-ws.getUserStateChannel():
+		case state := <-ws.bucketManager.UserState():
 			logs.Debugf("OnlineCache user online change, userID: %s, online: %v, offline: %v", state.UserID, state.Online, state.Offline)
 			pushUserState(state)
+			ws.pushUserIDOnlineStatus(ctx, state.UserID, state.Online)
+		}
+	}
+}
+
+// saveRoutes publishes what this node holds for the users whose state
+// changed or is being renewed. The entry is rebuilt from the buckets rather
+// than from the change itself, so batched changes of the same user settle on
+// the current state.
+func (ws *WebsocketServer) saveRoutes(ctx context.Context, status []*UserOnlineStatus) {
+	if ws.routeTable == nil {
+		return
+	}
+
+	routes := make(map[string]*route.Route, len(status))
+	for _, ss := range status {
+		if ss.UserID == "" {
+			continue
 		}
+		routes[ss.UserID] = ws.localRoute(ss.UserID)
+	}
+	if err := ws.routeTable.Save(ctx, ws.nodeID, routes); err != nil {
+		logs.CtxErrorf(ctx, "save gateway routes of %d users failed, err=%v", len(routes), err)
 	}
 }
 
+// localRoute returns the platforms and connections userID has on this node,
+// including the ones of sessions waiting for a resume.
+func (ws *WebsocketServer) localRoute(userID string) *route.Route {
+	clients, _ := ws.GetUserAllCons(userID)
+	r := &route.Route{
+		PlatformIDs: make([]int32, 0, len(clients)),
+		ConnIDs:     make([]string, 0, len(clients)),
+	}
+	for _, c := range clients {
+		r.PlatformIDs = append(r.PlatformIDs, c.PlatformID)
+		r.ConnIDs = append(r.ConnIDs, c.ConnID)
+	}
+	// Pushes keep reaching this node while a session waits for a resume.
+	for _, s := range ws.detachedSessions(userID) {
+		r.PlatformIDs = append(r.PlatformIDs, s.platformID)
+		r.ConnIDs = append(r.ConnIDs, s.connID)
+	}
+	r.PlatformIDs = langslice.Unique(r.PlatformIDs)
+
+	return r
+}
+
 // getAllUserStatus 获取所有用户状态
 func (ws *WebsocketServer) getAllUserStatus(deadline time.Time, nowtime time.Time) []UserState {
 	var result []UserState
@@ -174,16 +232,6 @@ func (ws *WebsocketServer) getAllUserStatus(deadline time.Time, nowtime time.Tim
 	return result
 }
 
-// getUserStateChannel 获取用户状态变化通道
-func (ws *WebsocketServer) getUserStateChannel() <-chan UserState {
-	// 这里应该返回一个用户状态变化的通道
-	// 由于我们使用BucketManager，需要实现一个状态变化通知机制
-	// 这里简化实现，返回一个空通道
-	ch := make(chan UserState)
-	close(ch)
-	return ch
-}
-
 // 相关数据结构
 type SetUserOnlineStatusReq struct {
 	Status []*UserOnlineStatus `json:"status"`
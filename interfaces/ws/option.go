@@ -1,6 +1,11 @@
 package ws
 
-import "time"
+import (
+	"net/netip"
+	"time"
+
+	"github.com/crazyfrankie/goim/internal/route"
+)
 
 type (
 	Option  func(opt *configs)
@@ -15,6 +20,30 @@ type (
 		messageMaxMsgLength int
 		// Websocket write buffer, default: 4096, 4kb.
 		writeBufferSize int
+		// Number of buckets the connections are sharded into
+		bucketNum int
+		// Per bucket capacity and broadcast worker settings
+		bucketConfig *BucketConfig
+		// Address this node registers in discovery, identifies it in the route table
+		nodeID string
+		// Cluster wide table of the gateway nodes holding each user
+		routeTable *route.Table
+		// Which connections a new login kicks, one of the consts login policies
+		multiLoginPolicy int
+		// Request rate limits per connection, user and remote IP
+		rateLimit *RateLimitConfig
+		// What sending to a client with a full queue does, per send class
+		backpressure *BackpressureConfig
+		// How long a session waits to be resumed and how many pushes it keeps
+		resumeGrace time.Duration
+		replaySize  int
+		// How long a push waits for its ACK and how often it is sent again
+		ackTimeout time.Duration
+		ackRetries int
+		// Which clients may join rooms and send to them
+		roomAuthorizer RoomAuthorizer
+		// Proxies whose X-Forwarded-For is believed when telling the client IP
+		trustedProxies []netip.Prefix
 	}
 )
 
@@ -47,3 +76,78 @@ func WithWriteBufferSize(size int) Option {
 		opt.writeBufferSize = size
 	}
 }
+
+func WithBucketNum(num int) Option {
+	return func(opt *configs) {
+		opt.bucketNum = num
+	}
+}
+
+func WithBucketConfig(config *BucketConfig) Option {
+	return func(opt *configs) {
+		opt.bucketConfig = config
+	}
+}
+
+// WithRouteTable publishes the users connected to this node in table under
+// nodeID, which must be the address the node registers in discovery.
+func WithRouteTable(nodeID string, table *route.Table) Option {
+	return func(opt *configs) {
+		opt.nodeID = nodeID
+		opt.routeTable = table
+	}
+}
+
+func WithMultiLoginPolicy(policy int) Option {
+	return func(opt *configs) {
+		opt.multiLoginPolicy = policy
+	}
+}
+
+func WithRateLimit(config *RateLimitConfig) Option {
+	return func(opt *configs) {
+		opt.rateLimit = config
+	}
+}
+
+func WithBackpressure(config *BackpressureConfig) Option {
+	return func(opt *configs) {
+		opt.backpressure = config
+	}
+}
+
+// WithSessionResume sets how long a session outlives its connection waiting to
+// be resumed and how many pushes it keeps for replay. A negative grace
+// disables resuming.
+func WithSessionResume(grace time.Duration, replaySize int) Option {
+	return func(opt *configs) {
+		opt.resumeGrace = grace
+		opt.replaySize = replaySize
+	}
+}
+
+// WithMsgAck sets how long a push waits for the client's ACK before it is
+// sent again, and how many times it is.
+func WithMsgAck(timeout time.Duration, retries int) Option {
+	return func(opt *configs) {
+		opt.ackTimeout = timeout
+		opt.ackRetries = retries
+	}
+}
+
+// WithRoomAuthorizer sets the hook deciding which clients may join rooms and
+// send to them, all of them may without one.
+func WithRoomAuthorizer(authorizer RoomAuthorizer) Option {
+	return func(opt *configs) {
+		opt.roomAuthorizer = authorizer
+	}
+}
+
+// WithTrustedProxies sets the proxies in front of the gateway. The client IP
+// is read from X-Forwarded-For only on connections coming from one of them,
+// without any it is always the peer address.
+func WithTrustedProxies(proxies []netip.Prefix) Option {
+	return func(opt *configs) {
+		opt.trustedProxies = proxies
+	}
+}
@@ -0,0 +1,214 @@
+package ws
+
+import (
+	"sync"
+	"time"
+
+	"github.com/crazyfrankie/goim/interfaces/ws/types"
+	"github.com/crazyfrankie/goim/pkg/errorx"
+	"github.com/crazyfrankie/goim/pkg/logs"
+	"github.com/crazyfrankie/goim/types/errno"
+)
+
+const (
+	rateLimitScopeConn = "conn"
+	rateLimitScopeUser = "user"
+	rateLimitScopeIP   = "ip"
+)
+
+// RateLimit is a token bucket refilled with Rate tokens per second and holding
+// at most Burst of them, a zero Rate means no limit.
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+// RateLimits are the limits a request is checked against: per connection, per
+// user across its connections on this node and per remote IP.
+type RateLimits struct {
+	Conn RateLimit
+	User RateLimit
+	IP   RateLimit
+}
+
+type RateLimitConfig struct {
+	// Default applies to the requests without an entry in Reqs
+	Default RateLimits
+	// Reqs overrides Default per ReqIdentifier
+	Reqs map[int32]RateLimits
+	// A connection exceeding its limits MaxViolations times within
+	// ViolationWindow is closed, zero never closes it
+	MaxViolations   int
+	ViolationWindow time.Duration
+}
+
+func DefaultRateLimitConfig() *RateLimitConfig {
+	return &RateLimitConfig{
+		Default: RateLimits{
+			Conn: RateLimit{Rate: 20, Burst: 40},
+			User: RateLimit{Rate: 50, Burst: 100},
+			IP:   RateLimit{Rate: 200, Burst: 400},
+		},
+		Reqs: map[int32]RateLimits{
+			types.WSSendMsg: {
+				Conn: RateLimit{Rate: 10, Burst: 20},
+				User: RateLimit{Rate: 20, Burst: 40},
+				IP:   RateLimit{Rate: 100, Burst: 200},
+			},
+			// Room messages fan out to every member, on every node
+			types.WsSendRoomMsg: {
+				Conn: RateLimit{Rate: 5, Burst: 10},
+				User: RateLimit{Rate: 10, Burst: 20},
+				IP:   RateLimit{Rate: 100, Burst: 200},
+			},
+			types.WsSubUserOnlineStatus: {
+				Conn: RateLimit{Rate: 2, Burst: 5},
+				User: RateLimit{Rate: 5, Burst: 10},
+				IP:   RateLimit{Rate: 50, Burst: 100},
+			},
+		},
+		MaxViolations:   10,
+		ViolationWindow: 10 * time.Second,
+	}
+}
+
+func (c *RateLimitConfig) limits(reqIdentifier int32) RateLimits {
+	if l, ok := c.Reqs[reqIdentifier]; ok {
+		return l
+	}
+	return c.Default
+}
+
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// refill adds the tokens accumulated since the last call and reports whether
+// one is available.
+func (b *tokenBucket) refill(limit RateLimit, now time.Time) bool {
+	burst := float64(max(limit.Burst, 1))
+	if b.last.IsZero() {
+		b.tokens = burst
+	} else {
+		b.tokens = min(burst, b.tokens+now.Sub(b.last).Seconds()*limit.Rate)
+	}
+	b.last = now
+
+	return b.tokens >= 1
+}
+
+// tokenBuckets are the buckets of one holder, keyed by ReqIdentifier.
+type tokenBuckets map[int32]*tokenBucket
+
+func (bs tokenBuckets) get(reqIdentifier int32) *tokenBucket {
+	b, ok := bs[reqIdentifier]
+	if !ok {
+		b = &tokenBucket{}
+		bs[reqIdentifier] = b
+	}
+	return b
+}
+
+// rateLimiter holds the buckets of the users and remote IPs connected to this
+// node, connection buckets live on the Client.
+type rateLimiter struct {
+	config *RateLimitConfig
+
+	mu    sync.Mutex
+	users map[string]tokenBuckets
+	ips   map[string]tokenBuckets
+}
+
+func newRateLimiter(config *RateLimitConfig) *rateLimiter {
+	return &rateLimiter{
+		config: config,
+		users:  make(map[string]tokenBuckets),
+		ips:    make(map[string]tokenBuckets),
+	}
+}
+
+// allow takes a token of reqIdentifier from each limited scope of c, or none
+// of them when any is empty, in which case the exhausted scope is returned.
+func (l *rateLimiter) allow(c *Client, reqIdentifier int32) (string, bool) {
+	limits := l.config.limits(reqIdentifier)
+	now := time.Now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	checks := []struct {
+		scope   string
+		limit   RateLimit
+		buckets func() tokenBuckets
+	}{
+		{rateLimitScopeConn, limits.Conn, func() tokenBuckets { return c.rateLimits }},
+		{rateLimitScopeUser, limits.User, func() tokenBuckets { return holderBuckets(l.users, c.UserID) }},
+		{rateLimitScopeIP, limits.IP, func() tokenBuckets { return holderBuckets(l.ips, c.IP()) }},
+	}
+	taken := make([]*tokenBucket, 0, len(checks))
+	for _, check := range checks {
+		if check.limit.Rate <= 0 {
+			continue
+		}
+		b := check.buckets().get(reqIdentifier)
+		if !b.refill(check.limit, now) {
+			return check.scope, false
+		}
+		taken = append(taken, b)
+	}
+	for _, b := range taken {
+		b.tokens--
+	}
+
+	return "", true
+}
+
+// forget drops the buckets of userID and ip once they hold no connection on
+// this node.
+func (l *rateLimiter) forget(userID string, userOnline bool, ip string, ipConns int32) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !userOnline {
+		delete(l.users, userID)
+	}
+	if ipConns == 0 {
+		delete(l.ips, ip)
+	}
+}
+
+func holderBuckets(holders map[string]tokenBuckets, key string) tokenBuckets {
+	bs, ok := holders[key]
+	if !ok {
+		bs = make(tokenBuckets)
+		holders[key] = bs
+	}
+	return bs
+}
+
+// RateLimit checks a request of client against the configured limits. A
+// request over a limit gets an ErrRateLimited reply, once the connection
+// keeps going over them ErrRateLimitExceeded tells it to close.
+func (ws *WebsocketServer) RateLimit(client *Client, reqIdentifier int32) error {
+	scope, ok := ws.rateLimiter.allow(client, reqIdentifier)
+	if ok {
+		return nil
+	}
+
+	config := ws.rateLimiter.config
+	now := time.Now()
+	if now.Sub(client.violationStart) > config.ViolationWindow {
+		client.violationStart = now
+		client.violations = 0
+	}
+	client.violations++
+	logs.Debugf("rate limited, userID: %s, connID: %s, reqIdentifier: %d, scope: %s, violations: %d",
+		client.UserID, client.ConnID, reqIdentifier, scope, client.violations)
+
+	if config.MaxViolations > 0 && client.violations >= config.MaxViolations {
+		return types.ErrRateLimitExceeded
+	}
+
+	return errorx.New(errno.ErrRateLimitedCode, errorx.KV("scope", scope))
+}
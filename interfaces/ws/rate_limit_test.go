@@ -0,0 +1,122 @@
+package ws
+
+import (
+	"errors"
+	"net/http"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/crazyfrankie/goim/interfaces/ws/types"
+	"github.com/crazyfrankie/goim/pkg/sonic"
+	messagev1 "github.com/crazyfrankie/goim/protocol/message/v1"
+	"github.com/crazyfrankie/goim/types/errno"
+)
+
+// slowRate barely refills, so a test only ever sees the burst.
+const slowRate = 0.001
+
+func TestRateLimiterScopes(t *testing.T) {
+	l := newRateLimiter(&RateLimitConfig{
+		Reqs: map[int32]RateLimits{
+			types.WSSendMsg: {
+				Conn: RateLimit{Rate: slowRate, Burst: 2},
+				User: RateLimit{Rate: slowRate, Burst: 3},
+			},
+		},
+	})
+	phone := newTestClient("1001", 1, "conn-1")
+	pc := newTestClient("1001", 3, "conn-2")
+
+	for i := 0; i < 2; i++ {
+		if _, ok := l.allow(phone, types.WSSendMsg); !ok {
+			t.Fatalf("request %d was limited", i)
+		}
+	}
+	if scope, ok := l.allow(phone, types.WSSendMsg); ok || scope != rateLimitScopeConn {
+		t.Fatalf("got scope %q allowed %v, want conn limited", scope, ok)
+	}
+	// Requests without limits are not counted against the others.
+	if _, ok := l.allow(phone, types.WSPullMsg); !ok {
+		t.Fatal("unlimited request was limited")
+	}
+
+	// The denied request above took no token of the user.
+	if _, ok := l.allow(pc, types.WSSendMsg); !ok {
+		t.Fatal("user limit was hit too early")
+	}
+	if scope, ok := l.allow(pc, types.WSSendMsg); ok || scope != rateLimitScopeUser {
+		t.Fatalf("got scope %q allowed %v, want user limited", scope, ok)
+	}
+
+	l.forget("1001", false, pc.IP(), 0)
+	if _, ok := l.allow(pc, types.WSSendMsg); !ok {
+		t.Fatal("user buckets survived the user going offline")
+	}
+}
+
+func TestClientIP(t *testing.T) {
+	trusted := []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}
+	cases := []struct {
+		name      string
+		peer      string
+		forwarded string
+		want      string
+	}{
+		{name: "direct", peer: "203.0.113.7:5000", want: "203.0.113.7"},
+		{name: "ipv6", peer: "[::1]:5000", want: "::1"},
+		{name: "untrusted peer", peer: "203.0.113.7:5000", forwarded: "198.51.100.1", want: "203.0.113.7"},
+		{name: "trusted proxy", peer: "10.0.0.1:5000", forwarded: "203.0.113.7", want: "203.0.113.7"},
+		{name: "spoofed hop", peer: "10.0.0.1:5000", forwarded: "198.51.100.1, 203.0.113.7, 10.0.0.2", want: "203.0.113.7"},
+		{name: "garbage hop", peer: "10.0.0.1:5000", forwarded: "203.0.113.7, nonsense", want: "10.0.0.1"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &http.Request{RemoteAddr: tc.peer, Header: http.Header{}}
+			if tc.forwarded != "" {
+				r.Header.Set("X-Forwarded-For", tc.forwarded)
+			}
+			if got := clientIP(r, trusted); got != tc.want {
+				t.Fatalf("got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRateLimitClosesConnection(t *testing.T) {
+	srv, _ := startGatewayWith(t, &fakeMessageService{}, &fakeAuthService{}, WithRateLimit(&RateLimitConfig{
+		Default:         RateLimits{Conn: RateLimit{Rate: slowRate, Burst: 1}},
+		MaxViolations:   2,
+		ViolationWindow: time.Minute,
+	}))
+	conn := dialGateway(t, srv, "1001")
+
+	data, err := sonic.Marshal(&messagev1.Message{SendID: 1001, RecvID: 1002, ClientMsgID: "client-msg-1"})
+	if err != nil {
+		t.Fatalf("marshal msg: %v", err)
+	}
+	req := &Req{ReqIdentifier: types.WSSendMsg, SendID: "1001", MsgIncr: "1", Data: data}
+
+	if resp := roundTrip(t, conn, req); resp.ErrCode != 0 {
+		t.Fatalf("first request: unexpected errCode %d: %s", resp.ErrCode, resp.ErrMsg)
+	}
+	if resp := roundTrip(t, conn, req); resp.ErrCode != errno.ErrRateLimitedCode {
+		t.Fatalf("second request: got errCode %d, want %d", resp.ErrCode, errno.ErrRateLimitedCode)
+	}
+
+	body, err := sonic.Marshal(req)
+	if err != nil {
+		t.Fatalf("marshal req: %v", err)
+	}
+	if err := conn.WriteMessage(websocket.BinaryMessage, body); err != nil {
+		t.Fatalf("write req: %v", err)
+	}
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	_, _, err = conn.ReadMessage()
+	var closeErr *websocket.CloseError
+	if !errors.As(err, &closeErr) || closeErr.Code != types.CloseRateLimited {
+		t.Fatalf("got %v, want close code %d", err, types.CloseRateLimited)
+	}
+}
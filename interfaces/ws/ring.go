@@ -73,6 +73,15 @@ func (r *Ring) GetAdv() {
 	r.rp++
 }
 
+// Peek Returns the i-th unread position without advancing
+func (r *Ring) Peek(i int) (*[]byte, error) {
+	if i < 0 || uint64(i) >= r.wp-r.rp {
+		return nil, types.ErrRingEmpty
+	}
+
+	return &r.data[(r.rp+uint64(i))&r.mask], nil
+}
+
 // Reset Reset Buffer
 func (r *Ring) Reset() {
 	r.rp = 0
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"sync"
 	"sync/atomic"
+
+	"github.com/crazyfrankie/goim/pkg/logs"
 )
 
 var (
@@ -13,7 +15,7 @@ var (
 type Room struct {
 	ID       string
 	Type     string
-	head     *Client
+	clients  map[*Client]struct{}
 	lock     sync.RWMutex
 	drop     bool
 	online   int32
@@ -24,6 +26,7 @@ func NewRoom(id, roomType string) *Room {
 	return &Room{
 		ID:       id,
 		Type:     roomType,
+		clients:  make(map[*Client]struct{}),
 		metadata: make(map[string]interface{}),
 	}
 }
@@ -37,14 +40,10 @@ func (r *Room) AddClient(client *Client) error {
 		return ErrRoomDropped
 	}
 
-	// Insert into the head of the linked list
-	if r.head != nil {
-		r.head.Prev = client
+	if _, ok := r.clients[client]; ok {
+		return nil
 	}
-	client.Next = r.head
-	client.Prev = nil
-	r.head = client
-	client.room = r
+	r.clients[client] = struct{}{}
 
 	atomic.AddInt32(&r.online, 1)
 	return nil
@@ -55,19 +54,10 @@ func (r *Room) DelClient(client *Client) bool {
 	r.lock.Lock()
 	defer r.lock.Unlock()
 
-	// Remove from the linked list
-	if client.Next != nil {
-		client.Next.Prev = client.Prev
-	}
-	if client.Prev != nil {
-		client.Prev.Next = client.Next
-	} else {
-		r.head = client.Next
+	if _, ok := r.clients[client]; !ok {
+		return r.drop
 	}
-
-	client.Next = nil
-	client.Prev = nil
-	client.room = nil
+	delete(r.clients, client)
 
 	atomic.AddInt32(&r.online, -1)
 
@@ -76,35 +66,44 @@ func (r *Room) DelClient(client *Client) bool {
 	return r.drop
 }
 
-// Broadcast Room Broadcast Message
-func (r *Room) Broadcast(data []byte) {
+// Broadcast Room Broadcast Message, full send queues are handled by the
+// backpressure policy of class
+func (r *Room) Broadcast(data []byte, class SendClass) {
 	r.lock.RLock()
 	defer r.lock.RUnlock()
 
-	for client := r.head; client != nil; client = client.Next {
-		select {
-		case client.sendCh <- data:
-		default:
-			// Skip when the send queue is full
+	for client := range r.clients {
+		_ = client.send(data, class)
+	}
+}
+
+// broadcastFrames sends a room message to every client, in the encoding of
+// its connection
+func (r *Room) broadcastFrames(frames *roomFrames, class SendClass) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	for client := range r.clients {
+		data, err := frames.frame(client)
+		if err != nil {
+			logs.Warnf("encode message of room %s failed, err: %v", r.ID, err)
+			continue
 		}
+		_ = client.send(data, class)
 	}
 }
 
 // BroadcastFilter Broadcast with filter
-func (r *Room) BroadcastFilter(data []byte, filter func(*Client) bool) {
+func (r *Room) BroadcastFilter(data []byte, class SendClass, filter func(*Client) bool) {
 	r.lock.RLock()
 	defer r.lock.RUnlock()
 
-	for client := r.head; client != nil; client = client.Next {
+	for client := range r.clients {
 		if filter != nil && !filter(client) {
 			continue
 		}
 
-		select {
-		case client.sendCh <- data:
-		default:
-			// Skip when the send queue is full
-		}
+		_ = client.send(data, class)
 	}
 }
 
@@ -114,7 +113,7 @@ func (r *Room) GetClients() []*Client {
 	defer r.lock.RUnlock()
 
 	var clients []*Client
-	for client := r.head; client != nil; client = client.Next {
+	for client := range r.clients {
 		clients = append(clients, client)
 	}
 
@@ -150,7 +149,7 @@ func (r *Room) Close() {
 	r.drop = true
 
 	// Close all client connections
-	for client := r.head; client != nil; client = client.Next {
+	for client := range r.clients {
 		client.close()
 	}
 }
@@ -0,0 +1,179 @@
+package ws
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/crazyfrankie/goim/interfaces/ws/types"
+	"github.com/crazyfrankie/goim/pkg/errorx"
+	"github.com/crazyfrankie/goim/pkg/logs"
+	"github.com/crazyfrankie/goim/pkg/safego"
+	gatewayv1 "github.com/crazyfrankie/goim/protocol/gateway/v1"
+	"github.com/crazyfrankie/goim/types/consts"
+	"github.com/crazyfrankie/goim/types/errno"
+)
+
+// roomForwardTimeout bounds forwarding a room message to the other nodes.
+const roomForwardTimeout = 5 * time.Second
+
+// RoomAuthorizer decides which clients may join a room and send to it. On
+// top of it, only members of a room can send to it.
+type RoomAuthorizer interface {
+	CanJoin(ctx context.Context, client *Client, roomID, roomType string) error
+	CanSend(ctx context.Context, client *Client, roomID string) error
+}
+
+// allowAllRooms lets every client join any room and send to it.
+type allowAllRooms struct{}
+
+func (allowAllRooms) CanJoin(context.Context, *Client, string, string) error { return nil }
+
+func (allowAllRooms) CanSend(context.Context, *Client, string) error { return nil }
+
+// roomFrames encodes a room message once per connection encoding, instead of
+// once per member.
+type roomFrames struct {
+	msg *gatewayv1.RoomMessage
+
+	mu     sync.Mutex
+	frames map[string][]byte
+}
+
+func newRoomFrames(msg *gatewayv1.RoomMessage) *roomFrames {
+	return &roomFrames{msg: msg, frames: make(map[string][]byte)}
+}
+
+func (f *roomFrames) frame(c *Client) ([]byte, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if frame, ok := f.frames[c.encoding]; ok {
+		return frame, nil
+	}
+	data, err := c.dataEncoder.Encode(f.msg)
+	if err != nil {
+		return nil, err
+	}
+	frame, err := c.encoder.Encode(&Resp{ReqIdentifier: types.WsRoomMsg, Data: data})
+	if err != nil {
+		return nil, err
+	}
+	f.frames[c.encoding] = frame
+
+	return frame, nil
+}
+
+// JoinRoom adds the connection to a room, next to the ones it is in.
+func (ws *WebsocketServer) JoinRoom(ctx context.Context, client *Client, data *Req) ([]byte, error) {
+	var req gatewayv1.JoinRoomReq
+	if err := decodeData(ctx, data, &req); err != nil {
+		return nil, err
+	}
+	if req.GetRoomID() == "" {
+		return nil, errorx.New(errno.ErrReqDataCode, errorx.KV("msg", "roomID is empty"))
+	}
+	if err := ws.roomAuthorizer.CanJoin(ctx, client, req.GetRoomID(), req.GetRoomType()); err != nil {
+		logs.CtxInfof(ctx, "user %s may not join room %s, err: %v", client.UserID, req.GetRoomID(), err)
+		return nil, errorx.New(errno.ErrRoomForbiddenCode, errorx.KV("room_id", req.GetRoomID()))
+	}
+
+	bucket := ws.bucketManager.GetBucket(client.UserID)
+	if err := bucket.JoinRoom(client, req.GetRoomID(), req.GetRoomType()); err != nil {
+		if errors.Is(err, types.ErrRoomLimitExceeded) {
+			return nil, errorx.New(errno.ErrRoomLimitCode, errorx.KV("limit", strconv.Itoa(bucket.clientRoomLimit)))
+		}
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// LeaveRoom removes the connection from a room.
+func (ws *WebsocketServer) LeaveRoom(ctx context.Context, client *Client, data *Req) ([]byte, error) {
+	var req gatewayv1.LeaveRoomReq
+	if err := decodeData(ctx, data, &req); err != nil {
+		return nil, err
+	}
+	if !ws.bucketManager.GetBucket(client.UserID).LeaveRoom(client, req.GetRoomID()) {
+		return nil, errorx.New(errno.ErrRoomNotJoinedCode, errorx.KV("room_id", req.GetRoomID()))
+	}
+
+	return nil, nil
+}
+
+// SendRoomMessage broadcasts a message of the connection to one of the rooms
+// it is in, on every node. The message is not persisted.
+func (ws *WebsocketServer) SendRoomMessage(ctx context.Context, client *Client, data *Req) ([]byte, error) {
+	var req gatewayv1.SendRoomMsgReq
+	if err := decodeData(ctx, data, &req); err != nil {
+		return nil, err
+	}
+	if !ws.bucketManager.GetBucket(client.UserID).InRoom(client, req.GetRoomID()) {
+		return nil, errorx.New(errno.ErrRoomNotJoinedCode, errorx.KV("room_id", req.GetRoomID()))
+	}
+	if err := ws.roomAuthorizer.CanSend(ctx, client, req.GetRoomID()); err != nil {
+		logs.CtxInfof(ctx, "user %s may not send to room %s, err: %v", client.UserID, req.GetRoomID(), err)
+		return nil, errorx.New(errno.ErrRoomForbiddenCode, errorx.KV("room_id", req.GetRoomID()))
+	}
+
+	ws.BroadcastRoomMessage(ctx, &gatewayv1.RoomMessage{
+		RoomID:           req.GetRoomID(),
+		SendID:           client.UserID,
+		SenderPlatformID: client.PlatformID,
+		ContentType:      req.GetContentType(),
+		Content:          req.GetContent(),
+		SendTime:         time.Now().UnixMilli(),
+	}, true)
+
+	return nil, nil
+}
+
+// BroadcastRoomMessage sends msg to the room's members on this node, and
+// forwards it to the other nodes when forward is set.
+func (ws *WebsocketServer) BroadcastRoomMessage(ctx context.Context, msg *gatewayv1.RoomMessage, forward bool) {
+	req := &BroadcastReq{RoomID: msg.GetRoomID(), Class: SendClassRoom, frames: newRoomFrames(msg)}
+	for _, bucket := range ws.bucketManager.GetAllBuckets() {
+		bucket.BroadcastRoom(req)
+	}
+
+	if forward {
+		ws.forwardRoomMessage(msg)
+	}
+}
+
+// forwardRoomMessage hands a room message to the other gateway nodes. Rooms
+// are not in the route table, every node gets it.
+func (ws *WebsocketServer) forwardRoomMessage(msg *gatewayv1.RoomMessage) {
+	if ws.discovery == nil {
+		return
+	}
+
+	req := &gatewayv1.BroadcastRoomRequest{Msg: msg, Forwarded: true}
+	safego.Go(context.Background(), func() {
+		ctx, cancel := context.WithTimeout(context.Background(), roomForwardTimeout)
+		defer cancel()
+
+		conns, err := ws.discovery.GetConns(ctx, consts.MsgGatewayServiceName)
+		if err != nil {
+			logs.CtxErrorf(ctx, "get gateway conns failed, err=%v", err)
+			return
+		}
+		for _, cc := range conns {
+			if ws.discovery.IsSelfNode(cc) {
+				continue
+			}
+			if _, err := gatewayv1.NewGatewayServiceClient(cc).BroadcastRoom(ctx, req); err != nil {
+				target := ""
+				if cli, ok := cc.(*grpc.ClientConn); ok {
+					target = cli.Target()
+				}
+				logs.CtxWarnf(ctx, "forward message of room %s to gateway %s failed, err=%v", msg.GetRoomID(), target, err)
+			}
+		}
+	})
+}
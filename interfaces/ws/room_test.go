@@ -0,0 +1,209 @@
+package ws
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/crazyfrankie/goim/interfaces/ws/types"
+	"github.com/crazyfrankie/goim/pkg/sonic"
+	gatewayv1 "github.com/crazyfrankie/goim/protocol/gateway/v1"
+	"github.com/crazyfrankie/goim/types/errno"
+)
+
+// denyRooms refuses to let clients join rooms of type "staff".
+type denyRooms struct {
+	allowAllRooms
+}
+
+func (denyRooms) CanJoin(_ context.Context, _ *Client, _, roomType string) error {
+	if roomType == "staff" {
+		return errors.New("staff only")
+	}
+	return nil
+}
+
+func roomReq(t *testing.T, conn *websocket.Conn, userID string, reqIdentifier int32, payload any) *Resp {
+	t.Helper()
+
+	data, err := sonic.Marshal(payload)
+	if err != nil {
+		t.Fatalf("marshal payload: %v", err)
+	}
+	return roundTrip(t, conn, &Req{ReqIdentifier: reqIdentifier, SendID: userID, MsgIncr: "room", Data: data})
+}
+
+func expectRoomMsg(t *testing.T, frame *Resp, roomID, sendID, content string) {
+	t.Helper()
+
+	if frame.ReqIdentifier != types.WsRoomMsg {
+		t.Fatalf("got reqIdentifier %d, want %d", frame.ReqIdentifier, types.WsRoomMsg)
+	}
+	var msg gatewayv1.RoomMessage
+	if err := sonic.Unmarshal(frame.Data, &msg); err != nil {
+		t.Fatalf("unmarshal room message: %v", err)
+	}
+	if msg.GetRoomID() != roomID || msg.GetSendID() != sendID || string(msg.GetContent()) != content {
+		t.Fatalf("unexpected room message: %+v", &msg)
+	}
+}
+
+func TestRoomJoinSendLeave(t *testing.T) {
+	srv, wsSrv := startGateway(t, &fakeMessageService{})
+	host := dialGateway(t, srv, "1001")
+	viewer := dialGateway(t, srv, "1002")
+	waitConns(t, wsSrv, "1001", 1)
+	waitConns(t, wsSrv, "1002", 1)
+
+	for userID, conn := range map[string]*websocket.Conn{"1001": host, "1002": viewer} {
+		if resp := roomReq(t, conn, userID, types.WsJoinRoom, &gatewayv1.JoinRoomReq{RoomID: "live-1"}); resp.ErrCode != 0 {
+			t.Fatalf("join room: %d %s", resp.ErrCode, resp.ErrMsg)
+		}
+	}
+
+	data, _ := sonic.Marshal(&gatewayv1.SendRoomMsgReq{RoomID: "live-1", Content: []byte("hello")})
+	body, _ := sonic.Marshal(&Req{ReqIdentifier: types.WsSendRoomMsg, SendID: "1001", MsgIncr: "room", Data: data})
+	if err := host.WriteMessage(websocket.BinaryMessage, body); err != nil {
+		t.Fatalf("write req: %v", err)
+	}
+	// The sender gets the reply and its own message, in either order.
+	for range 2 {
+		frame := readFrame(t, host)
+		if frame.ReqIdentifier == types.WsSendRoomMsg {
+			if frame.ErrCode != 0 {
+				t.Fatalf("send room message: %d %s", frame.ErrCode, frame.ErrMsg)
+			}
+			continue
+		}
+		expectRoomMsg(t, frame, "live-1", "1001", "hello")
+	}
+	expectRoomMsg(t, readFrame(t, viewer), "live-1", "1001", "hello")
+
+	if resp := roomReq(t, viewer, "1002", types.WsLeaveRoom, &gatewayv1.LeaveRoomReq{RoomID: "live-1"}); resp.ErrCode != 0 {
+		t.Fatalf("leave room: %d %s", resp.ErrCode, resp.ErrMsg)
+	}
+	resp := roomReq(t, viewer, "1002", types.WsSendRoomMsg, &gatewayv1.SendRoomMsgReq{RoomID: "live-1", Content: []byte("bye")})
+	if resp.ErrCode != errno.ErrRoomNotJoinedCode {
+		t.Fatalf("send after leaving: got errCode %d, want %d", resp.ErrCode, errno.ErrRoomNotJoinedCode)
+	}
+}
+
+func TestRoomAuthorizer(t *testing.T) {
+	srv, wsSrv := startGatewayWith(t, &fakeMessageService{}, &fakeAuthService{}, WithRoomAuthorizer(denyRooms{}))
+	conn := dialGateway(t, srv, "1001")
+	waitConns(t, wsSrv, "1001", 1)
+
+	resp := roomReq(t, conn, "1001", types.WsJoinRoom, &gatewayv1.JoinRoomReq{RoomID: "ops", RoomType: "staff"})
+	if resp.ErrCode != errno.ErrRoomForbiddenCode {
+		t.Fatalf("join denied room: got errCode %d, want %d", resp.ErrCode, errno.ErrRoomForbiddenCode)
+	}
+	if resp := roomReq(t, conn, "1001", types.WsJoinRoom, &gatewayv1.JoinRoomReq{RoomID: "live-1"}); resp.ErrCode != 0 {
+		t.Fatalf("join room: %d %s", resp.ErrCode, resp.ErrMsg)
+	}
+}
+
+func TestHubBroadcastRoom(t *testing.T) {
+	srv, wsSrv := startGateway(t, &fakeMessageService{})
+	hub := NewServer(wsSrv, nil)
+	conn := dialGateway(t, srv, "1002")
+	waitConns(t, wsSrv, "1002", 1)
+	if resp := roomReq(t, conn, "1002", types.WsJoinRoom, &gatewayv1.JoinRoomReq{RoomID: "live-1"}); resp.ErrCode != 0 {
+		t.Fatalf("join room: %d %s", resp.ErrCode, resp.ErrMsg)
+	}
+
+	_, err := hub.BroadcastRoom(context.Background(), &gatewayv1.BroadcastRoomRequest{
+		Msg:       &gatewayv1.RoomMessage{RoomID: "live-1", SendID: "system", Content: []byte("starting")},
+		Forwarded: true,
+	})
+	if err != nil {
+		t.Fatalf("broadcast room: %v", err)
+	}
+	expectRoomMsg(t, readFrame(t, conn), "live-1", "system", "starting")
+}
+
+func TestBucketRoomMembership(t *testing.T) {
+	// A zero config still gets broadcast workers.
+	bm := NewBucketManager(1, &BucketConfig{})
+	bucket := bm.GetBucket("1001")
+	c := newTestClient("1001", 1, "conn-1")
+
+	if err := bucket.JoinRoom(c, "live-1", ""); !errors.Is(err, types.ErrClientNotFound) {
+		t.Fatalf("join before registration: got %v, want %v", err, types.ErrClientNotFound)
+	}
+	_ = bucket.PutClient(c)
+	if err := bucket.JoinRoom(c, "live-1", ""); err != nil {
+		t.Fatalf("join room: %v", err)
+	}
+	bucket.BroadcastRoom(&BroadcastReq{RoomID: "live-1", Message: []byte("hi"), Class: SendClassChat})
+	select {
+	case got := <-c.sendCh:
+		if string(got) != "hi" {
+			t.Fatalf("got %s, want hi", got)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("room broadcast was not delivered")
+	}
+
+	bucket.DelClient(c)
+	if _, ok := bucket.GetRoom("live-1"); ok {
+		t.Fatal("empty room was not dropped")
+	}
+}
+
+func TestBucketMultipleRooms(t *testing.T) {
+	bm := NewBucketManager(1, &BucketConfig{ClientRoomLimit: 2})
+	bucket := bm.GetBucket("1001")
+	c := newTestClient("1001", 1, "conn-1")
+	other := newTestClient("1002", 1, "conn-2")
+	_ = bucket.PutClient(c)
+	_ = bucket.PutClient(other)
+
+	for _, roomID := range []string{"stream", "staff", "stream"} {
+		if err := bucket.JoinRoom(c, roomID, ""); err != nil {
+			t.Fatalf("join %s: %v", roomID, err)
+		}
+	}
+	if err := bucket.JoinRoom(c, "lobby", ""); !errors.Is(err, types.ErrRoomLimitExceeded) {
+		t.Fatalf("join beyond the limit: got %v, want %v", err, types.ErrRoomLimitExceeded)
+	}
+	_ = bucket.JoinRoom(other, "stream", "")
+
+	// Leaving one room keeps the client in the other.
+	if !bucket.LeaveRoom(c, "staff") || bucket.InRoom(c, "staff") || !bucket.InRoom(c, "stream") {
+		t.Fatal("leaving staff changed the membership of stream")
+	}
+	if _, ok := bucket.GetRoom("staff"); ok {
+		t.Fatal("empty room was not dropped")
+	}
+	_ = bucket.JoinRoom(c, "lobby", "")
+
+	bucket.DelClient(c)
+	if n := bucket.RoomCount(c); n != 0 {
+		t.Fatalf("client left in %d rooms after disconnecting", n)
+	}
+	if _, ok := bucket.GetRoom("lobby"); ok {
+		t.Fatal("empty room was not dropped")
+	}
+	room, ok := bucket.GetRoom("stream")
+	if !ok || room.GetOnlineCount() != 1 || room.GetClients()[0] != other {
+		t.Fatal("disconnecting changed the other members of stream")
+	}
+}
+
+func TestRoomLimit(t *testing.T) {
+	srv, wsSrv := startGatewayWith(t, &fakeMessageService{}, &fakeAuthService{},
+		WithBucketConfig(&BucketConfig{ClientRoomLimit: 1}))
+	conn := dialGateway(t, srv, "1001")
+	waitConns(t, wsSrv, "1001", 1)
+
+	if resp := roomReq(t, conn, "1001", types.WsJoinRoom, &gatewayv1.JoinRoomReq{RoomID: "stream"}); resp.ErrCode != 0 {
+		t.Fatalf("join room: %d %s", resp.ErrCode, resp.ErrMsg)
+	}
+	resp := roomReq(t, conn, "1001", types.WsJoinRoom, &gatewayv1.JoinRoomReq{RoomID: "staff"})
+	if resp.ErrCode != errno.ErrRoomLimitCode {
+		t.Fatalf("join beyond the limit: got errCode %d, want %d", resp.ErrCode, errno.ErrRoomLimitCode)
+	}
+}
@@ -0,0 +1,272 @@
+package ws
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/crazyfrankie/goim/interfaces/ws/encoding"
+	"github.com/crazyfrankie/goim/interfaces/ws/types"
+	"github.com/crazyfrankie/goim/pkg/logs"
+	"github.com/crazyfrankie/goim/pkg/safego"
+	gatewayv1 "github.com/crazyfrankie/goim/protocol/gateway/v1"
+	messagev1 "github.com/crazyfrankie/goim/protocol/message/v1"
+	"github.com/crazyfrankie/goim/types/consts"
+)
+
+const (
+	// How long a session outlives its connection waiting to be resumed
+	defaultResumeGrace = 2 * time.Minute
+	// How many pushes a session keeps for replay, they are queued at once on
+	// resume so it stays below the send queue size
+	defaultReplaySize = 64
+	// How often sessions past their grace window are dropped
+	sessionSweepInterval = 10 * time.Second
+)
+
+// session outlives a connection for the resume grace window. It numbers the
+// WSPushMsg frames sent over it and keeps the last ones, so a client
+// reconnecting to this node gets what it missed replayed before live traffic.
+type session struct {
+	token      string
+	userID     string
+	platformID int32
+	connID     string
+	encoding   string
+	// encoder encodes the Req/Resp envelope, dataEncoder the payload it carries
+	encoder     encoding.Encoder
+	dataEncoder encoding.Encoder
+
+	mu         sync.Mutex
+	client     *Client
+	detachedAt time.Time
+	seq        int64
+	replay     *Ring
+}
+
+// pushMessage numbers and keeps a push for the session, sending it to the
+// attached client if any. Typing frames are stale by the time of a resume
+// and are not kept.
+func (s *session) pushMessage(msgData *messagev1.Message) error {
+	if msgData.GetContentType() == consts.TypingMessageType {
+		return nil
+	}
+	resp, err := newPushResp(s.dataEncoder, msgData)
+	if err != nil {
+		return err
+	}
+
+	return s.push(nil, resp)
+}
+
+// push numbers resp, keeps it for replay and sends it to the attached client.
+// A push from a client the session has moved away from is refused.
+func (s *session) push(from *Client, resp *Resp) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if from != nil && s.client != from {
+		return types.ErrClientClosed
+	}
+
+	resp.PushSeq = s.seq + 1
+	data, err := s.encoder.Encode(resp)
+	if err != nil {
+		return err
+	}
+	s.seq++
+	if s.replay.Len() == s.replay.Cap() {
+		s.replay.GetAdv()
+	}
+	slot, _ := s.replay.Set()
+	*slot = data
+	s.replay.SetAdv()
+
+	if s.client == nil {
+		return nil
+	}
+	return s.client.send(data, SendClassChat)
+}
+
+// attach hands the session over to c and queues the resume tips, followed by
+// the pushes after the client's lastSeq when resuming. The caller holds s.mu.
+func (s *session) attach(c *Client, resume bool) {
+	if old := s.client; old != nil && old != c {
+		// The old connection has not noticed the network went away yet.
+		safego.Go(context.Background(), func() { _ = old.close() })
+	}
+	s.client = c
+	s.detachedAt = time.Time{}
+	s.connID = c.ConnID
+
+	var replay [][]byte
+	lastSeq := c.ctx.GetLastSeq()
+	// The pushes after lastSeq are all kept when it is not older than the
+	// seq before the oldest kept one.
+	resumed := resume && lastSeq >= s.seq-int64(s.replay.Len()) && lastSeq <= s.seq
+	if resumed {
+		for i := s.replay.Len() - int(s.seq-lastSeq); i < s.replay.Len(); i++ {
+			frame, _ := s.replay.Peek(i)
+			replay = append(replay, *frame)
+		}
+	}
+
+	data, err := s.dataEncoder.Encode(&gatewayv1.SessionResumeTips{ResumeToken: s.token, Resumed: resumed})
+	if err == nil {
+		err = c.sendResp(&Resp{ReqIdentifier: types.WsSessionResume, Data: data}, SendClassReply)
+	}
+	if err != nil {
+		logs.Warnf("send session resume tips failed, userID: %s, err: %v", s.userID, err)
+		return
+	}
+	for _, frame := range replay {
+		if err := c.send(frame, SendClassChat); err != nil {
+			logs.Warnf("replay session pushes failed, userID: %s, err: %v", s.userID, err)
+			return
+		}
+	}
+	logs.Debugf("session attached, userID: %s, resumed: %v, replayed: %d", s.userID, resumed, len(replay))
+}
+
+// detach leaves the session waiting for a resume if c still holds it.
+func (s *session) detach(c *Client) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.client == c {
+		s.client = nil
+		s.detachedAt = time.Now()
+	}
+}
+
+// detached reports whether the session waits for a resume.
+func (s *session) detached() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.client == nil
+}
+
+type sessionStore struct {
+	grace      time.Duration
+	replaySize int
+
+	mu       sync.Mutex
+	sessions map[string]*session
+	users    map[string]map[*session]struct{}
+}
+
+func newSessionStore(grace time.Duration, replaySize int) *sessionStore {
+	return &sessionStore{
+		grace:      grace,
+		replaySize: replaySize,
+		sessions:   make(map[string]*session),
+		users:      make(map[string]map[*session]struct{}),
+	}
+}
+
+// open returns the session c asks to resume, or a new one. It reports whether
+// c resumes, a session is only handed to the user, platform and encoding it
+// was opened with.
+func (st *sessionStore) open(c *Client) (*session, bool) {
+	encodingName := c.ctx.GetEncoding()
+
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	if s, ok := st.sessions[c.ctx.GetResumeToken()]; ok &&
+		s.userID == c.UserID && s.platformID == c.PlatformID && s.encoding == encodingName {
+		return s, true
+	}
+
+	token := make([]byte, 16)
+	_, _ = rand.Read(token)
+	s := &session{
+		token:      hex.EncodeToString(token),
+		userID:     c.UserID,
+		platformID: c.PlatformID,
+		connID:     c.ConnID,
+		encoding:   encodingName,
+		replay:     NewRing(st.replaySize),
+	}
+	s.encoder, s.dataEncoder = newEncoders(encodingName)
+	st.sessions[s.token] = s
+	if st.users[s.userID] == nil {
+		st.users[s.userID] = make(map[*session]struct{})
+	}
+	st.users[s.userID][s] = struct{}{}
+
+	return s, false
+}
+
+// detached returns the sessions of userID waiting for a resume.
+func (st *sessionStore) detached(userID string) []*session {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	var res []*session
+	for s := range st.users[userID] {
+		if s.detached() {
+			res = append(res, s)
+		}
+	}
+	return res
+}
+
+// sweep drops the sessions detached for longer than the grace window and
+// returns their users.
+func (st *sessionStore) sweep(now time.Time) []string {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	var userIDs []string
+	for token, s := range st.sessions {
+		s.mu.Lock()
+		expired := s.client == nil && now.Sub(s.detachedAt) > st.grace
+		s.mu.Unlock()
+		if !expired {
+			continue
+		}
+
+		delete(st.sessions, token)
+		delete(st.users[s.userID], s)
+		if len(st.users[s.userID]) == 0 {
+			delete(st.users, s.userID)
+		}
+		userIDs = append(userIDs, s.userID)
+	}
+	return userIDs
+}
+
+// detachedSessions returns the sessions of userID waiting for a resume on
+// this node, pushes to the user are kept for them.
+func (ws *WebsocketServer) detachedSessions(userID string) []*session {
+	if ws.sessions == nil {
+		return nil
+	}
+	return ws.sessions.detached(userID)
+}
+
+// sweepSessions drops the sessions past their grace window and withdraws
+// their routes.
+func (ws *WebsocketServer) sweepSessions(now time.Time) {
+	if ws.sessions == nil {
+		return
+	}
+	userIDs := ws.sessions.sweep(now)
+	if len(userIDs) == 0 {
+		return
+	}
+
+	status := make([]*UserOnlineStatus, 0, len(userIDs))
+	for _, userID := range userIDs {
+		status = append(status, &UserOnlineStatus{UserID: userID})
+	}
+	safego.Go(context.Background(), func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		ws.saveRoutes(ctx, status)
+	})
+}
@@ -0,0 +1,144 @@
+package ws
+
+import (
+	"context"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/crazyfrankie/goim/interfaces/ws/types"
+	"github.com/crazyfrankie/goim/pkg/sonic"
+	gatewayv1 "github.com/crazyfrankie/goim/protocol/gateway/v1"
+	messagev1 "github.com/crazyfrankie/goim/protocol/message/v1"
+	"github.com/crazyfrankie/goim/types/consts"
+)
+
+func dialSession(t *testing.T, srv *httptest.Server, resumeToken string, lastSeq int64) (*websocket.Conn, *gatewayv1.SessionResumeTips) {
+	t.Helper()
+
+	addr := platformGatewayURL(srv, "uid:1002", "1002", consts.WebPlatformID)
+	if resumeToken != "" {
+		addr += "&" + url.Values{
+			types.Resume:  {resumeToken},
+			types.LastSeq: {strconv.FormatInt(lastSeq, 10)},
+		}.Encode()
+	}
+	conn, _, err := websocket.DefaultDialer.Dial(addr, nil)
+	if err != nil {
+		t.Fatalf("dial gateway: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	_, raw, err := conn.ReadMessage()
+	if err != nil {
+		t.Fatalf("read resume tips: %v", err)
+	}
+	var frame Resp
+	if err := sonic.Unmarshal(raw, &frame); err != nil {
+		t.Fatalf("unmarshal frame: %v", err)
+	}
+	if frame.ReqIdentifier != types.WsSessionResume {
+		t.Fatalf("got reqIdentifier %d, want %d", frame.ReqIdentifier, types.WsSessionResume)
+	}
+	var tips gatewayv1.SessionResumeTips
+	if err := sonic.Unmarshal(frame.Data, &tips); err != nil {
+		t.Fatalf("unmarshal resume tips: %v", err)
+	}
+
+	return conn, &tips
+}
+
+func pushSeqs(t *testing.T, hub *Server, seqs ...int64) {
+	t.Helper()
+
+	for _, seq := range seqs {
+		_, err := hub.SuperGroupOnlineBatchPushOneMsg(context.Background(), &gatewayv1.OnlineBatchPushOneMsgRequest{
+			PushToUserIDs: []string{"1002"},
+			MsgData: &messagev1.Message{
+				SendID:         1001,
+				RecvID:         1002,
+				SessionType:    consts.SingleChatType,
+				ContentType:    consts.TextMessageType,
+				Seq:            seq,
+				ConversationID: "si_1001_1002",
+			},
+		})
+		if err != nil {
+			t.Fatalf("push: %v", err)
+		}
+	}
+}
+
+// expectPush reads a push frame and checks its push seq and message seq.
+func expectPush(t *testing.T, conn *websocket.Conn, pushSeq, seq int64) {
+	t.Helper()
+
+	frame := readFrame(t, conn)
+	if frame.ReqIdentifier != types.WSPushMsg || frame.PushSeq != pushSeq {
+		t.Fatalf("got reqIdentifier %d pushSeq %d, want push %d", frame.ReqIdentifier, frame.PushSeq, pushSeq)
+	}
+	var pushed gatewayv1.PushMessages
+	if err := sonic.Unmarshal(frame.Data, &pushed); err != nil {
+		t.Fatalf("unmarshal push payload: %v", err)
+	}
+	if msgs := pushed.GetMsgs()["si_1001_1002"].GetMsgs(); len(msgs) != 1 || msgs[0].GetSeq() != seq {
+		t.Fatalf("unexpected push payload: %+v", &pushed)
+	}
+}
+
+func TestSessionResumeReplaysMissedPushes(t *testing.T) {
+	srv, wsSrv := startGateway(t, &fakeMessageService{})
+	hub := NewServer(wsSrv, nil)
+
+	conn, tips := dialSession(t, srv, "", 0)
+	if tips.GetResumeToken() == "" || tips.GetResumed() {
+		t.Fatalf("unexpected tips of a new session: %+v", tips)
+	}
+	waitConns(t, wsSrv, "1002", 1)
+	pushSeqs(t, hub, 7)
+	expectPush(t, conn, 1, 7)
+
+	_ = conn.Close()
+	waitConns(t, wsSrv, "1002", 0)
+	pushSeqs(t, hub, 8, 9)
+
+	conn, resumed := dialSession(t, srv, tips.GetResumeToken(), 1)
+	if resumed.GetResumeToken() != tips.GetResumeToken() || !resumed.GetResumed() {
+		t.Fatalf("unexpected tips of a resumed session: %+v", resumed)
+	}
+	expectPush(t, conn, 2, 8)
+	expectPush(t, conn, 3, 9)
+
+	// Live traffic continues the numbering.
+	waitConns(t, wsSrv, "1002", 1)
+	pushSeqs(t, hub, 10)
+	expectPush(t, conn, 4, 10)
+}
+
+func TestSessionResumeBeyondReplayBuffer(t *testing.T) {
+	srv, wsSrv := startGatewayWith(t, &fakeMessageService{}, &fakeAuthService{}, WithSessionResume(time.Minute, 2))
+	hub := NewServer(wsSrv, nil)
+
+	conn, tips := dialSession(t, srv, "", 0)
+	waitConns(t, wsSrv, "1002", 1)
+	_ = conn.Close()
+	waitConns(t, wsSrv, "1002", 0)
+	pushSeqs(t, hub, 7, 8, 9)
+
+	// The first push is no longer kept, the client has to pull by seq.
+	_, resumed := dialSession(t, srv, tips.GetResumeToken(), 0)
+	if resumed.GetResumed() {
+		t.Fatal("resumed although pushes were dropped from the replay buffer")
+	}
+
+	// An unknown token opens a new session.
+	_, unknown := dialSession(t, srv, "unknown", 3)
+	if unknown.GetResumed() || unknown.GetResumeToken() == tips.GetResumeToken() {
+		t.Fatalf("unexpected tips for an unknown token: %+v", unknown)
+	}
+}
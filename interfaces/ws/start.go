@@ -0,0 +1,66 @@
+package ws
+
+import (
+	"context"
+
+	"github.com/oklog/run"
+	"google.golang.org/grpc"
+
+	"github.com/crazyfrankie/goim/infra/contract/discovery"
+	"github.com/crazyfrankie/goim/pkg/grpc/startrpc"
+	"github.com/crazyfrankie/goim/types/consts"
+)
+
+// onlineStatusConcurrent is the number of workers persisting online state changes.
+const onlineStatusConcurrent = 4
+
+// Start boots the long connection server, the hub gRPC service and the online
+// status loop, and blocks until one of them exits or ctx is done.
+func Start(ctx context.Context, listenIP, registerIP, listenPort string, wsOpts []Option, rpcOpts ...grpc.ServerOption) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	longServer := NewWebsocketServer(wsOpts...)
+
+	// The long connection server only accepts clients once its gRPC clients
+	// have been resolved through discovery.
+	ready := make(chan struct{})
+	hubServer := NewServer(longServer, func(ctx context.Context, client discovery.SvcDiscoveryRegistry) error {
+		if err := longServer.SetDiscoveryRegistry(ctx, client); err != nil {
+			return err
+		}
+		close(ready)
+		return nil
+	})
+
+	g := &run.Group{}
+
+	// Hub RPC server, registered in discovery as the gateway service
+	g.Add(func() error {
+		return startrpc.Start(ctx, listenIP, registerIP, listenPort, consts.MsgGatewayServiceName, hubServer.InitServer, rpcOpts...)
+	}, func(err error) {
+		cancel()
+	})
+
+	// Websocket server
+	g.Add(func() error {
+		select {
+		case <-ready:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		return longServer.Run(ctx)
+	}, func(err error) {
+		cancel()
+	})
+
+	// Online status loop
+	g.Add(func() error {
+		longServer.ChangeOnlineStatus(ctx, onlineStatusConcurrent)
+		return ctx.Err()
+	}, func(err error) {
+		cancel()
+	})
+
+	return g.Run()
+}
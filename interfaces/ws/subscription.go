@@ -5,7 +5,7 @@ import (
 	"sync"
 
 	"github.com/crazyfrankie/goim/pkg/logs"
-	"github.com/crazyfrankie/goim/pkg/sonic"
+	gatewayv1 "github.com/crazyfrankie/goim/protocol/gateway/v1"
 )
 
 func (ws *WebsocketServer) subscriberUserOnlineStatusChanges(ctx context.Context, userID string, platformIDs []int32) {
@@ -16,27 +16,27 @@ func (ws *WebsocketServer) subscriberUserOnlineStatusChanges(ctx context.Context
 }
 
 func (ws *WebsocketServer) SubUserOnlineStatus(ctx context.Context, client *Client, data *Req) ([]byte, error) {
-	var sub SubUserOnlineStatus
-	if err := sonic.Unmarshal(data.Data, &sub); err != nil {
+	var sub gatewayv1.SubUserOnlineStatus
+	if err := decodeData(ctx, data, &sub); err != nil {
 		return nil, err
 	}
 
 	ws.subscription.Sub(client, sub.SubscribeUserID, sub.UnsubscribeUserID)
 
-	var resp SubUserOnlineStatusTips
+	var resp gatewayv1.SubUserOnlineStatusTips
 	if len(sub.SubscribeUserID) > 0 {
-		resp.Subscribers = make([]*SubUserOnlineStatusElem, 0, len(sub.SubscribeUserID))
+		resp.Subscribers = make([]*gatewayv1.SubUserOnlineStatusElem, 0, len(sub.SubscribeUserID))
 		for _, userID := range sub.SubscribeUserID {
 			// 获取用户在线平台信息
 			platformIDs := ws.getUserOnlinePlatforms(userID)
-			resp.Subscribers = append(resp.Subscribers, &SubUserOnlineStatusElem{
+			resp.Subscribers = append(resp.Subscribers, &gatewayv1.SubUserOnlineStatusElem{
 				UserID:            userID,
 				OnlinePlatformIDs: platformIDs,
 			})
 		}
 	}
 
-	return sonic.Marshal(&resp)
+	return encodeData(ctx, &resp)
 }
 
 // getUserOnlinePlatforms 获取用户在线平台列表
@@ -183,35 +183,14 @@ func (ws *WebsocketServer) pushUserIDOnlineStatus(ctx context.Context, userID st
 		return
 	}
 
-	onlineStatus := &SubUserOnlineStatusTips{
-		Subscribers: []*SubUserOnlineStatusElem{{UserID: userID, OnlinePlatformIDs: platformIDs}},
-	}
-
-	statusData, err := sonic.Marshal(onlineStatus)
-	if err != nil {
-		logs.Errorf("pushUserIDOnlineStatus json.Marshal failed: %v", err)
-		return
+	onlineStatus := &gatewayv1.SubUserOnlineStatusTips{
+		Subscribers: []*gatewayv1.SubUserOnlineStatusElem{{UserID: userID, OnlinePlatformIDs: platformIDs}},
 	}
 
 	for _, client := range clients {
-		if err := client.PushUserOnlineStatus(statusData); err != nil {
+		if err := client.PushUserOnlineStatus(onlineStatus); err != nil {
 			logs.Errorf("UserSubscribeOnlineStatusNotification push failed: %v, userID: %s, platformID: %d, changeUserID: %s, changePlatformID: %v",
 				err, client.UserID, client.PlatformID, userID, platformIDs)
 		}
 	}
 }
-
-// 订阅相关的数据结构
-type SubUserOnlineStatus struct {
-	SubscribeUserID   []string `json:"subscribeUserID"`
-	UnsubscribeUserID []string `json:"unsubscribeUserID"`
-}
-
-type SubUserOnlineStatusTips struct {
-	Subscribers []*SubUserOnlineStatusElem `json:"subscribers"`
-}
-
-type SubUserOnlineStatusElem struct {
-	UserID            string  `json:"userID"`
-	OnlinePlatformIDs []int32 `json:"onlinePlatformIDs"`
-}
@@ -13,6 +13,10 @@ const (
 	BackgroundStatus        = "isBackground"
 	SendResponse            = "isMsgResp"
 	SDKType                 = "sdkType"
+	Encoding                = "encoding"
+	Resume                  = "resume"
+	LastSeq                 = "lastSeq"
+	MsgAck                  = "msgAck"
 )
 
 const (
@@ -20,6 +24,14 @@ const (
 	JsSDK = "js"
 )
 
+// Wire encodings of the frames, chosen with the encoding handshake param.
+// Without it Go SDKs use gob and the others JSON.
+const (
+	JSONEncoding     = "json"
+	GobEncoding      = "gob"
+	ProtobufEncoding = "protobuf"
+)
+
 const (
 	WSGetNewestSeq        = 1001
 	WSPullMsgBySeqList    = 1002
@@ -28,10 +40,26 @@ const (
 	WSPullMsg             = 1005
 	WSGetConvMaxReadSeq   = 1006
 	WsPullConvLastMessage = 1007
+	WsMsgAck              = 1008
+	WsJoinRoom            = 1009
+	WsLeaveRoom           = 1010
+	WsSendRoomMsg         = 1011
 	WSPushMsg             = 2001
 	WSKickOnlineMsg       = 2002
 	WsLogoutMsg           = 2003
 	WsSetBackgroundStatus = 2004
 	WsSubUserOnlineStatus = 2005
+	WsSessionResume       = 2006
+	WsRoomMsg             = 2007
 	WSDataError           = 3001
 )
+
+// Close codes the server closes connections with.
+const (
+	// CloseRateLimited closes connections that kept exceeding their request
+	// rate limits
+	CloseRateLimited = 4029
+	// CloseSlowConsumer closes connections whose send queue overflowed, the
+	// client has to pull what it missed by seq
+	CloseSlowConsumer = 4030
+)
@@ -41,6 +41,7 @@ var (
 	ErrRateLimitExceeded      = errors.New("rate limit exceeded")
 	ErrUnauthorized           = errors.New("unauthorized")
 	ErrClientNotFound         = errors.New("client not found")
+	ErrRoomLimitExceeded      = errors.New("room limit exceeded")
 )
 
 // DefaultServerConfig Default Server Configuration
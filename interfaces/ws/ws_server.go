@@ -2,18 +2,33 @@ package ws
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/netip"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"github.com/crazyfrankie/goim/infra/contract/discovery"
+	"github.com/crazyfrankie/goim/infra/contract/token"
 	"github.com/crazyfrankie/goim/interfaces/ws/compressor"
 	wsctx "github.com/crazyfrankie/goim/interfaces/ws/context"
+	"github.com/crazyfrankie/goim/internal/route"
+	"github.com/crazyfrankie/goim/pkg/errorx"
+	"github.com/crazyfrankie/goim/pkg/lang/conv"
 	"github.com/crazyfrankie/goim/pkg/logs"
+	authv1 "github.com/crazyfrankie/goim/protocol/auth/v1"
+	gatewayv1 "github.com/crazyfrankie/goim/protocol/gateway/v1"
+	"github.com/crazyfrankie/goim/types/consts"
+	"github.com/crazyfrankie/goim/types/errno"
 	"github.com/go-playground/validator/v10"
+	"google.golang.org/grpc/status"
 )
 
+// authTimeout bounds the token verification done during the handshake.
+const authTimeout = 5 * time.Second
+
 type LongConnServer interface {
 	Run(ctx context.Context) error
 	wsHandler(w http.ResponseWriter, r *http.Request)
@@ -24,6 +39,13 @@ type LongConnServer interface {
 	UnRegister(c *Client)
 	SetKickHandlerInfo(i *kickHandler)
 	SubUserOnlineStatus(ctx context.Context, client *Client, data *Req) ([]byte, error)
+	MsgAck(ctx context.Context, client *Client, data *Req) ([]byte, error)
+	JoinRoom(ctx context.Context, client *Client, data *Req) ([]byte, error)
+	LeaveRoom(ctx context.Context, client *Client, data *Req) ([]byte, error)
+	SendRoomMessage(ctx context.Context, client *Client, data *Req) ([]byte, error)
+	BroadcastRoomMessage(ctx context.Context, msg *gatewayv1.RoomMessage, forward bool)
+	detachedSessions(userID string) []*session
+	RateLimit(client *Client, reqIdentifier int32) error
 	compressor.Compressor
 	MessageHandler
 }
@@ -42,14 +64,26 @@ type WebsocketServer struct {
 	handshakeTimeout  time.Duration
 	writeBufferSize   int
 	validate          *validator.Validate
+	authClient        authv1.AuthServiceClient
+	nodeID            string
+	routeTable        *route.Table
+	multiLoginPolicy  int
+	rateLimiter       *rateLimiter
+	backpressure      *backpressure
+	sessions          *sessionStore
+	ackTimeout        time.Duration
+	ackRetries        int
+	roomAuthorizer    RoomAuthorizer
+	trustedProxies    []netip.Prefix
+	discovery         discovery.SvcDiscoveryRegistry
 	compressor.Compressor
 	MessageHandler
 }
 
+// kickHandler asks this node to apply the login policy for a login made on
+// another node.
 type kickHandler struct {
-	clientOK   bool
-	oldClients []*Client
-	newClient  *Client
+	newClient *Client
 }
 
 func (ws *WebsocketServer) UnRegister(c *Client) {
@@ -90,12 +124,57 @@ func NewWebsocketServer(opts ...Option) *WebsocketServer {
 		o(&config)
 	}
 
+	if config.bucketNum <= 0 {
+		config.bucketNum = 32
+	}
+	if config.bucketConfig == nil {
+		config.bucketConfig = DefaultBucketConfig()
+	}
+	if config.multiLoginPolicy == 0 {
+		config.multiLoginPolicy = consts.AllowAllLogin
+	}
+	if config.rateLimit == nil {
+		config.rateLimit = DefaultRateLimitConfig()
+	}
+	if config.backpressure == nil {
+		config.backpressure = DefaultBackpressureConfig()
+	}
+	if config.resumeGrace == 0 {
+		config.resumeGrace = defaultResumeGrace
+	}
+	if config.replaySize <= 0 {
+		config.replaySize = defaultReplaySize
+	}
+	if config.ackTimeout <= 0 {
+		config.ackTimeout = defaultAckTimeout
+	}
+	if config.ackRetries <= 0 {
+		config.ackRetries = defaultAckRetries
+	}
+	if config.roomAuthorizer == nil {
+		config.roomAuthorizer = allowAllRooms{}
+	}
+	var sessions *sessionStore
+	if config.resumeGrace > 0 {
+		sessions = newSessionStore(config.resumeGrace, config.replaySize)
+	}
+
 	v := validator.New()
 	return &WebsocketServer{
 		port:             config.port,
 		wsMaxConnNum:     config.maxConnNum,
 		writeBufferSize:  config.writeBufferSize,
 		handshakeTimeout: config.handshakeTimeout,
+		nodeID:           config.nodeID,
+		routeTable:       config.routeTable,
+		multiLoginPolicy: config.multiLoginPolicy,
+		rateLimiter:      newRateLimiter(config.rateLimit),
+		backpressure:     newBackpressure(config.backpressure),
+		sessions:         sessions,
+		ackTimeout:       config.ackTimeout,
+		ackRetries:       config.ackRetries,
+		roomAuthorizer:   config.roomAuthorizer,
+		trustedProxies:   config.trustedProxies,
 		clientPool: sync.Pool{
 			New: func() any {
 				return new(Client)
@@ -105,35 +184,59 @@ func NewWebsocketServer(opts ...Option) *WebsocketServer {
 		unregisterChan:  make(chan *Client, 1000),
 		kickHandlerChan: make(chan *kickHandler, 1000),
 		validate:        v,
-		bucketManager:   NewBucketManager(32, DefaultBucketConfig()), // 使用BucketManager
+		bucketManager:   NewBucketManager(config.bucketNum, config.bucketConfig),
 		subscription:    newSubscription(),
 		Compressor:      compressor.NewCompressor(),
 	}
 }
 
+// SetDiscoveryRegistry wires the gRPC clients used to serve gateway requests.
+func (ws *WebsocketServer) SetDiscoveryRegistry(ctx context.Context, client discovery.SvcDiscoveryRegistry) error {
+	handler, err := NewGrpcHandler(ctx, ws.validate, client)
+	if err != nil {
+		return err
+	}
+	ws.MessageHandler = handler
+
+	authConn, err := client.GetConn(ctx, consts.AuthServiceName)
+	if err != nil {
+		return err
+	}
+	ws.authClient = authv1.NewAuthServiceClient(authConn)
+	ws.discovery = client
+
+	return nil
+}
+
 func (ws *WebsocketServer) Run(ctx context.Context) error {
 	var client *Client
 
 	ctx, cancel := context.WithCancelCause(ctx)
 	go func() {
+		sweepTicker := time.NewTicker(sessionSweepInterval)
+		defer sweepTicker.Stop()
+
 		for {
 			select {
 			case <-ctx.Done():
 				return
+			case now := <-sweepTicker.C:
+				ws.sweepSessions(now)
 			case client = <-ws.registerChan:
 				ws.registerClient(client)
 			case client = <-ws.unregisterChan:
 				ws.unregisterClient(client)
 			case onlineInfo := <-ws.kickHandlerChan:
-				ws.multiTerminalLoginChecker(onlineInfo.clientOK, onlineInfo.oldClients, onlineInfo.newClient)
+				ws.multiTerminalLoginChecker(onlineInfo.newClient)
 			}
 		}
 	}()
 
 	done := make(chan struct{})
 	go func() {
-		wsSrv := http.Server{Addr: fmt.Sprintf(":%d", ws.port), Handler: nil}
-		http.HandleFunc("/", ws.wsHandler)
+		mux := http.NewServeMux()
+		mux.HandleFunc("/", ws.wsHandler)
+		wsSrv := http.Server{Addr: fmt.Sprintf(":%d", ws.port), Handler: mux}
 		go func() {
 			defer close(done)
 			<-ctx.Done()
@@ -164,8 +267,26 @@ func (ws *WebsocketServer) SetKickHandlerInfo(i *kickHandler) {
 }
 
 func (ws *WebsocketServer) registerClient(client *Client) {
+	var (
+		sess   *session
+		resume bool
+	)
+	if ws.sessions != nil {
+		sess, resume = ws.sessions.open(client)
+		client.session = sess
+		// Pushes wait on the session while the client is added, so none
+		// slips in between the replay and the live traffic.
+		sess.mu.Lock()
+	}
+
 	bucket := ws.bucketManager.GetBucket(client.UserID)
 	err := bucket.PutClient(client)
+	if sess != nil {
+		if err == nil {
+			sess.attach(client, resume)
+		}
+		sess.mu.Unlock()
+	}
 	if err != nil {
 		logs.Errorf("register client failed: %v", err)
 		return
@@ -174,6 +295,9 @@ func (ws *WebsocketServer) registerClient(client *Client) {
 	ws.onlineUserConnNum.Add(1)
 	logs.Debugf("user online, userID: %s, platformID: %d, online user conn Num: %d",
 		client.UserID, client.PlatformID, ws.onlineUserConnNum.Load())
+
+	ws.multiTerminalLoginChecker(client)
+	ws.remoteMultiTerminalLoginCheck(client)
 }
 
 func (ws *WebsocketServer) KickUserConn(client *Client) error {
@@ -197,7 +321,17 @@ func (ws *WebsocketServer) wsHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	logs.Debugf("new conn, token: %s", connContext.GetToken())
+	if err := ws.authenticate(r.Context(), connContext); err != nil {
+		statusCode := http.StatusUnauthorized
+		var customErr errorx.StatusError
+		if !errors.As(err, &customErr) {
+			statusCode = http.StatusInternalServerError
+		}
+		httpErrorWithStatus(connContext, err, statusCode)
+		return
+	}
+
+	logs.Debugf("new conn, userID: %s", connContext.GetUserID())
 
 	wsLongConn := newWebSocketConn(ws.handshakeTimeout, ws.writeBufferSize)
 	if err := wsLongConn.GenerateConn(w, r); err != nil {
@@ -207,33 +341,60 @@ func (ws *WebsocketServer) wsHandler(w http.ResponseWriter, r *http.Request) {
 
 	client := ws.clientPool.Get().(*Client)
 	client.Reset(connContext, wsLongConn, ws)
+	client.ip = clientIP(r, ws.trustedProxies)
+	client.backpressure = ws.backpressure
+	if connContext.GetMsgAck() {
+		client.acks = newAckTracker(ws.ackTimeout, ws.ackRetries)
+	}
 
 	ws.registerChan <- client
 	go client.Start()
 }
 
-func (ws *WebsocketServer) multiTerminalLoginChecker(clientOK bool, oldClients []*Client, newClient *Client) {
-	//// 多终端登录检查逻辑，基本保持与open-im-server一致
-	//// 这里简化实现，实际应该根据配置策略处理
-	//if clientOK {
-	//	for _, c := range oldClients {
-	//		if c.token == newClient.token {
-	//			continue // 相同token不踢出
-	//		}
-	//
-	//		// 踢出旧连接
-	//		bucket := ws.bucketManager.GetBucket(c.UserID)
-	//		bucket.DelClient(c)
-	//		err := c.KickOnlineMessage()
-	//		if err != nil {
-	//			logs.Warnf("KickOnlineMessage failed: %v", err)
-	//		}
-	//	}
-	//}
+// authenticate verifies the connection token against the auth service and
+// pins the verified uid on connCtx. Access and refresh tokens are refused.
+func (ws *WebsocketServer) authenticate(ctx context.Context, connCtx *wsctx.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, authTimeout)
+	defer cancel()
+
+	resp, err := ws.authClient.ParseToken(ctx, &authv1.ParseTokenRequest{
+		Token:     connCtx.GetToken(),
+		TokenType: token.TypeConn,
+	})
+	if err != nil {
+		switch int32(status.Code(err)) {
+		case errno.ErrAuthTokenExpiredCode:
+			return errorx.New(errno.ErrConnTokenExpiredCode)
+		case errno.ErrAuthTokenRevokedCode:
+			return errorx.New(errno.ErrConnTokenRevokedCode)
+		case errno.ErrAuthTokenInvalidCode:
+			return errorx.New(errno.ErrConnTokenInvalidCode)
+		default:
+			return errorx.Wrapf(err, "parse conn token failed")
+		}
+	}
+
+	userID := conv.Int64ToStr(resp.GetUserID())
+	if sendID := connCtx.GetUserID(); sendID != userID {
+		return errorx.New(errno.ErrConnTokenMismatchCode, errorx.KV("send_id", sendID))
+	}
+	connCtx.SetUserID(userID)
+
+	return nil
 }
 
 func (ws *WebsocketServer) unregisterClient(client *Client) {
-	defer ws.clientPool.Put(client)
+	// Only recycle the client once its read and write loops have returned,
+	// the close reason is settled by then too, a connection closed by the
+	// server gets here before its read loop notices.
+	defer func() {
+		go func() {
+			client.wg.Wait()
+			logs.Debugf("user offline, userID: %s, close reason: %v, online user conn Num: %d",
+				client.UserID, client.closedErr, ws.onlineUserConnNum.Load())
+			ws.clientPool.Put(client)
+		}()
+	}()
 
 	// 从BucketManager中移除客户端
 	bucket := ws.bucketManager.GetBucket(client.UserID)
@@ -241,9 +402,13 @@ func (ws *WebsocketServer) unregisterClient(client *Client) {
 
 	ws.onlineUserConnNum.Add(-1)
 	ws.subscription.DelClient(client)
+	if client.session != nil {
+		client.session.detach(client)
+	}
 
-	logs.Debugf("user offline, userID: %s, close reason: %v, online user conn Num: %d",
-		client.UserID, client.closedErr, ws.onlineUserConnNum.Load())
+	_, userOnline := ws.GetUserAllCons(client.UserID)
+	ip := client.IP()
+	ws.rateLimiter.forget(client.UserID, userOnline, ip, ws.bucketManager.IPConnCount(ip))
 }
 
 func getRemoteAdders(client []*Client) string {
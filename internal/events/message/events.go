@@ -1,12 +1,17 @@
 package message
 
+import messagev1 "github.com/crazyfrankie/goim/protocol/message/v1"
+
 type MessageEvent struct {
-	EventType   EventType  `json:"event_type"`
-	MessageID   int64      `json:"message_id"`
-	UserID      int64      `json:"user_id"`
-	Content     string     `json:"content"`
-	TimestampMS int64      `json:"timestamp_ms"`
-	Meta        *EventMeta `json:"meta,omitempty"`
+	EventType      EventType          `json:"event_type"`
+	MessageID      int64              `json:"message_id"`
+	UserID         int64              `json:"user_id"`
+	Content        string             `json:"content"`
+	TimestampMS    int64              `json:"timestamp_ms"`
+	ConversationID string             `json:"conversation_id,omitempty"`
+	PushToUserIDs  []int64            `json:"push_to_user_ids,omitempty"` // Users the event is delivered to
+	Msg            *messagev1.Message `json:"msg,omitempty"`
+	Meta           *EventMeta         `json:"meta,omitempty"`
 }
 
 type EventType int
@@ -15,6 +20,10 @@ const (
 	MessageSent EventType = iota + 1
 	MessageRead
 	MessageDeleted
+	MessageSending
+	MessageDelivered
+	MessageFailed
+	MessageRevoked
 )
 
 type EventMeta struct {
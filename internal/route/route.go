@@ -0,0 +1,114 @@
+// Package route keeps the cluster wide table of which gateway nodes hold a
+// user's long connections, so pushes only go to the nodes that can deliver.
+package route
+
+import (
+	"context"
+	"time"
+
+	"github.com/crazyfrankie/goim/infra/contract/cache"
+	langslice "github.com/crazyfrankie/goim/pkg/lang/slice"
+	"github.com/crazyfrankie/goim/pkg/logs"
+	"github.com/crazyfrankie/goim/pkg/sonic"
+)
+
+const (
+	routeKeyPrefix = "gateway_route:"
+	// TTL is how long a node's entry lives without being renewed. Gateways
+	// renew the users they hold every few minutes, so an entry only outlives
+	// its TTL when the node went away without cleaning up.
+	TTL = 15 * time.Minute
+)
+
+// Route is what one gateway node holds for a user.
+type Route struct {
+	NodeID      string   `json:"-"`
+	PlatformIDs []int32  `json:"platformIDs"`
+	ConnIDs     []string `json:"connIDs"`
+	ExpireAt    int64    `json:"expireAt"`
+}
+
+// Table stores a hash per user, keyed by gateway node ID.
+type Table struct {
+	cache cache.Cmdable
+}
+
+func NewTable(cache cache.Cmdable) *Table {
+	return &Table{cache: cache}
+}
+
+// Save replaces the entries of nodeID for the given users. A route without
+// connections removes the user's entry on that node.
+func (t *Table) Save(ctx context.Context, nodeID string, routes map[string]*Route) error {
+	if len(routes) == 0 {
+		return nil
+	}
+
+	expireAt := time.Now().Add(TTL).UnixMilli()
+	pipe := t.cache.Pipeline()
+	for userID, r := range routes {
+		key := routeKey(userID)
+		if len(r.ConnIDs) == 0 {
+			pipe.HDel(ctx, key, nodeID)
+			continue
+		}
+
+		r.ExpireAt = expireAt
+		val, err := sonic.MarshalString(r)
+		if err != nil {
+			return err
+		}
+		pipe.HSet(ctx, key, nodeID, val)
+		// Refreshing the key keeps it alive as long as any node renews it,
+		// stale fields of dead nodes are skipped by their own ExpireAt.
+		pipe.Expire(ctx, key, TTL)
+	}
+	_, err := pipe.Exec(ctx)
+
+	return err
+}
+
+// Lookup returns the live routes of each user, users without any are
+// left out of the result.
+func (t *Table) Lookup(ctx context.Context, userIDs []string) (map[string][]*Route, error) {
+	userIDs = langslice.Unique(userIDs)
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+
+	pipe := t.cache.Pipeline()
+	cmds := make([]cache.MapStringStringCmd, len(userIDs))
+	for i, userID := range userIDs {
+		cmds[i] = pipe.HGetAll(ctx, routeKey(userID))
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UnixMilli()
+	res := make(map[string][]*Route, len(userIDs))
+	for i, cmd := range cmds {
+		fields, err := cmd.Result()
+		if err != nil {
+			return nil, err
+		}
+		for nodeID, val := range fields {
+			var r Route
+			if err := sonic.UnmarshalString(val, &r); err != nil {
+				logs.CtxWarnf(ctx, "skip malformed route of user %s on node %s, err=%v", userIDs[i], nodeID, err)
+				continue
+			}
+			if r.ExpireAt <= now {
+				continue
+			}
+			r.NodeID = nodeID
+			res[userIDs[i]] = append(res[userIDs[i]], &r)
+		}
+	}
+
+	return res, nil
+}
+
+func routeKey(userID string) string {
+	return routeKeyPrefix + userID
+}
@@ -0,0 +1,156 @@
+package route
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/crazyfrankie/goim/infra/contract/cache"
+	"github.com/crazyfrankie/goim/pkg/sonic"
+)
+
+// fakeCache keeps the route hashes in memory, its pipelines run their
+// commands on Exec.
+type fakeCache struct {
+	cache.Cmdable
+
+	hashes map[string]map[string]string
+}
+
+func (c *fakeCache) Pipeline() cache.Pipeliner {
+	return &fakePipe{c: c}
+}
+
+type fakePipe struct {
+	cache.Pipeliner
+
+	c    *fakeCache
+	cmds []func()
+}
+
+type mapCmd struct {
+	res map[string]string
+}
+
+func (m *mapCmd) Err() error                         { return nil }
+func (m *mapCmd) Result() (map[string]string, error) { return m.res, nil }
+
+func (p *fakePipe) HSet(_ context.Context, key string, values ...interface{}) cache.IntCmd {
+	p.cmds = append(p.cmds, func() {
+		if p.c.hashes[key] == nil {
+			p.c.hashes[key] = make(map[string]string)
+		}
+		p.c.hashes[key][values[0].(string)] = values[1].(string)
+	})
+	return nil
+}
+
+func (p *fakePipe) HDel(_ context.Context, key string, fields ...string) cache.IntCmd {
+	p.cmds = append(p.cmds, func() {
+		for _, field := range fields {
+			delete(p.c.hashes[key], field)
+		}
+	})
+	return nil
+}
+
+func (p *fakePipe) Expire(context.Context, string, time.Duration) cache.BoolCmd {
+	return nil
+}
+
+func (p *fakePipe) HGetAll(_ context.Context, key string) cache.MapStringStringCmd {
+	cmd := &mapCmd{}
+	p.cmds = append(p.cmds, func() {
+		cmd.res = p.c.hashes[key]
+	})
+	return cmd
+}
+
+func (p *fakePipe) Exec(context.Context) ([]cache.Cmder, error) {
+	for _, cmd := range p.cmds {
+		cmd()
+	}
+	return nil, nil
+}
+
+func TestLookupSkipsExpiredRoutes(t *testing.T) {
+	now := time.Now()
+	stale := func(expireAt time.Time) string {
+		val, _ := sonic.MarshalString(&Route{ConnIDs: []string{"conn"}, ExpireAt: expireAt.UnixMilli()})
+		return val
+	}
+
+	cases := []struct {
+		name   string
+		fields map[string]string
+		want   []string
+	}{
+		{name: "live", fields: map[string]string{"node-b": stale(now.Add(time.Minute))}, want: []string{"node-b"}},
+		{name: "expired", fields: map[string]string{"node-b": stale(now.Add(-time.Second))}},
+		{name: "expiring now", fields: map[string]string{"node-b": stale(now)}},
+		{name: "malformed", fields: map[string]string{"node-b": "{"}},
+		{
+			name: "live next to expired",
+			fields: map[string]string{
+				"node-b": stale(now.Add(-time.Minute)),
+				"node-c": stale(now.Add(time.Minute)),
+			},
+			want: []string{"node-c"},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := &fakeCache{hashes: map[string]map[string]string{routeKey("1001"): tc.fields}}
+
+			routes, err := NewTable(c).Lookup(context.Background(), []string{"1001", "1001"})
+			if err != nil {
+				t.Fatalf("lookup: %v", err)
+			}
+			var got []string
+			for _, r := range routes["1001"] {
+				got = append(got, r.NodeID)
+			}
+			slices.Sort(got)
+			if !slices.Equal(got, tc.want) {
+				t.Fatalf("got nodes %v, want %v", got, tc.want)
+			}
+			if _, ok := routes["1001"]; ok && len(tc.want) == 0 {
+				t.Fatal("user without live routes was not left out")
+			}
+		})
+	}
+}
+
+func TestSaveRenewsAndRemovesRoutes(t *testing.T) {
+	c := &fakeCache{hashes: make(map[string]map[string]string)}
+	table := NewTable(c)
+	ctx := context.Background()
+
+	err := table.Save(ctx, "node-a", map[string]*Route{
+		"1001": {PlatformIDs: []int32{1}, ConnIDs: []string{"conn-1"}},
+		"1002": {PlatformIDs: []int32{5}, ConnIDs: []string{"conn-2"}},
+	})
+	if err != nil {
+		t.Fatalf("save: %v", err)
+	}
+	// A route without connections removes the user from the node.
+	if err := table.Save(ctx, "node-a", map[string]*Route{"1002": {}}); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+
+	routes, err := table.Lookup(ctx, []string{"1001", "1002"})
+	if err != nil {
+		t.Fatalf("lookup: %v", err)
+	}
+	if len(routes) != 1 || len(routes["1001"]) != 1 {
+		t.Fatalf("got routes %v, want only those of 1001", routes)
+	}
+	r := routes["1001"][0]
+	if r.NodeID != "node-a" || !slices.Equal(r.ConnIDs, []string{"conn-1"}) {
+		t.Fatalf("unexpected route %+v", r)
+	}
+	if ttl := time.Until(time.UnixMilli(r.ExpireAt)); ttl <= TTL-time.Minute || ttl > TTL {
+		t.Fatalf("route expires in %v, want about %v", ttl, TTL)
+	}
+}
@@ -107,7 +107,28 @@ type MessageRevoked struct {
 	ClientMsgID     string `mapstructure:"clientMsgID"     json:"clientMsgID"     validate:"required"`
 	RevokerNickname string `mapstructure:"revokerNickname" json:"revokerNickname"`
 	SessionType     int32  `mapstructure:"sessionType"     json:"sessionType"     validate:"required"`
-	Seq             uint32 `mapstructure:"seq"             json:"seq"             validate:"required"`
+	Seq             int64  `mapstructure:"seq"             json:"seq"             validate:"required"`
+}
+
+type MessagesDeleted struct {
+	ConversationID string  `mapstructure:"conversationID" json:"conversationID" validate:"required"`
+	Seqs           []int64 `mapstructure:"seqs"           json:"seqs"`
+	ClearedSeq     int64   `mapstructure:"clearedSeq"     json:"clearedSeq"`
+}
+
+type MarkAsReadTips struct {
+	ConversationID string `mapstructure:"conversationID" json:"conversationID" validate:"required"`
+	ReaderID       string `mapstructure:"readerID"       json:"readerID"       validate:"required"`
+	HasReadSeq     int64  `mapstructure:"hasReadSeq"     json:"hasReadSeq"     validate:"required"`
+}
+
+type MessageStatusChanged struct {
+	ConversationID string `mapstructure:"conversationID" json:"conversationID" validate:"required"`
+	ServerMsgID    int64  `mapstructure:"serverMsgID"    json:"serverMsgID"    validate:"required"`
+	ClientMsgID    string `mapstructure:"clientMsgID"    json:"clientMsgID"    validate:"required"`
+	Seq            int64  `mapstructure:"seq"            json:"seq"            validate:"required"`
+	Status         int32  `mapstructure:"status"         json:"status"`
+	OperatorID     string `mapstructure:"operatorID"     json:"operatorID"     validate:"required"`
 }
 
 type MsgStruct struct {
@@ -0,0 +1,52 @@
+package rpc
+
+import (
+	"context"
+	"os"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/crazyfrankie/goim/apps/conversation"
+	"github.com/crazyfrankie/goim/pkg/cmd"
+	"github.com/crazyfrankie/goim/pkg/grpc/interceptor"
+	"github.com/crazyfrankie/goim/pkg/grpc/startrpc"
+	"github.com/crazyfrankie/goim/pkg/lang/program"
+	"github.com/crazyfrankie/goim/types/consts"
+)
+
+type ConversationCmd struct {
+	*cmd.RootCmd
+}
+
+func NewConversationCmd() *ConversationCmd {
+	conversationCmd := &ConversationCmd{
+		RootCmd: cmd.NewRootCmd(program.GetProcessName(), consts.ConversationServiceName),
+	}
+	conversationCmd.Command.RunE = func(cmd *cobra.Command, args []string) error {
+		return conversationCmd.runE()
+	}
+
+	return conversationCmd
+}
+
+func (c *ConversationCmd) Exec() error {
+	return c.Execute()
+}
+
+func (c *ConversationCmd) runE() error {
+	listenIP := os.Getenv("LISTEN_IP")
+	registerIP := os.Getenv("REGISTER_IP")
+	listenPort := os.Getenv("LISTEN_PORT")
+
+	return startrpc.Start(context.Background(), listenIP, registerIP, listenPort, consts.ConversationServiceName, conversation.Start, conversationGrpcServerOption()...)
+}
+
+func conversationGrpcServerOption() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(
+			interceptor.CtxMDInterceptor(),
+			interceptor.ResponseInterceptor(),
+		),
+	}
+}
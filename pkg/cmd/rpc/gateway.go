@@ -0,0 +1,219 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/crazyfrankie/goim/infra/impl/cache/redis"
+	"github.com/crazyfrankie/goim/interfaces/ws"
+	"github.com/crazyfrankie/goim/internal/route"
+	"github.com/crazyfrankie/goim/pkg/cmd"
+	"github.com/crazyfrankie/goim/pkg/grpc/interceptor"
+	"github.com/crazyfrankie/goim/pkg/lang/program"
+	"github.com/crazyfrankie/goim/types/consts"
+)
+
+type GatewayCmd struct {
+	*cmd.RootCmd
+}
+
+func NewGatewayCmd() *GatewayCmd {
+	gatewayCmd := &GatewayCmd{
+		RootCmd: cmd.NewRootCmd(program.GetProcessName(), consts.MsgGatewayServiceName),
+	}
+	gatewayCmd.Command.RunE = func(cmd *cobra.Command, args []string) error {
+		return gatewayCmd.runE()
+	}
+
+	return gatewayCmd
+}
+
+func (g *GatewayCmd) Exec() error {
+	return g.Execute()
+}
+
+func (g *GatewayCmd) runE() error {
+	listenIP := os.Getenv("LISTEN_IP")
+	registerIP := os.Getenv("REGISTER_IP")
+	listenPort := os.Getenv("LISTEN_PORT")
+
+	wsOpts, err := gatewayWsOption()
+	if err != nil {
+		return err
+	}
+	// Push workers match the route table against the addresses in discovery.
+	wsOpts = append(wsOpts, ws.WithRouteTable(net.JoinHostPort(registerIP, listenPort), route.NewTable(redis.New())))
+
+	return ws.Start(context.Background(), listenIP, registerIP, listenPort, wsOpts, gatewayGrpcServerOption()...)
+}
+
+// gatewayWsOption builds the long connection server options from env.
+func gatewayWsOption() ([]ws.Option, error) {
+	port, err := envInt("WS_PORT", 10001)
+	if err != nil {
+		return nil, err
+	}
+	maxConnNum, err := envInt("WS_MAX_CONN_NUM", 100000)
+	if err != nil {
+		return nil, err
+	}
+	handshakeTimeout, err := envDuration("WS_HANDSHAKE_TIMEOUT", 10*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	writeBufferSize, err := envInt("WS_WRITE_BUFFER_SIZE", 4096)
+	if err != nil {
+		return nil, err
+	}
+	messageMaxLength, err := envInt("WS_MESSAGE_MAX_LENGTH", 51200)
+	if err != nil {
+		return nil, err
+	}
+
+	multiLoginPolicy, err := envInt("WS_MULTI_LOGIN_POLICY", consts.AllowAllLogin)
+	if err != nil {
+		return nil, err
+	}
+
+	bucketNum, err := envInt("WS_BUCKET_NUM", 32)
+	if err != nil {
+		return nil, err
+	}
+	bucketConfig := ws.DefaultBucketConfig()
+	for key, field := range map[string]*int{
+		"WS_BUCKET_CHANNEL_SIZE":   &bucketConfig.ChannelSize,
+		"WS_BUCKET_ROOM_SIZE":      &bucketConfig.RoomSize,
+		"WS_BUCKET_ROUTINE_AMOUNT": &bucketConfig.RoutineAmount,
+		"WS_BUCKET_ROUTINE_SIZE":   &bucketConfig.RoutineSize,
+		"WS_CLIENT_ROOM_LIMIT":     &bucketConfig.ClientRoomLimit,
+	} {
+		if *field, err = envInt(key, *field); err != nil {
+			return nil, err
+		}
+	}
+
+	rateLimit := ws.DefaultRateLimitConfig()
+	if rateLimit.MaxViolations, err = envInt("WS_RATE_LIMIT_MAX_VIOLATIONS", rateLimit.MaxViolations); err != nil {
+		return nil, err
+	}
+	if rateLimit.ViolationWindow, err = envDuration("WS_RATE_LIMIT_VIOLATION_WINDOW", rateLimit.ViolationWindow); err != nil {
+		return nil, err
+	}
+
+	backpressure := ws.DefaultBackpressureConfig()
+	if backpressure.BlockTimeout, err = envDuration("WS_SEND_BLOCK_TIMEOUT", backpressure.BlockTimeout); err != nil {
+		return nil, err
+	}
+
+	resumeGrace, err := envDuration("WS_RESUME_GRACE", 2*time.Minute)
+	if err != nil {
+		return nil, err
+	}
+	replaySize, err := envInt("WS_RESUME_BUFFER_SIZE", 64)
+	if err != nil {
+		return nil, err
+	}
+
+	ackTimeout, err := envDuration("WS_MSG_ACK_TIMEOUT", 5*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	ackRetries, err := envInt("WS_MSG_ACK_RETRIES", 3)
+	if err != nil {
+		return nil, err
+	}
+
+	trustedProxies, err := envPrefixes("WS_TRUSTED_PROXIES")
+	if err != nil {
+		return nil, err
+	}
+
+	return []ws.Option{
+		ws.WithPort(port),
+		ws.WithMaxConnNum(int64(maxConnNum)),
+		ws.WithHandshakeTimeout(handshakeTimeout),
+		ws.WithWriteBufferSize(writeBufferSize),
+		ws.WithMessageMaxMsgLength(messageMaxLength),
+		ws.WithMultiLoginPolicy(multiLoginPolicy),
+		ws.WithBucketNum(bucketNum),
+		ws.WithBucketConfig(bucketConfig),
+		ws.WithRateLimit(rateLimit),
+		ws.WithBackpressure(backpressure),
+		ws.WithSessionResume(resumeGrace, replaySize),
+		ws.WithMsgAck(ackTimeout, ackRetries),
+		ws.WithTrustedProxies(trustedProxies),
+	}, nil
+}
+
+func gatewayGrpcServerOption() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(
+			interceptor.CtxMDInterceptor(),
+			interceptor.ResponseInterceptor(),
+		),
+	}
+}
+
+func envInt(key string, def int) (int, error) {
+	val := os.Getenv(key)
+	if val == "" {
+		return def, nil
+	}
+	i, err := strconv.Atoi(val)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", key, err)
+	}
+
+	return i, nil
+}
+
+func envDuration(key string, def time.Duration) (time.Duration, error) {
+	val := os.Getenv(key)
+	if val == "" {
+		return def, nil
+	}
+	d, err := time.ParseDuration(val)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", key, err)
+	}
+
+	return d, nil
+}
+
+// envPrefixes reads a comma separated list of CIDRs, single IPs stand for
+// themselves.
+func envPrefixes(key string) ([]netip.Prefix, error) {
+	val := os.Getenv(key)
+	if val == "" {
+		return nil, nil
+	}
+
+	var prefixes []netip.Prefix
+	for item := range strings.SplitSeq(val, ",") {
+		item = strings.TrimSpace(item)
+		if !strings.Contains(item, "/") {
+			addr, err := netip.ParseAddr(item)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s: %w", key, err)
+			}
+			prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+			continue
+		}
+		prefix, err := netip.ParsePrefix(item)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", key, err)
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+
+	return prefixes, nil
+}
@@ -0,0 +1,52 @@
+package rpc
+
+import (
+	"context"
+	"os"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/crazyfrankie/goim/apps/group"
+	"github.com/crazyfrankie/goim/pkg/cmd"
+	"github.com/crazyfrankie/goim/pkg/grpc/interceptor"
+	"github.com/crazyfrankie/goim/pkg/grpc/startrpc"
+	"github.com/crazyfrankie/goim/pkg/lang/program"
+	"github.com/crazyfrankie/goim/types/consts"
+)
+
+type GroupCmd struct {
+	*cmd.RootCmd
+}
+
+func NewGroupCmd() *GroupCmd {
+	groupCmd := &GroupCmd{
+		RootCmd: cmd.NewRootCmd(program.GetProcessName(), consts.GroupServiceName),
+	}
+	groupCmd.Command.RunE = func(cmd *cobra.Command, args []string) error {
+		return groupCmd.runE()
+	}
+
+	return groupCmd
+}
+
+func (c *GroupCmd) Exec() error {
+	return c.Execute()
+}
+
+func (c *GroupCmd) runE() error {
+	listenIP := os.Getenv("LISTEN_IP")
+	registerIP := os.Getenv("REGISTER_IP")
+	listenPort := os.Getenv("LISTEN_PORT")
+
+	return startrpc.Start(context.Background(), listenIP, registerIP, listenPort, consts.GroupServiceName, group.Start, groupGrpcServerOption()...)
+}
+
+func groupGrpcServerOption() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(
+			interceptor.CtxMDInterceptor(),
+			interceptor.ResponseInterceptor(),
+		),
+	}
+}
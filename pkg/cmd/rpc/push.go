@@ -0,0 +1,38 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/crazyfrankie/goim/apps/push"
+	"github.com/crazyfrankie/goim/pkg/cmd"
+	"github.com/crazyfrankie/goim/pkg/grpc/startrpc"
+	"github.com/crazyfrankie/goim/pkg/lang/program"
+	"github.com/crazyfrankie/goim/types/consts"
+)
+
+type PushCmd struct {
+	*cmd.RootCmd
+}
+
+func NewPushCmd() *PushCmd {
+	pushCmd := &PushCmd{
+		RootCmd: cmd.NewRootCmd(program.GetProcessName(), consts.PushServiceName),
+	}
+	pushCmd.Command.RunE = func(cmd *cobra.Command, args []string) error {
+		return pushCmd.runE()
+	}
+
+	return pushCmd
+}
+
+func (p *PushCmd) Exec() error {
+	return p.Execute()
+}
+
+// runE runs the push worker. It registers no gRPC service, so startrpc only
+// provides the discovery client and blocks until the process is signaled.
+func (p *PushCmd) runE() error {
+	return startrpc.Start(context.Background(), "", "", "", consts.PushServiceName, push.Start)
+}
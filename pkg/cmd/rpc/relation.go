@@ -0,0 +1,52 @@
+package rpc
+
+import (
+	"context"
+	"os"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/crazyfrankie/goim/apps/relation"
+	"github.com/crazyfrankie/goim/pkg/cmd"
+	"github.com/crazyfrankie/goim/pkg/grpc/interceptor"
+	"github.com/crazyfrankie/goim/pkg/grpc/startrpc"
+	"github.com/crazyfrankie/goim/pkg/lang/program"
+	"github.com/crazyfrankie/goim/types/consts"
+)
+
+type RelationCmd struct {
+	*cmd.RootCmd
+}
+
+func NewRelationCmd() *RelationCmd {
+	relationCmd := &RelationCmd{
+		RootCmd: cmd.NewRootCmd(program.GetProcessName(), consts.RelationServiceName),
+	}
+	relationCmd.Command.RunE = func(cmd *cobra.Command, args []string) error {
+		return relationCmd.runE()
+	}
+
+	return relationCmd
+}
+
+func (c *RelationCmd) Exec() error {
+	return c.Execute()
+}
+
+func (c *RelationCmd) runE() error {
+	listenIP := os.Getenv("LISTEN_IP")
+	registerIP := os.Getenv("REGISTER_IP")
+	listenPort := os.Getenv("LISTEN_PORT")
+
+	return startrpc.Start(context.Background(), listenIP, registerIP, listenPort, consts.RelationServiceName, relation.Start, relationGrpcServerOption()...)
+}
+
+func relationGrpcServerOption() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(
+			interceptor.CtxMDInterceptor(),
+			interceptor.ResponseInterceptor(),
+		),
+	}
+}
@@ -42,7 +42,7 @@ func StoreM(ctx context.Context, kv ...any) {
 		return
 	}
 	if cacheMap, ok := ctx.Value(ctxCacheKey{}).(*sync.Map); ok {
-		for i := 0; i < len(kv)/2; i += 2 {
+		for i := 0; i < len(kv); i += 2 {
 			cacheMap.Store(kv[i], kv[i+1])
 		}
 	}
@@ -8,6 +8,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"google.golang.org/grpc/metadata"
 
+	"github.com/crazyfrankie/goim/infra/contract/token"
 	"github.com/crazyfrankie/goim/pkg/gin/response"
 	"github.com/crazyfrankie/goim/pkg/lang/conv"
 	authv1 "github.com/crazyfrankie/goim/protocol/auth/v1"
@@ -43,7 +44,10 @@ func (h *AuthnHandler) Auth() gin.HandlerFunc {
 			response.Unauthorized(c)
 			return
 		}
-		parseRes, err := h.authClient.ParseToken(c.Request.Context(), &authv1.ParseTokenRequest{Token: accessToken})
+		parseRes, err := h.authClient.ParseToken(c.Request.Context(), &authv1.ParseTokenRequest{
+			Token:     accessToken,
+			TokenType: token.TypeAccess,
+		})
 		if err == nil {
 			c.Request = c.Request.WithContext(h.storeUserID(c.Request.Context(), parseRes.GetUserID()))
 
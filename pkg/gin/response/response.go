@@ -1,10 +1,13 @@
 package response
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 	"google.golang.org/grpc/status"
+
+	"github.com/crazyfrankie/goim/pkg/errorx"
 )
 
 const (
@@ -50,6 +53,14 @@ func ParseError(err error) *Response {
 	code := InternalServer
 	msg := "internal server error"
 
+	var customErr errorx.StatusError
+	if errors.As(err, &customErr) && customErr.Code() != 0 {
+		return &Response{
+			Code:    customErr.Code(),
+			Message: customErr.Msg(),
+		}
+	}
+
 	if grpcErr, ok := status.FromError(err); ok {
 		code = int32(grpcErr.Code())
 		msg = grpcErr.Message()
@@ -3,6 +3,8 @@ package ctxutil
 import (
 	"context"
 
+	"google.golang.org/grpc/metadata"
+
 	"github.com/crazyfrankie/goim/pkg/ctxcache"
 	"github.com/crazyfrankie/goim/pkg/lang/conv"
 )
@@ -17,3 +19,9 @@ func MustGetUserIDFromCtx(ctx context.Context) int64 {
 
 	return userID
 }
+
+// WithOutgoingUserID attaches userID to the outgoing metadata so that the
+// callee can read it back through MustGetUserIDFromCtx.
+func WithOutgoingUserID(ctx context.Context, userID string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, "user_id", userID)
+}
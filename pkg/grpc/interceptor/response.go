@@ -20,12 +20,18 @@ func ResponseInterceptor() grpc.UnaryServerInterceptor {
 
 			if errors.As(err, &customErr) && customErr.Code() != 0 {
 				logs.CtxWarnf(ctx, "[ErrorX] error:  %v %v \n", customErr.Code(), err)
-				err = status.Errorf(codes.Code(customErr.Code()), customErr.Msg())
+				err = status.Error(codes.Code(customErr.Code()), customErr.Msg())
+				return
+			}
+
+			// errors coming back from downstream services are already gRPC statuses
+			if _, ok := status.FromError(err); ok {
+				logs.CtxWarnf(ctx, "[StatusError] error: %v \n", err)
 				return
 			}
 
 			logs.CtxErrorf(ctx, "[InternalError]  error: %v \n", err)
-			err = status.Errorf(codes.Internal, "internal error")
+			err = status.Error(codes.Internal, "internal error")
 		}
 
 		return
@@ -0,0 +1,94 @@
+package msgprocessor
+
+import (
+	"strconv"
+	"strings"
+
+	messagev1 "github.com/crazyfrankie/goim/protocol/message/v1"
+	"github.com/crazyfrankie/goim/types/consts"
+)
+
+const (
+	singleChatPrefix   = "si_"
+	groupChatPrefix    = "sg_"
+	notificationPrefix = "n_"
+)
+
+// GetConversationIDByMsg returns the conversation a message belongs to.
+func GetConversationIDByMsg(msg *messagev1.Message) string {
+	return GetConversationIDBySessionType(msg.GetSessionType(), msg.GetSendID(), msg.GetRecvID(), msg.GetGroupID())
+}
+
+// GetConversationIDBySessionType derives the conversation ID from the session type.
+// Single chat and notification conversations are keyed by the sorted user pair so
+// both sides share one sequence; group conversations are keyed by the group ID.
+func GetConversationIDBySessionType(sessionType int32, sendID, recvID, groupID int64) string {
+	switch sessionType {
+	case consts.SingleChatType:
+		return singleChatPrefix + sortedPair(sendID, recvID)
+	case consts.NotificationChatType:
+		return notificationPrefix + sortedPair(sendID, recvID)
+	case consts.GroupChatType, consts.WriteGroupChatType, consts.ReadGroupChatType:
+		return groupChatPrefix + strconv.FormatInt(groupID, 10)
+	}
+
+	return ""
+}
+
+// IsNotification reports whether the conversation carries notifications.
+func IsNotification(conversationID string) bool {
+	return strings.HasPrefix(conversationID, notificationPrefix)
+}
+
+// IsGroupConversationID reports whether the conversation belongs to a group.
+func IsGroupConversationID(conversationID string) bool {
+	return strings.HasPrefix(conversationID, groupChatPrefix)
+}
+
+// GetUserIDsByConversationID returns the two users of a single chat or notification
+// conversation. ok is false for group conversations and malformed IDs.
+func GetUserIDsByConversationID(conversationID string) (userA, userB int64, ok bool) {
+	var pair string
+	switch {
+	case strings.HasPrefix(conversationID, singleChatPrefix):
+		pair = strings.TrimPrefix(conversationID, singleChatPrefix)
+	case strings.HasPrefix(conversationID, notificationPrefix):
+		pair = strings.TrimPrefix(conversationID, notificationPrefix)
+	default:
+		return 0, 0, false
+	}
+
+	a, b, found := strings.Cut(pair, "_")
+	if !found {
+		return 0, 0, false
+	}
+	userA, errA := strconv.ParseInt(a, 10, 64)
+	userB, errB := strconv.ParseInt(b, 10, 64)
+	if errA != nil || errB != nil {
+		return 0, 0, false
+	}
+
+	return userA, userB, true
+}
+
+// GetGroupIDByConversationID returns the group of a group conversation, ok is
+// false for any other conversation and for malformed IDs.
+func GetGroupIDByConversationID(conversationID string) (groupID int64, ok bool) {
+	if !IsGroupConversationID(conversationID) {
+		return 0, false
+	}
+	groupID, err := strconv.ParseInt(strings.TrimPrefix(conversationID, groupChatPrefix), 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return groupID, true
+}
+
+func sortedPair(a, b int64) string {
+	if a > b {
+		a, b = b, a
+	}
+
+	return strconv.FormatInt(a, 10) + "_" + strconv.FormatInt(b, 10)
+}
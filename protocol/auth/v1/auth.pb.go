@@ -117,16 +117,106 @@ func (x *GenerateTokenResponse) GetRefreshToken() string {
 	return ""
 }
 
-type ParseTokenRequest struct {
+type GenerateConnTokenRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	UserID        int64                  `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateConnTokenRequest) Reset() {
+	*x = GenerateConnTokenRequest{}
+	mi := &file_idl_auth_v1_auth_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateConnTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateConnTokenRequest) ProtoMessage() {}
+
+func (x *GenerateConnTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_auth_v1_auth_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateConnTokenRequest.ProtoReflect.Descriptor instead.
+func (*GenerateConnTokenRequest) Descriptor() ([]byte, []int) {
+	return file_idl_auth_v1_auth_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GenerateConnTokenRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+type GenerateConnTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConnToken     string                 `protobuf:"bytes,1,opt,name=conn_token,json=connToken,proto3" json:"conn_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateConnTokenResponse) Reset() {
+	*x = GenerateConnTokenResponse{}
+	mi := &file_idl_auth_v1_auth_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateConnTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateConnTokenResponse) ProtoMessage() {}
+
+func (x *GenerateConnTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_auth_v1_auth_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateConnTokenResponse.ProtoReflect.Descriptor instead.
+func (*GenerateConnTokenResponse) Descriptor() ([]byte, []int) {
+	return file_idl_auth_v1_auth_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GenerateConnTokenResponse) GetConnToken() string {
+	if x != nil {
+		return x.ConnToken
+	}
+	return ""
+}
+
+type ParseTokenRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Token string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// token_type is the type the token must have, an access token when empty.
+	TokenType     string `protobuf:"bytes,2,opt,name=token_type,json=tokenType,proto3" json:"token_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ParseTokenRequest) Reset() {
 	*x = ParseTokenRequest{}
-	mi := &file_idl_auth_v1_auth_proto_msgTypes[2]
+	mi := &file_idl_auth_v1_auth_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -138,7 +228,7 @@ func (x *ParseTokenRequest) String() string {
 func (*ParseTokenRequest) ProtoMessage() {}
 
 func (x *ParseTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_auth_v1_auth_proto_msgTypes[2]
+	mi := &file_idl_auth_v1_auth_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -151,7 +241,7 @@ func (x *ParseTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ParseTokenRequest.ProtoReflect.Descriptor instead.
 func (*ParseTokenRequest) Descriptor() ([]byte, []int) {
-	return file_idl_auth_v1_auth_proto_rawDescGZIP(), []int{2}
+	return file_idl_auth_v1_auth_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ParseTokenRequest) GetToken() string {
@@ -161,6 +251,13 @@ func (x *ParseTokenRequest) GetToken() string {
 	return ""
 }
 
+func (x *ParseTokenRequest) GetTokenType() string {
+	if x != nil {
+		return x.TokenType
+	}
+	return ""
+}
+
 type ParseTokenResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserID        int64                  `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
@@ -170,7 +267,7 @@ type ParseTokenResponse struct {
 
 func (x *ParseTokenResponse) Reset() {
 	*x = ParseTokenResponse{}
-	mi := &file_idl_auth_v1_auth_proto_msgTypes[3]
+	mi := &file_idl_auth_v1_auth_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -182,7 +279,7 @@ func (x *ParseTokenResponse) String() string {
 func (*ParseTokenResponse) ProtoMessage() {}
 
 func (x *ParseTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_auth_v1_auth_proto_msgTypes[3]
+	mi := &file_idl_auth_v1_auth_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -195,7 +292,7 @@ func (x *ParseTokenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ParseTokenResponse.ProtoReflect.Descriptor instead.
 func (*ParseTokenResponse) Descriptor() ([]byte, []int) {
-	return file_idl_auth_v1_auth_proto_rawDescGZIP(), []int{3}
+	return file_idl_auth_v1_auth_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ParseTokenResponse) GetUserID() int64 {
@@ -214,7 +311,7 @@ type RefreshTokenRequest struct {
 
 func (x *RefreshTokenRequest) Reset() {
 	*x = RefreshTokenRequest{}
-	mi := &file_idl_auth_v1_auth_proto_msgTypes[4]
+	mi := &file_idl_auth_v1_auth_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -226,7 +323,7 @@ func (x *RefreshTokenRequest) String() string {
 func (*RefreshTokenRequest) ProtoMessage() {}
 
 func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_auth_v1_auth_proto_msgTypes[4]
+	mi := &file_idl_auth_v1_auth_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -239,7 +336,7 @@ func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefreshTokenRequest.ProtoReflect.Descriptor instead.
 func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
-	return file_idl_auth_v1_auth_proto_rawDescGZIP(), []int{4}
+	return file_idl_auth_v1_auth_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *RefreshTokenRequest) GetRefreshToken() string {
@@ -260,7 +357,7 @@ type RefreshTokenResponse struct {
 
 func (x *RefreshTokenResponse) Reset() {
 	*x = RefreshTokenResponse{}
-	mi := &file_idl_auth_v1_auth_proto_msgTypes[5]
+	mi := &file_idl_auth_v1_auth_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -272,7 +369,7 @@ func (x *RefreshTokenResponse) String() string {
 func (*RefreshTokenResponse) ProtoMessage() {}
 
 func (x *RefreshTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_auth_v1_auth_proto_msgTypes[5]
+	mi := &file_idl_auth_v1_auth_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -285,7 +382,7 @@ func (x *RefreshTokenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefreshTokenResponse.ProtoReflect.Descriptor instead.
 func (*RefreshTokenResponse) Descriptor() ([]byte, []int) {
-	return file_idl_auth_v1_auth_proto_rawDescGZIP(), []int{5}
+	return file_idl_auth_v1_auth_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *RefreshTokenResponse) GetAccessToken() string {
@@ -309,6 +406,94 @@ func (x *RefreshTokenResponse) GetUserID() int64 {
 	return 0
 }
 
+type RevokeTokensRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        int64                  `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Tokens        []string               `protobuf:"bytes,2,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeTokensRequest) Reset() {
+	*x = RevokeTokensRequest{}
+	mi := &file_idl_auth_v1_auth_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeTokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeTokensRequest) ProtoMessage() {}
+
+func (x *RevokeTokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_auth_v1_auth_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeTokensRequest.ProtoReflect.Descriptor instead.
+func (*RevokeTokensRequest) Descriptor() ([]byte, []int) {
+	return file_idl_auth_v1_auth_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RevokeTokensRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *RevokeTokensRequest) GetTokens() []string {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+type RevokeTokensResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeTokensResponse) Reset() {
+	*x = RevokeTokensResponse{}
+	mi := &file_idl_auth_v1_auth_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeTokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeTokensResponse) ProtoMessage() {}
+
+func (x *RevokeTokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_auth_v1_auth_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeTokensResponse.ProtoReflect.Descriptor instead.
+func (*RevokeTokensResponse) Descriptor() ([]byte, []int) {
+	return file_idl_auth_v1_auth_proto_rawDescGZIP(), []int{9}
+}
+
 var File_idl_auth_v1_auth_proto protoreflect.FileDescriptor
 
 const file_idl_auth_v1_auth_proto_rawDesc = "" +
@@ -318,9 +503,16 @@ const file_idl_auth_v1_auth_proto_rawDesc = "" +
 	"\x06userID\x18\x01 \x01(\x03R\x06userID\"_\n" +
 	"\x15GenerateTokenResponse\x12!\n" +
 	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
-	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\")\n" +
+	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\"2\n" +
+	"\x18GenerateConnTokenRequest\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\x03R\x06userID\":\n" +
+	"\x19GenerateConnTokenResponse\x12\x1d\n" +
+	"\n" +
+	"conn_token\x18\x01 \x01(\tR\tconnToken\"H\n" +
 	"\x11ParseTokenRequest\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\",\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1d\n" +
+	"\n" +
+	"token_type\x18\x02 \x01(\tR\ttokenType\",\n" +
 	"\x12ParseTokenResponse\x12\x16\n" +
 	"\x06userID\x18\x01 \x01(\x03R\x06userID\":\n" +
 	"\x13RefreshTokenRequest\x12#\n" +
@@ -328,12 +520,18 @@ const file_idl_auth_v1_auth_proto_rawDesc = "" +
 	"\x14RefreshTokenResponse\x12!\n" +
 	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
 	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\x12\x16\n" +
-	"\x06userID\x18\x03 \x01(\x03R\x06userID2\xf1\x01\n" +
+	"\x06userID\x18\x03 \x01(\x03R\x06userID\"E\n" +
+	"\x13RevokeTokensRequest\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\x03R\x06userID\x12\x16\n" +
+	"\x06tokens\x18\x02 \x03(\tR\x06tokens\"\x16\n" +
+	"\x14RevokeTokensResponse2\x9a\x03\n" +
 	"\vAuthService\x12N\n" +
-	"\rGenerateToken\x12\x1d.auth.v1.GenerateTokenRequest\x1a\x1e.auth.v1.GenerateTokenResponse\x12E\n" +
+	"\rGenerateToken\x12\x1d.auth.v1.GenerateTokenRequest\x1a\x1e.auth.v1.GenerateTokenResponse\x12Z\n" +
+	"\x11GenerateConnToken\x12!.auth.v1.GenerateConnTokenRequest\x1a\".auth.v1.GenerateConnTokenResponse\x12E\n" +
 	"\n" +
 	"ParseToken\x12\x1a.auth.v1.ParseTokenRequest\x1a\x1b.auth.v1.ParseTokenResponse\x12K\n" +
-	"\fRefreshToken\x12\x1c.auth.v1.RefreshTokenRequest\x1a\x1d.auth.v1.RefreshTokenResponseB6Z4github.com/crazyfrankie/goim/protocol/auth/v1;authv1b\x06proto3"
+	"\fRefreshToken\x12\x1c.auth.v1.RefreshTokenRequest\x1a\x1d.auth.v1.RefreshTokenResponse\x12K\n" +
+	"\fRevokeTokens\x12\x1c.auth.v1.RevokeTokensRequest\x1a\x1d.auth.v1.RevokeTokensResponseB6Z4github.com/crazyfrankie/goim/protocol/auth/v1;authv1b\x06proto3"
 
 var (
 	file_idl_auth_v1_auth_proto_rawDescOnce sync.Once
@@ -347,24 +545,32 @@ func file_idl_auth_v1_auth_proto_rawDescGZIP() []byte {
 	return file_idl_auth_v1_auth_proto_rawDescData
 }
 
-var file_idl_auth_v1_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_idl_auth_v1_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_idl_auth_v1_auth_proto_goTypes = []any{
-	(*GenerateTokenRequest)(nil),  // 0: auth.v1.GenerateTokenRequest
-	(*GenerateTokenResponse)(nil), // 1: auth.v1.GenerateTokenResponse
-	(*ParseTokenRequest)(nil),     // 2: auth.v1.ParseTokenRequest
-	(*ParseTokenResponse)(nil),    // 3: auth.v1.ParseTokenResponse
-	(*RefreshTokenRequest)(nil),   // 4: auth.v1.RefreshTokenRequest
-	(*RefreshTokenResponse)(nil),  // 5: auth.v1.RefreshTokenResponse
+	(*GenerateTokenRequest)(nil),      // 0: auth.v1.GenerateTokenRequest
+	(*GenerateTokenResponse)(nil),     // 1: auth.v1.GenerateTokenResponse
+	(*GenerateConnTokenRequest)(nil),  // 2: auth.v1.GenerateConnTokenRequest
+	(*GenerateConnTokenResponse)(nil), // 3: auth.v1.GenerateConnTokenResponse
+	(*ParseTokenRequest)(nil),         // 4: auth.v1.ParseTokenRequest
+	(*ParseTokenResponse)(nil),        // 5: auth.v1.ParseTokenResponse
+	(*RefreshTokenRequest)(nil),       // 6: auth.v1.RefreshTokenRequest
+	(*RefreshTokenResponse)(nil),      // 7: auth.v1.RefreshTokenResponse
+	(*RevokeTokensRequest)(nil),       // 8: auth.v1.RevokeTokensRequest
+	(*RevokeTokensResponse)(nil),      // 9: auth.v1.RevokeTokensResponse
 }
 var file_idl_auth_v1_auth_proto_depIdxs = []int32{
 	0, // 0: auth.v1.AuthService.GenerateToken:input_type -> auth.v1.GenerateTokenRequest
-	2, // 1: auth.v1.AuthService.ParseToken:input_type -> auth.v1.ParseTokenRequest
-	4, // 2: auth.v1.AuthService.RefreshToken:input_type -> auth.v1.RefreshTokenRequest
-	1, // 3: auth.v1.AuthService.GenerateToken:output_type -> auth.v1.GenerateTokenResponse
-	3, // 4: auth.v1.AuthService.ParseToken:output_type -> auth.v1.ParseTokenResponse
-	5, // 5: auth.v1.AuthService.RefreshToken:output_type -> auth.v1.RefreshTokenResponse
-	3, // [3:6] is the sub-list for method output_type
-	0, // [0:3] is the sub-list for method input_type
+	2, // 1: auth.v1.AuthService.GenerateConnToken:input_type -> auth.v1.GenerateConnTokenRequest
+	4, // 2: auth.v1.AuthService.ParseToken:input_type -> auth.v1.ParseTokenRequest
+	6, // 3: auth.v1.AuthService.RefreshToken:input_type -> auth.v1.RefreshTokenRequest
+	8, // 4: auth.v1.AuthService.RevokeTokens:input_type -> auth.v1.RevokeTokensRequest
+	1, // 5: auth.v1.AuthService.GenerateToken:output_type -> auth.v1.GenerateTokenResponse
+	3, // 6: auth.v1.AuthService.GenerateConnToken:output_type -> auth.v1.GenerateConnTokenResponse
+	5, // 7: auth.v1.AuthService.ParseToken:output_type -> auth.v1.ParseTokenResponse
+	7, // 8: auth.v1.AuthService.RefreshToken:output_type -> auth.v1.RefreshTokenResponse
+	9, // 9: auth.v1.AuthService.RevokeTokens:output_type -> auth.v1.RevokeTokensResponse
+	5, // [5:10] is the sub-list for method output_type
+	0, // [0:5] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -381,7 +587,7 @@ func file_idl_auth_v1_auth_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_idl_auth_v1_auth_proto_rawDesc), len(file_idl_auth_v1_auth_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,1284 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: idl/conversation/v1/conversation.proto
+
+package conversationv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type LastMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerMsgID   int64                  `protobuf:"varint,1,opt,name=serverMsgID,proto3" json:"serverMsgID,omitempty"`
+	SendID        int64                  `protobuf:"varint,2,opt,name=sendID,proto3" json:"sendID,omitempty"`
+	ContentType   int32                  `protobuf:"varint,3,opt,name=contentType,proto3" json:"contentType,omitempty"`
+	Content       []byte                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	Seq           int64                  `protobuf:"varint,5,opt,name=seq,proto3" json:"seq,omitempty"`
+	SendTime      int64                  `protobuf:"varint,6,opt,name=send_time,json=sendTime,proto3" json:"send_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LastMessage) Reset() {
+	*x = LastMessage{}
+	mi := &file_idl_conversation_v1_conversation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LastMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LastMessage) ProtoMessage() {}
+
+func (x *LastMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_conversation_v1_conversation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LastMessage.ProtoReflect.Descriptor instead.
+func (*LastMessage) Descriptor() ([]byte, []int) {
+	return file_idl_conversation_v1_conversation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LastMessage) GetServerMsgID() int64 {
+	if x != nil {
+		return x.ServerMsgID
+	}
+	return 0
+}
+
+func (x *LastMessage) GetSendID() int64 {
+	if x != nil {
+		return x.SendID
+	}
+	return 0
+}
+
+func (x *LastMessage) GetContentType() int32 {
+	if x != nil {
+		return x.ContentType
+	}
+	return 0
+}
+
+func (x *LastMessage) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *LastMessage) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *LastMessage) GetSendTime() int64 {
+	if x != nil {
+		return x.SendTime
+	}
+	return 0
+}
+
+type Conversation struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ConversationID   string                 `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+	OwnerID          int64                  `protobuf:"varint,2,opt,name=ownerID,proto3" json:"ownerID,omitempty"`
+	ConversationType int32                  `protobuf:"varint,3,opt,name=conversation_type,json=conversationType,proto3" json:"conversation_type,omitempty"`
+	PeerID           int64                  `protobuf:"varint,4,opt,name=peerID,proto3" json:"peerID,omitempty"`
+	GroupID          int64                  `protobuf:"varint,5,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	MaxSeq           int64                  `protobuf:"varint,6,opt,name=max_seq,json=maxSeq,proto3" json:"max_seq,omitempty"`
+	HasReadSeq       int64                  `protobuf:"varint,7,opt,name=has_read_seq,json=hasReadSeq,proto3" json:"has_read_seq,omitempty"`
+	UnreadCount      int64                  `protobuf:"varint,8,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	LastMessage      *LastMessage           `protobuf:"bytes,9,opt,name=last_message,json=lastMessage,proto3" json:"last_message,omitempty"`
+	IsPinned         bool                   `protobuf:"varint,10,opt,name=is_pinned,json=isPinned,proto3" json:"is_pinned,omitempty"`
+	IsMuted          bool                   `protobuf:"varint,11,opt,name=is_muted,json=isMuted,proto3" json:"is_muted,omitempty"`
+	Draft            string                 `protobuf:"bytes,12,opt,name=draft,proto3" json:"draft,omitempty"`
+	DraftTime        int64                  `protobuf:"varint,13,opt,name=draft_time,json=draftTime,proto3" json:"draft_time,omitempty"`
+	UpdateTime       int64                  `protobuf:"varint,14,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Conversation) Reset() {
+	*x = Conversation{}
+	mi := &file_idl_conversation_v1_conversation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Conversation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Conversation) ProtoMessage() {}
+
+func (x *Conversation) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_conversation_v1_conversation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Conversation.ProtoReflect.Descriptor instead.
+func (*Conversation) Descriptor() ([]byte, []int) {
+	return file_idl_conversation_v1_conversation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Conversation) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *Conversation) GetOwnerID() int64 {
+	if x != nil {
+		return x.OwnerID
+	}
+	return 0
+}
+
+func (x *Conversation) GetConversationType() int32 {
+	if x != nil {
+		return x.ConversationType
+	}
+	return 0
+}
+
+func (x *Conversation) GetPeerID() int64 {
+	if x != nil {
+		return x.PeerID
+	}
+	return 0
+}
+
+func (x *Conversation) GetGroupID() int64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+func (x *Conversation) GetMaxSeq() int64 {
+	if x != nil {
+		return x.MaxSeq
+	}
+	return 0
+}
+
+func (x *Conversation) GetHasReadSeq() int64 {
+	if x != nil {
+		return x.HasReadSeq
+	}
+	return 0
+}
+
+func (x *Conversation) GetUnreadCount() int64 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+func (x *Conversation) GetLastMessage() *LastMessage {
+	if x != nil {
+		return x.LastMessage
+	}
+	return nil
+}
+
+func (x *Conversation) GetIsPinned() bool {
+	if x != nil {
+		return x.IsPinned
+	}
+	return false
+}
+
+func (x *Conversation) GetIsMuted() bool {
+	if x != nil {
+		return x.IsMuted
+	}
+	return false
+}
+
+func (x *Conversation) GetDraft() string {
+	if x != nil {
+		return x.Draft
+	}
+	return ""
+}
+
+func (x *Conversation) GetDraftTime() int64 {
+	if x != nil {
+		return x.DraftTime
+	}
+	return 0
+}
+
+func (x *Conversation) GetUpdateTime() int64 {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return 0
+}
+
+type ListConversationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Size          int32                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConversationsRequest) Reset() {
+	*x = ListConversationsRequest{}
+	mi := &file_idl_conversation_v1_conversation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConversationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConversationsRequest) ProtoMessage() {}
+
+func (x *ListConversationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_conversation_v1_conversation_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConversationsRequest.ProtoReflect.Descriptor instead.
+func (*ListConversationsRequest) Descriptor() ([]byte, []int) {
+	return file_idl_conversation_v1_conversation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListConversationsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListConversationsRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type ListConversationsResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Conversations    []*Conversation        `protobuf:"bytes,1,rep,name=conversations,proto3" json:"conversations,omitempty"`
+	Total            int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	TotalUnreadCount int64                  `protobuf:"varint,3,opt,name=total_unread_count,json=totalUnreadCount,proto3" json:"total_unread_count,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListConversationsResponse) Reset() {
+	*x = ListConversationsResponse{}
+	mi := &file_idl_conversation_v1_conversation_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConversationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConversationsResponse) ProtoMessage() {}
+
+func (x *ListConversationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_conversation_v1_conversation_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConversationsResponse.ProtoReflect.Descriptor instead.
+func (*ListConversationsResponse) Descriptor() ([]byte, []int) {
+	return file_idl_conversation_v1_conversation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListConversationsResponse) GetConversations() []*Conversation {
+	if x != nil {
+		return x.Conversations
+	}
+	return nil
+}
+
+func (x *ListConversationsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListConversationsResponse) GetTotalUnreadCount() int64 {
+	if x != nil {
+		return x.TotalUnreadCount
+	}
+	return 0
+}
+
+type GetConversationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationID string                 `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetConversationRequest) Reset() {
+	*x = GetConversationRequest{}
+	mi := &file_idl_conversation_v1_conversation_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConversationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationRequest) ProtoMessage() {}
+
+func (x *GetConversationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_conversation_v1_conversation_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationRequest.ProtoReflect.Descriptor instead.
+func (*GetConversationRequest) Descriptor() ([]byte, []int) {
+	return file_idl_conversation_v1_conversation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetConversationRequest) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+type GetConversationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *Conversation          `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConversationResponse) Reset() {
+	*x = GetConversationResponse{}
+	mi := &file_idl_conversation_v1_conversation_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConversationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationResponse) ProtoMessage() {}
+
+func (x *GetConversationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_conversation_v1_conversation_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationResponse.ProtoReflect.Descriptor instead.
+func (*GetConversationResponse) Descriptor() ([]byte, []int) {
+	return file_idl_conversation_v1_conversation_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetConversationResponse) GetData() *Conversation {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type SetConversationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationID string                 `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+	IsPinned       *bool                  `protobuf:"varint,2,opt,name=is_pinned,json=isPinned,proto3,oneof" json:"is_pinned,omitempty"`
+	IsMuted        *bool                  `protobuf:"varint,3,opt,name=is_muted,json=isMuted,proto3,oneof" json:"is_muted,omitempty"`
+	Draft          *string                `protobuf:"bytes,4,opt,name=draft,proto3,oneof" json:"draft,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetConversationRequest) Reset() {
+	*x = SetConversationRequest{}
+	mi := &file_idl_conversation_v1_conversation_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetConversationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetConversationRequest) ProtoMessage() {}
+
+func (x *SetConversationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_conversation_v1_conversation_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetConversationRequest.ProtoReflect.Descriptor instead.
+func (*SetConversationRequest) Descriptor() ([]byte, []int) {
+	return file_idl_conversation_v1_conversation_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SetConversationRequest) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *SetConversationRequest) GetIsPinned() bool {
+	if x != nil && x.IsPinned != nil {
+		return *x.IsPinned
+	}
+	return false
+}
+
+func (x *SetConversationRequest) GetIsMuted() bool {
+	if x != nil && x.IsMuted != nil {
+		return *x.IsMuted
+	}
+	return false
+}
+
+func (x *SetConversationRequest) GetDraft() string {
+	if x != nil && x.Draft != nil {
+		return *x.Draft
+	}
+	return ""
+}
+
+type SetConversationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetConversationResponse) Reset() {
+	*x = SetConversationResponse{}
+	mi := &file_idl_conversation_v1_conversation_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetConversationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetConversationResponse) ProtoMessage() {}
+
+func (x *SetConversationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_conversation_v1_conversation_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetConversationResponse.ProtoReflect.Descriptor instead.
+func (*SetConversationResponse) Descriptor() ([]byte, []int) {
+	return file_idl_conversation_v1_conversation_proto_rawDescGZIP(), []int{7}
+}
+
+type MarkConversationAsReadRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationID string                 `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+	HasReadSeq     int64                  `protobuf:"varint,2,opt,name=has_read_seq,json=hasReadSeq,proto3" json:"has_read_seq,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MarkConversationAsReadRequest) Reset() {
+	*x = MarkConversationAsReadRequest{}
+	mi := &file_idl_conversation_v1_conversation_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkConversationAsReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkConversationAsReadRequest) ProtoMessage() {}
+
+func (x *MarkConversationAsReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_conversation_v1_conversation_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkConversationAsReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkConversationAsReadRequest) Descriptor() ([]byte, []int) {
+	return file_idl_conversation_v1_conversation_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MarkConversationAsReadRequest) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *MarkConversationAsReadRequest) GetHasReadSeq() int64 {
+	if x != nil {
+		return x.HasReadSeq
+	}
+	return 0
+}
+
+type MarkConversationAsReadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkConversationAsReadResponse) Reset() {
+	*x = MarkConversationAsReadResponse{}
+	mi := &file_idl_conversation_v1_conversation_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkConversationAsReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkConversationAsReadResponse) ProtoMessage() {}
+
+func (x *MarkConversationAsReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_conversation_v1_conversation_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkConversationAsReadResponse.ProtoReflect.Descriptor instead.
+func (*MarkConversationAsReadResponse) Descriptor() ([]byte, []int) {
+	return file_idl_conversation_v1_conversation_proto_rawDescGZIP(), []int{9}
+}
+
+type ConversationSeqs struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaxSeq        int64                  `protobuf:"varint,1,opt,name=max_seq,json=maxSeq,proto3" json:"max_seq,omitempty"`
+	HasReadSeq    int64                  `protobuf:"varint,2,opt,name=has_read_seq,json=hasReadSeq,proto3" json:"has_read_seq,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConversationSeqs) Reset() {
+	*x = ConversationSeqs{}
+	mi := &file_idl_conversation_v1_conversation_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConversationSeqs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConversationSeqs) ProtoMessage() {}
+
+func (x *ConversationSeqs) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_conversation_v1_conversation_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConversationSeqs.ProtoReflect.Descriptor instead.
+func (*ConversationSeqs) Descriptor() ([]byte, []int) {
+	return file_idl_conversation_v1_conversation_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ConversationSeqs) GetMaxSeq() int64 {
+	if x != nil {
+		return x.MaxSeq
+	}
+	return 0
+}
+
+func (x *ConversationSeqs) GetHasReadSeq() int64 {
+	if x != nil {
+		return x.HasReadSeq
+	}
+	return 0
+}
+
+type GetConversationsHasReadAndMaxSeqRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ConversationIDs []string               `protobuf:"bytes,1,rep,name=conversationIDs,proto3" json:"conversationIDs,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetConversationsHasReadAndMaxSeqRequest) Reset() {
+	*x = GetConversationsHasReadAndMaxSeqRequest{}
+	mi := &file_idl_conversation_v1_conversation_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConversationsHasReadAndMaxSeqRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationsHasReadAndMaxSeqRequest) ProtoMessage() {}
+
+func (x *GetConversationsHasReadAndMaxSeqRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_conversation_v1_conversation_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationsHasReadAndMaxSeqRequest.ProtoReflect.Descriptor instead.
+func (*GetConversationsHasReadAndMaxSeqRequest) Descriptor() ([]byte, []int) {
+	return file_idl_conversation_v1_conversation_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetConversationsHasReadAndMaxSeqRequest) GetConversationIDs() []string {
+	if x != nil {
+		return x.ConversationIDs
+	}
+	return nil
+}
+
+type GetConversationsHasReadAndMaxSeqResponse struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Seqs          map[string]*ConversationSeqs `protobuf:"bytes,1,rep,name=seqs,proto3" json:"seqs,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConversationsHasReadAndMaxSeqResponse) Reset() {
+	*x = GetConversationsHasReadAndMaxSeqResponse{}
+	mi := &file_idl_conversation_v1_conversation_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConversationsHasReadAndMaxSeqResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationsHasReadAndMaxSeqResponse) ProtoMessage() {}
+
+func (x *GetConversationsHasReadAndMaxSeqResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_conversation_v1_conversation_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationsHasReadAndMaxSeqResponse.ProtoReflect.Descriptor instead.
+func (*GetConversationsHasReadAndMaxSeqResponse) Descriptor() ([]byte, []int) {
+	return file_idl_conversation_v1_conversation_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetConversationsHasReadAndMaxSeqResponse) GetSeqs() map[string]*ConversationSeqs {
+	if x != nil {
+		return x.Seqs
+	}
+	return nil
+}
+
+type GetConversationsLastMessageRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ConversationIDs []string               `protobuf:"bytes,1,rep,name=conversationIDs,proto3" json:"conversationIDs,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetConversationsLastMessageRequest) Reset() {
+	*x = GetConversationsLastMessageRequest{}
+	mi := &file_idl_conversation_v1_conversation_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConversationsLastMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationsLastMessageRequest) ProtoMessage() {}
+
+func (x *GetConversationsLastMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_conversation_v1_conversation_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationsLastMessageRequest.ProtoReflect.Descriptor instead.
+func (*GetConversationsLastMessageRequest) Descriptor() ([]byte, []int) {
+	return file_idl_conversation_v1_conversation_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetConversationsLastMessageRequest) GetConversationIDs() []string {
+	if x != nil {
+		return x.ConversationIDs
+	}
+	return nil
+}
+
+type GetConversationsLastMessageResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// last_messages is keyed by conversationID, conversations without messages
+	// are left out.
+	LastMessages  map[string]*LastMessage `protobuf:"bytes,1,rep,name=last_messages,json=lastMessages,proto3" json:"last_messages,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConversationsLastMessageResponse) Reset() {
+	*x = GetConversationsLastMessageResponse{}
+	mi := &file_idl_conversation_v1_conversation_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConversationsLastMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationsLastMessageResponse) ProtoMessage() {}
+
+func (x *GetConversationsLastMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_conversation_v1_conversation_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationsLastMessageResponse.ProtoReflect.Descriptor instead.
+func (*GetConversationsLastMessageResponse) Descriptor() ([]byte, []int) {
+	return file_idl_conversation_v1_conversation_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetConversationsLastMessageResponse) GetLastMessages() map[string]*LastMessage {
+	if x != nil {
+		return x.LastMessages
+	}
+	return nil
+}
+
+type UpdateConversationsByMessageRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ConversationID   string                 `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+	ConversationType int32                  `protobuf:"varint,2,opt,name=conversation_type,json=conversationType,proto3" json:"conversation_type,omitempty"`
+	RecvID           int64                  `protobuf:"varint,3,opt,name=recvID,proto3" json:"recvID,omitempty"`
+	GroupID          int64                  `protobuf:"varint,4,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	OwnerIDs         []int64                `protobuf:"varint,5,rep,packed,name=ownerIDs,proto3" json:"ownerIDs,omitempty"`
+	LastMessage      *LastMessage           `protobuf:"bytes,6,opt,name=last_message,json=lastMessage,proto3" json:"last_message,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateConversationsByMessageRequest) Reset() {
+	*x = UpdateConversationsByMessageRequest{}
+	mi := &file_idl_conversation_v1_conversation_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateConversationsByMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateConversationsByMessageRequest) ProtoMessage() {}
+
+func (x *UpdateConversationsByMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_conversation_v1_conversation_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateConversationsByMessageRequest.ProtoReflect.Descriptor instead.
+func (*UpdateConversationsByMessageRequest) Descriptor() ([]byte, []int) {
+	return file_idl_conversation_v1_conversation_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpdateConversationsByMessageRequest) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *UpdateConversationsByMessageRequest) GetConversationType() int32 {
+	if x != nil {
+		return x.ConversationType
+	}
+	return 0
+}
+
+func (x *UpdateConversationsByMessageRequest) GetRecvID() int64 {
+	if x != nil {
+		return x.RecvID
+	}
+	return 0
+}
+
+func (x *UpdateConversationsByMessageRequest) GetGroupID() int64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+func (x *UpdateConversationsByMessageRequest) GetOwnerIDs() []int64 {
+	if x != nil {
+		return x.OwnerIDs
+	}
+	return nil
+}
+
+func (x *UpdateConversationsByMessageRequest) GetLastMessage() *LastMessage {
+	if x != nil {
+		return x.LastMessage
+	}
+	return nil
+}
+
+type UpdateConversationsByMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateConversationsByMessageResponse) Reset() {
+	*x = UpdateConversationsByMessageResponse{}
+	mi := &file_idl_conversation_v1_conversation_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateConversationsByMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateConversationsByMessageResponse) ProtoMessage() {}
+
+func (x *UpdateConversationsByMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_conversation_v1_conversation_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateConversationsByMessageResponse.ProtoReflect.Descriptor instead.
+func (*UpdateConversationsByMessageResponse) Descriptor() ([]byte, []int) {
+	return file_idl_conversation_v1_conversation_proto_rawDescGZIP(), []int{16}
+}
+
+type GetMutedOwnerIDsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationID string                 `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+	OwnerIDs       []int64                `protobuf:"varint,2,rep,packed,name=ownerIDs,proto3" json:"ownerIDs,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetMutedOwnerIDsRequest) Reset() {
+	*x = GetMutedOwnerIDsRequest{}
+	mi := &file_idl_conversation_v1_conversation_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMutedOwnerIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMutedOwnerIDsRequest) ProtoMessage() {}
+
+func (x *GetMutedOwnerIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_conversation_v1_conversation_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMutedOwnerIDsRequest.ProtoReflect.Descriptor instead.
+func (*GetMutedOwnerIDsRequest) Descriptor() ([]byte, []int) {
+	return file_idl_conversation_v1_conversation_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetMutedOwnerIDsRequest) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *GetMutedOwnerIDsRequest) GetOwnerIDs() []int64 {
+	if x != nil {
+		return x.OwnerIDs
+	}
+	return nil
+}
+
+type GetMutedOwnerIDsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MutedOwnerIDs []int64                `protobuf:"varint,1,rep,packed,name=mutedOwnerIDs,proto3" json:"mutedOwnerIDs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMutedOwnerIDsResponse) Reset() {
+	*x = GetMutedOwnerIDsResponse{}
+	mi := &file_idl_conversation_v1_conversation_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMutedOwnerIDsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMutedOwnerIDsResponse) ProtoMessage() {}
+
+func (x *GetMutedOwnerIDsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_conversation_v1_conversation_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMutedOwnerIDsResponse.ProtoReflect.Descriptor instead.
+func (*GetMutedOwnerIDsResponse) Descriptor() ([]byte, []int) {
+	return file_idl_conversation_v1_conversation_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetMutedOwnerIDsResponse) GetMutedOwnerIDs() []int64 {
+	if x != nil {
+		return x.MutedOwnerIDs
+	}
+	return nil
+}
+
+var File_idl_conversation_v1_conversation_proto protoreflect.FileDescriptor
+
+const file_idl_conversation_v1_conversation_proto_rawDesc = "" +
+	"\n" +
+	"&idl/conversation/v1/conversation.proto\x12\x0fconversation.v1\"\xb2\x01\n" +
+	"\vLastMessage\x12 \n" +
+	"\vserverMsgID\x18\x01 \x01(\x03R\vserverMsgID\x12\x16\n" +
+	"\x06sendID\x18\x02 \x01(\x03R\x06sendID\x12 \n" +
+	"\vcontentType\x18\x03 \x01(\x05R\vcontentType\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\fR\acontent\x12\x10\n" +
+	"\x03seq\x18\x05 \x01(\x03R\x03seq\x12\x1b\n" +
+	"\tsend_time\x18\x06 \x01(\x03R\bsendTime\"\xdc\x03\n" +
+	"\fConversation\x12&\n" +
+	"\x0econversationID\x18\x01 \x01(\tR\x0econversationID\x12\x18\n" +
+	"\aownerID\x18\x02 \x01(\x03R\aownerID\x12+\n" +
+	"\x11conversation_type\x18\x03 \x01(\x05R\x10conversationType\x12\x16\n" +
+	"\x06peerID\x18\x04 \x01(\x03R\x06peerID\x12\x18\n" +
+	"\agroupID\x18\x05 \x01(\x03R\agroupID\x12\x17\n" +
+	"\amax_seq\x18\x06 \x01(\x03R\x06maxSeq\x12 \n" +
+	"\fhas_read_seq\x18\a \x01(\x03R\n" +
+	"hasReadSeq\x12!\n" +
+	"\funread_count\x18\b \x01(\x03R\vunreadCount\x12?\n" +
+	"\flast_message\x18\t \x01(\v2\x1c.conversation.v1.LastMessageR\vlastMessage\x12\x1b\n" +
+	"\tis_pinned\x18\n" +
+	" \x01(\bR\bisPinned\x12\x19\n" +
+	"\bis_muted\x18\v \x01(\bR\aisMuted\x12\x14\n" +
+	"\x05draft\x18\f \x01(\tR\x05draft\x12\x1d\n" +
+	"\n" +
+	"draft_time\x18\r \x01(\x03R\tdraftTime\x12\x1f\n" +
+	"\vupdate_time\x18\x0e \x01(\x03R\n" +
+	"updateTime\"B\n" +
+	"\x18ListConversationsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x05R\x04size\"\xa4\x01\n" +
+	"\x19ListConversationsResponse\x12C\n" +
+	"\rconversations\x18\x01 \x03(\v2\x1d.conversation.v1.ConversationR\rconversations\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12,\n" +
+	"\x12total_unread_count\x18\x03 \x01(\x03R\x10totalUnreadCount\"@\n" +
+	"\x16GetConversationRequest\x12&\n" +
+	"\x0econversationID\x18\x01 \x01(\tR\x0econversationID\"L\n" +
+	"\x17GetConversationResponse\x121\n" +
+	"\x04data\x18\x01 \x01(\v2\x1d.conversation.v1.ConversationR\x04data\"\xc2\x01\n" +
+	"\x16SetConversationRequest\x12&\n" +
+	"\x0econversationID\x18\x01 \x01(\tR\x0econversationID\x12 \n" +
+	"\tis_pinned\x18\x02 \x01(\bH\x00R\bisPinned\x88\x01\x01\x12\x1e\n" +
+	"\bis_muted\x18\x03 \x01(\bH\x01R\aisMuted\x88\x01\x01\x12\x19\n" +
+	"\x05draft\x18\x04 \x01(\tH\x02R\x05draft\x88\x01\x01B\f\n" +
+	"\n" +
+	"_is_pinnedB\v\n" +
+	"\t_is_mutedB\b\n" +
+	"\x06_draft\"\x19\n" +
+	"\x17SetConversationResponse\"i\n" +
+	"\x1dMarkConversationAsReadRequest\x12&\n" +
+	"\x0econversationID\x18\x01 \x01(\tR\x0econversationID\x12 \n" +
+	"\fhas_read_seq\x18\x02 \x01(\x03R\n" +
+	"hasReadSeq\" \n" +
+	"\x1eMarkConversationAsReadResponse\"M\n" +
+	"\x10ConversationSeqs\x12\x17\n" +
+	"\amax_seq\x18\x01 \x01(\x03R\x06maxSeq\x12 \n" +
+	"\fhas_read_seq\x18\x02 \x01(\x03R\n" +
+	"hasReadSeq\"S\n" +
+	"'GetConversationsHasReadAndMaxSeqRequest\x12(\n" +
+	"\x0fconversationIDs\x18\x01 \x03(\tR\x0fconversationIDs\"\xdf\x01\n" +
+	"(GetConversationsHasReadAndMaxSeqResponse\x12W\n" +
+	"\x04seqs\x18\x01 \x03(\v2C.conversation.v1.GetConversationsHasReadAndMaxSeqResponse.SeqsEntryR\x04seqs\x1aZ\n" +
+	"\tSeqsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x127\n" +
+	"\x05value\x18\x02 \x01(\v2!.conversation.v1.ConversationSeqsR\x05value:\x028\x01\"N\n" +
+	"\"GetConversationsLastMessageRequest\x12(\n" +
+	"\x0fconversationIDs\x18\x01 \x03(\tR\x0fconversationIDs\"\xf1\x01\n" +
+	"#GetConversationsLastMessageResponse\x12k\n" +
+	"\rlast_messages\x18\x01 \x03(\v2F.conversation.v1.GetConversationsLastMessageResponse.LastMessagesEntryR\flastMessages\x1a]\n" +
+	"\x11LastMessagesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x122\n" +
+	"\x05value\x18\x02 \x01(\v2\x1c.conversation.v1.LastMessageR\x05value:\x028\x01\"\x89\x02\n" +
+	"#UpdateConversationsByMessageRequest\x12&\n" +
+	"\x0econversationID\x18\x01 \x01(\tR\x0econversationID\x12+\n" +
+	"\x11conversation_type\x18\x02 \x01(\x05R\x10conversationType\x12\x16\n" +
+	"\x06recvID\x18\x03 \x01(\x03R\x06recvID\x12\x18\n" +
+	"\agroupID\x18\x04 \x01(\x03R\agroupID\x12\x1a\n" +
+	"\bownerIDs\x18\x05 \x03(\x03R\bownerIDs\x12?\n" +
+	"\flast_message\x18\x06 \x01(\v2\x1c.conversation.v1.LastMessageR\vlastMessage\"&\n" +
+	"$UpdateConversationsByMessageResponse\"]\n" +
+	"\x17GetMutedOwnerIDsRequest\x12&\n" +
+	"\x0econversationID\x18\x01 \x01(\tR\x0econversationID\x12\x1a\n" +
+	"\bownerIDs\x18\x02 \x03(\x03R\bownerIDs\"@\n" +
+	"\x18GetMutedOwnerIDsResponse\x12$\n" +
+	"\rmutedOwnerIDs\x18\x01 \x03(\x03R\rmutedOwnerIDs2\xdb\x05\n" +
+	"\x13ConversationService\x12j\n" +
+	"\x11ListConversations\x12).conversation.v1.ListConversationsRequest\x1a*.conversation.v1.ListConversationsResponse\x12d\n" +
+	"\x0fGetConversation\x12'.conversation.v1.GetConversationRequest\x1a(.conversation.v1.GetConversationResponse\x12d\n" +
+	"\x0fSetConversation\x12'.conversation.v1.SetConversationRequest\x1a(.conversation.v1.SetConversationResponse\x12\x97\x01\n" +
+	" GetConversationsHasReadAndMaxSeq\x128.conversation.v1.GetConversationsHasReadAndMaxSeqRequest\x1a9.conversation.v1.GetConversationsHasReadAndMaxSeqResponse\x12\x88\x01\n" +
+	"\x1bGetConversationsLastMessage\x123.conversation.v1.GetConversationsLastMessageRequest\x1a4.conversation.v1.GetConversationsLastMessageResponse\x12g\n" +
+	"\x10GetMutedOwnerIDs\x12(.conversation.v1.GetMutedOwnerIDsRequest\x1a).conversation.v1.GetMutedOwnerIDsResponse2\xa6\x02\n" +
+	"\x1bConversationInternalService\x12\x8b\x01\n" +
+	"\x1cUpdateConversationsByMessage\x124.conversation.v1.UpdateConversationsByMessageRequest\x1a5.conversation.v1.UpdateConversationsByMessageResponse\x12y\n" +
+	"\x16MarkConversationAsRead\x12..conversation.v1.MarkConversationAsReadRequest\x1a/.conversation.v1.MarkConversationAsReadResponseBFZDgithub.com/crazyfrankie/goim/protocol/conversation/v1;conversationv1b\x06proto3"
+
+var (
+	file_idl_conversation_v1_conversation_proto_rawDescOnce sync.Once
+	file_idl_conversation_v1_conversation_proto_rawDescData []byte
+)
+
+func file_idl_conversation_v1_conversation_proto_rawDescGZIP() []byte {
+	file_idl_conversation_v1_conversation_proto_rawDescOnce.Do(func() {
+		file_idl_conversation_v1_conversation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_idl_conversation_v1_conversation_proto_rawDesc), len(file_idl_conversation_v1_conversation_proto_rawDesc)))
+	})
+	return file_idl_conversation_v1_conversation_proto_rawDescData
+}
+
+var file_idl_conversation_v1_conversation_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_idl_conversation_v1_conversation_proto_goTypes = []any{
+	(*LastMessage)(nil),                              // 0: conversation.v1.LastMessage
+	(*Conversation)(nil),                             // 1: conversation.v1.Conversation
+	(*ListConversationsRequest)(nil),                 // 2: conversation.v1.ListConversationsRequest
+	(*ListConversationsResponse)(nil),                // 3: conversation.v1.ListConversationsResponse
+	(*GetConversationRequest)(nil),                   // 4: conversation.v1.GetConversationRequest
+	(*GetConversationResponse)(nil),                  // 5: conversation.v1.GetConversationResponse
+	(*SetConversationRequest)(nil),                   // 6: conversation.v1.SetConversationRequest
+	(*SetConversationResponse)(nil),                  // 7: conversation.v1.SetConversationResponse
+	(*MarkConversationAsReadRequest)(nil),            // 8: conversation.v1.MarkConversationAsReadRequest
+	(*MarkConversationAsReadResponse)(nil),           // 9: conversation.v1.MarkConversationAsReadResponse
+	(*ConversationSeqs)(nil),                         // 10: conversation.v1.ConversationSeqs
+	(*GetConversationsHasReadAndMaxSeqRequest)(nil),  // 11: conversation.v1.GetConversationsHasReadAndMaxSeqRequest
+	(*GetConversationsHasReadAndMaxSeqResponse)(nil), // 12: conversation.v1.GetConversationsHasReadAndMaxSeqResponse
+	(*GetConversationsLastMessageRequest)(nil),       // 13: conversation.v1.GetConversationsLastMessageRequest
+	(*GetConversationsLastMessageResponse)(nil),      // 14: conversation.v1.GetConversationsLastMessageResponse
+	(*UpdateConversationsByMessageRequest)(nil),      // 15: conversation.v1.UpdateConversationsByMessageRequest
+	(*UpdateConversationsByMessageResponse)(nil),     // 16: conversation.v1.UpdateConversationsByMessageResponse
+	(*GetMutedOwnerIDsRequest)(nil),                  // 17: conversation.v1.GetMutedOwnerIDsRequest
+	(*GetMutedOwnerIDsResponse)(nil),                 // 18: conversation.v1.GetMutedOwnerIDsResponse
+	nil,                                              // 19: conversation.v1.GetConversationsHasReadAndMaxSeqResponse.SeqsEntry
+	nil,                                              // 20: conversation.v1.GetConversationsLastMessageResponse.LastMessagesEntry
+}
+var file_idl_conversation_v1_conversation_proto_depIdxs = []int32{
+	0,  // 0: conversation.v1.Conversation.last_message:type_name -> conversation.v1.LastMessage
+	1,  // 1: conversation.v1.ListConversationsResponse.conversations:type_name -> conversation.v1.Conversation
+	1,  // 2: conversation.v1.GetConversationResponse.data:type_name -> conversation.v1.Conversation
+	19, // 3: conversation.v1.GetConversationsHasReadAndMaxSeqResponse.seqs:type_name -> conversation.v1.GetConversationsHasReadAndMaxSeqResponse.SeqsEntry
+	20, // 4: conversation.v1.GetConversationsLastMessageResponse.last_messages:type_name -> conversation.v1.GetConversationsLastMessageResponse.LastMessagesEntry
+	0,  // 5: conversation.v1.UpdateConversationsByMessageRequest.last_message:type_name -> conversation.v1.LastMessage
+	10, // 6: conversation.v1.GetConversationsHasReadAndMaxSeqResponse.SeqsEntry.value:type_name -> conversation.v1.ConversationSeqs
+	0,  // 7: conversation.v1.GetConversationsLastMessageResponse.LastMessagesEntry.value:type_name -> conversation.v1.LastMessage
+	2,  // 8: conversation.v1.ConversationService.ListConversations:input_type -> conversation.v1.ListConversationsRequest
+	4,  // 9: conversation.v1.ConversationService.GetConversation:input_type -> conversation.v1.GetConversationRequest
+	6,  // 10: conversation.v1.ConversationService.SetConversation:input_type -> conversation.v1.SetConversationRequest
+	11, // 11: conversation.v1.ConversationService.GetConversationsHasReadAndMaxSeq:input_type -> conversation.v1.GetConversationsHasReadAndMaxSeqRequest
+	13, // 12: conversation.v1.ConversationService.GetConversationsLastMessage:input_type -> conversation.v1.GetConversationsLastMessageRequest
+	17, // 13: conversation.v1.ConversationService.GetMutedOwnerIDs:input_type -> conversation.v1.GetMutedOwnerIDsRequest
+	15, // 14: conversation.v1.ConversationInternalService.UpdateConversationsByMessage:input_type -> conversation.v1.UpdateConversationsByMessageRequest
+	8,  // 15: conversation.v1.ConversationInternalService.MarkConversationAsRead:input_type -> conversation.v1.MarkConversationAsReadRequest
+	3,  // 16: conversation.v1.ConversationService.ListConversations:output_type -> conversation.v1.ListConversationsResponse
+	5,  // 17: conversation.v1.ConversationService.GetConversation:output_type -> conversation.v1.GetConversationResponse
+	7,  // 18: conversation.v1.ConversationService.SetConversation:output_type -> conversation.v1.SetConversationResponse
+	12, // 19: conversation.v1.ConversationService.GetConversationsHasReadAndMaxSeq:output_type -> conversation.v1.GetConversationsHasReadAndMaxSeqResponse
+	14, // 20: conversation.v1.ConversationService.GetConversationsLastMessage:output_type -> conversation.v1.GetConversationsLastMessageResponse
+	18, // 21: conversation.v1.ConversationService.GetMutedOwnerIDs:output_type -> conversation.v1.GetMutedOwnerIDsResponse
+	16, // 22: conversation.v1.ConversationInternalService.UpdateConversationsByMessage:output_type -> conversation.v1.UpdateConversationsByMessageResponse
+	9,  // 23: conversation.v1.ConversationInternalService.MarkConversationAsRead:output_type -> conversation.v1.MarkConversationAsReadResponse
+	16, // [16:24] is the sub-list for method output_type
+	8,  // [8:16] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_idl_conversation_v1_conversation_proto_init() }
+func file_idl_conversation_v1_conversation_proto_init() {
+	if File_idl_conversation_v1_conversation_proto != nil {
+		return
+	}
+	file_idl_conversation_v1_conversation_proto_msgTypes[6].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_idl_conversation_v1_conversation_proto_rawDesc), len(file_idl_conversation_v1_conversation_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   21,
+			NumExtensions: 0,
+			NumServices:   2,
+		},
+		GoTypes:           file_idl_conversation_v1_conversation_proto_goTypes,
+		DependencyIndexes: file_idl_conversation_v1_conversation_proto_depIdxs,
+		MessageInfos:      file_idl_conversation_v1_conversation_proto_msgTypes,
+	}.Build()
+	File_idl_conversation_v1_conversation_proto = out.File
+	file_idl_conversation_v1_conversation_proto_goTypes = nil
+	file_idl_conversation_v1_conversation_proto_depIdxs = nil
+}
@@ -0,0 +1,468 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: idl/conversation/v1/conversation.proto
+
+package conversationv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ConversationService_ListConversations_FullMethodName                = "/conversation.v1.ConversationService/ListConversations"
+	ConversationService_GetConversation_FullMethodName                  = "/conversation.v1.ConversationService/GetConversation"
+	ConversationService_SetConversation_FullMethodName                  = "/conversation.v1.ConversationService/SetConversation"
+	ConversationService_GetConversationsHasReadAndMaxSeq_FullMethodName = "/conversation.v1.ConversationService/GetConversationsHasReadAndMaxSeq"
+	ConversationService_GetConversationsLastMessage_FullMethodName      = "/conversation.v1.ConversationService/GetConversationsLastMessage"
+	ConversationService_GetMutedOwnerIDs_FullMethodName                 = "/conversation.v1.ConversationService/GetMutedOwnerIDs"
+)
+
+// ConversationServiceClient is the client API for ConversationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ConversationServiceClient interface {
+	ListConversations(ctx context.Context, in *ListConversationsRequest, opts ...grpc.CallOption) (*ListConversationsResponse, error)
+	GetConversation(ctx context.Context, in *GetConversationRequest, opts ...grpc.CallOption) (*GetConversationResponse, error)
+	SetConversation(ctx context.Context, in *SetConversationRequest, opts ...grpc.CallOption) (*SetConversationResponse, error)
+	GetConversationsHasReadAndMaxSeq(ctx context.Context, in *GetConversationsHasReadAndMaxSeqRequest, opts ...grpc.CallOption) (*GetConversationsHasReadAndMaxSeqResponse, error)
+	GetConversationsLastMessage(ctx context.Context, in *GetConversationsLastMessageRequest, opts ...grpc.CallOption) (*GetConversationsLastMessageResponse, error)
+	// GetMutedOwnerIDs returns those of ownerIDs who muted the conversation, so that no offline push disturbs them.
+	GetMutedOwnerIDs(ctx context.Context, in *GetMutedOwnerIDsRequest, opts ...grpc.CallOption) (*GetMutedOwnerIDsResponse, error)
+}
+
+type conversationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewConversationServiceClient(cc grpc.ClientConnInterface) ConversationServiceClient {
+	return &conversationServiceClient{cc}
+}
+
+func (c *conversationServiceClient) ListConversations(ctx context.Context, in *ListConversationsRequest, opts ...grpc.CallOption) (*ListConversationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListConversationsResponse)
+	err := c.cc.Invoke(ctx, ConversationService_ListConversations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) GetConversation(ctx context.Context, in *GetConversationRequest, opts ...grpc.CallOption) (*GetConversationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetConversationResponse)
+	err := c.cc.Invoke(ctx, ConversationService_GetConversation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) SetConversation(ctx context.Context, in *SetConversationRequest, opts ...grpc.CallOption) (*SetConversationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetConversationResponse)
+	err := c.cc.Invoke(ctx, ConversationService_SetConversation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) GetConversationsHasReadAndMaxSeq(ctx context.Context, in *GetConversationsHasReadAndMaxSeqRequest, opts ...grpc.CallOption) (*GetConversationsHasReadAndMaxSeqResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetConversationsHasReadAndMaxSeqResponse)
+	err := c.cc.Invoke(ctx, ConversationService_GetConversationsHasReadAndMaxSeq_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) GetConversationsLastMessage(ctx context.Context, in *GetConversationsLastMessageRequest, opts ...grpc.CallOption) (*GetConversationsLastMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetConversationsLastMessageResponse)
+	err := c.cc.Invoke(ctx, ConversationService_GetConversationsLastMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) GetMutedOwnerIDs(ctx context.Context, in *GetMutedOwnerIDsRequest, opts ...grpc.CallOption) (*GetMutedOwnerIDsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMutedOwnerIDsResponse)
+	err := c.cc.Invoke(ctx, ConversationService_GetMutedOwnerIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ConversationServiceServer is the server API for ConversationService service.
+// All implementations must embed UnimplementedConversationServiceServer
+// for forward compatibility.
+type ConversationServiceServer interface {
+	ListConversations(context.Context, *ListConversationsRequest) (*ListConversationsResponse, error)
+	GetConversation(context.Context, *GetConversationRequest) (*GetConversationResponse, error)
+	SetConversation(context.Context, *SetConversationRequest) (*SetConversationResponse, error)
+	GetConversationsHasReadAndMaxSeq(context.Context, *GetConversationsHasReadAndMaxSeqRequest) (*GetConversationsHasReadAndMaxSeqResponse, error)
+	GetConversationsLastMessage(context.Context, *GetConversationsLastMessageRequest) (*GetConversationsLastMessageResponse, error)
+	// GetMutedOwnerIDs returns those of ownerIDs who muted the conversation, so that no offline push disturbs them.
+	GetMutedOwnerIDs(context.Context, *GetMutedOwnerIDsRequest) (*GetMutedOwnerIDsResponse, error)
+	mustEmbedUnimplementedConversationServiceServer()
+}
+
+// UnimplementedConversationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedConversationServiceServer struct{}
+
+func (UnimplementedConversationServiceServer) ListConversations(context.Context, *ListConversationsRequest) (*ListConversationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConversations not implemented")
+}
+func (UnimplementedConversationServiceServer) GetConversation(context.Context, *GetConversationRequest) (*GetConversationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConversation not implemented")
+}
+func (UnimplementedConversationServiceServer) SetConversation(context.Context, *SetConversationRequest) (*SetConversationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetConversation not implemented")
+}
+func (UnimplementedConversationServiceServer) GetConversationsHasReadAndMaxSeq(context.Context, *GetConversationsHasReadAndMaxSeqRequest) (*GetConversationsHasReadAndMaxSeqResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConversationsHasReadAndMaxSeq not implemented")
+}
+func (UnimplementedConversationServiceServer) GetConversationsLastMessage(context.Context, *GetConversationsLastMessageRequest) (*GetConversationsLastMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConversationsLastMessage not implemented")
+}
+func (UnimplementedConversationServiceServer) GetMutedOwnerIDs(context.Context, *GetMutedOwnerIDsRequest) (*GetMutedOwnerIDsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMutedOwnerIDs not implemented")
+}
+func (UnimplementedConversationServiceServer) mustEmbedUnimplementedConversationServiceServer() {}
+func (UnimplementedConversationServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeConversationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ConversationServiceServer will
+// result in compilation errors.
+type UnsafeConversationServiceServer interface {
+	mustEmbedUnimplementedConversationServiceServer()
+}
+
+func RegisterConversationServiceServer(s grpc.ServiceRegistrar, srv ConversationServiceServer) {
+	// If the following call pancis, it indicates UnimplementedConversationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ConversationService_ServiceDesc, srv)
+}
+
+func _ConversationService_ListConversations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConversationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).ListConversations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_ListConversations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).ListConversations(ctx, req.(*ListConversationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_GetConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConversationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).GetConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_GetConversation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).GetConversation(ctx, req.(*GetConversationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_SetConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetConversationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).SetConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_SetConversation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).SetConversation(ctx, req.(*SetConversationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_GetConversationsHasReadAndMaxSeq_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConversationsHasReadAndMaxSeqRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).GetConversationsHasReadAndMaxSeq(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_GetConversationsHasReadAndMaxSeq_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).GetConversationsHasReadAndMaxSeq(ctx, req.(*GetConversationsHasReadAndMaxSeqRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_GetConversationsLastMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConversationsLastMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).GetConversationsLastMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_GetConversationsLastMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).GetConversationsLastMessage(ctx, req.(*GetConversationsLastMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_GetMutedOwnerIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMutedOwnerIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).GetMutedOwnerIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_GetMutedOwnerIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).GetMutedOwnerIDs(ctx, req.(*GetMutedOwnerIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ConversationService_ServiceDesc is the grpc.ServiceDesc for ConversationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ConversationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "conversation.v1.ConversationService",
+	HandlerType: (*ConversationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListConversations",
+			Handler:    _ConversationService_ListConversations_Handler,
+		},
+		{
+			MethodName: "GetConversation",
+			Handler:    _ConversationService_GetConversation_Handler,
+		},
+		{
+			MethodName: "SetConversation",
+			Handler:    _ConversationService_SetConversation_Handler,
+		},
+		{
+			MethodName: "GetConversationsHasReadAndMaxSeq",
+			Handler:    _ConversationService_GetConversationsHasReadAndMaxSeq_Handler,
+		},
+		{
+			MethodName: "GetConversationsLastMessage",
+			Handler:    _ConversationService_GetConversationsLastMessage_Handler,
+		},
+		{
+			MethodName: "GetMutedOwnerIDs",
+			Handler:    _ConversationService_GetMutedOwnerIDs_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "idl/conversation/v1/conversation.proto",
+}
+
+const (
+	ConversationInternalService_UpdateConversationsByMessage_FullMethodName = "/conversation.v1.ConversationInternalService/UpdateConversationsByMessage"
+	ConversationInternalService_MarkConversationAsRead_FullMethodName       = "/conversation.v1.ConversationInternalService/MarkConversationAsRead"
+)
+
+// ConversationInternalServiceClient is the client API for ConversationInternalService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ConversationInternalService is called by the message service only, the
+// gateway and the HTTP servers never expose it to clients.
+type ConversationInternalServiceClient interface {
+	// UpdateConversationsByMessage advances the conversations of the owners a
+	// stored message lands in.
+	UpdateConversationsByMessage(ctx context.Context, in *UpdateConversationsByMessageRequest, opts ...grpc.CallOption) (*UpdateConversationsByMessageResponse, error)
+	// MarkConversationAsRead keeps the unread count of the caller's conversation
+	// in step with the read cursor, which the message service owns.
+	MarkConversationAsRead(ctx context.Context, in *MarkConversationAsReadRequest, opts ...grpc.CallOption) (*MarkConversationAsReadResponse, error)
+}
+
+type conversationInternalServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewConversationInternalServiceClient(cc grpc.ClientConnInterface) ConversationInternalServiceClient {
+	return &conversationInternalServiceClient{cc}
+}
+
+func (c *conversationInternalServiceClient) UpdateConversationsByMessage(ctx context.Context, in *UpdateConversationsByMessageRequest, opts ...grpc.CallOption) (*UpdateConversationsByMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateConversationsByMessageResponse)
+	err := c.cc.Invoke(ctx, ConversationInternalService_UpdateConversationsByMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationInternalServiceClient) MarkConversationAsRead(ctx context.Context, in *MarkConversationAsReadRequest, opts ...grpc.CallOption) (*MarkConversationAsReadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkConversationAsReadResponse)
+	err := c.cc.Invoke(ctx, ConversationInternalService_MarkConversationAsRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ConversationInternalServiceServer is the server API for ConversationInternalService service.
+// All implementations must embed UnimplementedConversationInternalServiceServer
+// for forward compatibility.
+//
+// ConversationInternalService is called by the message service only, the
+// gateway and the HTTP servers never expose it to clients.
+type ConversationInternalServiceServer interface {
+	// UpdateConversationsByMessage advances the conversations of the owners a
+	// stored message lands in.
+	UpdateConversationsByMessage(context.Context, *UpdateConversationsByMessageRequest) (*UpdateConversationsByMessageResponse, error)
+	// MarkConversationAsRead keeps the unread count of the caller's conversation
+	// in step with the read cursor, which the message service owns.
+	MarkConversationAsRead(context.Context, *MarkConversationAsReadRequest) (*MarkConversationAsReadResponse, error)
+	mustEmbedUnimplementedConversationInternalServiceServer()
+}
+
+// UnimplementedConversationInternalServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedConversationInternalServiceServer struct{}
+
+func (UnimplementedConversationInternalServiceServer) UpdateConversationsByMessage(context.Context, *UpdateConversationsByMessageRequest) (*UpdateConversationsByMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateConversationsByMessage not implemented")
+}
+func (UnimplementedConversationInternalServiceServer) MarkConversationAsRead(context.Context, *MarkConversationAsReadRequest) (*MarkConversationAsReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkConversationAsRead not implemented")
+}
+func (UnimplementedConversationInternalServiceServer) mustEmbedUnimplementedConversationInternalServiceServer() {
+}
+func (UnimplementedConversationInternalServiceServer) testEmbeddedByValue() {}
+
+// UnsafeConversationInternalServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ConversationInternalServiceServer will
+// result in compilation errors.
+type UnsafeConversationInternalServiceServer interface {
+	mustEmbedUnimplementedConversationInternalServiceServer()
+}
+
+func RegisterConversationInternalServiceServer(s grpc.ServiceRegistrar, srv ConversationInternalServiceServer) {
+	// If the following call pancis, it indicates UnimplementedConversationInternalServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ConversationInternalService_ServiceDesc, srv)
+}
+
+func _ConversationInternalService_UpdateConversationsByMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateConversationsByMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationInternalServiceServer).UpdateConversationsByMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationInternalService_UpdateConversationsByMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationInternalServiceServer).UpdateConversationsByMessage(ctx, req.(*UpdateConversationsByMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationInternalService_MarkConversationAsRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkConversationAsReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationInternalServiceServer).MarkConversationAsRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationInternalService_MarkConversationAsRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationInternalServiceServer).MarkConversationAsRead(ctx, req.(*MarkConversationAsReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ConversationInternalService_ServiceDesc is the grpc.ServiceDesc for ConversationInternalService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ConversationInternalService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "conversation.v1.ConversationInternalService",
+	HandlerType: (*ConversationInternalServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateConversationsByMessage",
+			Handler:    _ConversationInternalService_UpdateConversationsByMessage_Handler,
+		},
+		{
+			MethodName: "MarkConversationAsRead",
+			Handler:    _ConversationInternalService_MarkConversationAsRead_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "idl/conversation/v1/conversation.proto",
+}
@@ -0,0 +1,1779 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: idl/gateway/v1/gateway.proto
+
+package gatewayv1
+
+import (
+	v1 "github.com/crazyfrankie/goim/protocol/message/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetUsersOnlineStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIDs       []string               `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsersOnlineStatusRequest) Reset() {
+	*x = GetUsersOnlineStatusRequest{}
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsersOnlineStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsersOnlineStatusRequest) ProtoMessage() {}
+
+func (x *GetUsersOnlineStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsersOnlineStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetUsersOnlineStatusRequest) Descriptor() ([]byte, []int) {
+	return file_idl_gateway_v1_gateway_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetUsersOnlineStatusRequest) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+type GetUsersOnlineStatusResponse struct {
+	state         protoimpl.MessageState                        `protogen:"open.v1"`
+	SuccessResult []*GetUsersOnlineStatusResponse_SuccessResult `protobuf:"bytes,1,rep,name=successResult,proto3" json:"successResult,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsersOnlineStatusResponse) Reset() {
+	*x = GetUsersOnlineStatusResponse{}
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsersOnlineStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsersOnlineStatusResponse) ProtoMessage() {}
+
+func (x *GetUsersOnlineStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsersOnlineStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetUsersOnlineStatusResponse) Descriptor() ([]byte, []int) {
+	return file_idl_gateway_v1_gateway_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetUsersOnlineStatusResponse) GetSuccessResult() []*GetUsersOnlineStatusResponse_SuccessResult {
+	if x != nil {
+		return x.SuccessResult
+	}
+	return nil
+}
+
+type OnlineBatchPushOneMsgRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PushToUserIDs []string               `protobuf:"bytes,1,rep,name=pushToUserIDs,proto3" json:"pushToUserIDs,omitempty"`
+	MsgData       *v1.Message            `protobuf:"bytes,2,opt,name=msgData,proto3" json:"msgData,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OnlineBatchPushOneMsgRequest) Reset() {
+	*x = OnlineBatchPushOneMsgRequest{}
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnlineBatchPushOneMsgRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnlineBatchPushOneMsgRequest) ProtoMessage() {}
+
+func (x *OnlineBatchPushOneMsgRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnlineBatchPushOneMsgRequest.ProtoReflect.Descriptor instead.
+func (*OnlineBatchPushOneMsgRequest) Descriptor() ([]byte, []int) {
+	return file_idl_gateway_v1_gateway_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *OnlineBatchPushOneMsgRequest) GetPushToUserIDs() []string {
+	if x != nil {
+		return x.PushToUserIDs
+	}
+	return nil
+}
+
+func (x *OnlineBatchPushOneMsgRequest) GetMsgData() *v1.Message {
+	if x != nil {
+		return x.MsgData
+	}
+	return nil
+}
+
+type SingleMsgToUserPlatform struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RecvPlatformID int32                  `protobuf:"varint,1,opt,name=recvPlatformID,proto3" json:"recvPlatformID,omitempty"`
+	ResultCode     int64                  `protobuf:"varint,2,opt,name=resultCode,proto3" json:"resultCode,omitempty"`
+	IsBackground   bool                   `protobuf:"varint,3,opt,name=isBackground,proto3" json:"isBackground,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SingleMsgToUserPlatform) Reset() {
+	*x = SingleMsgToUserPlatform{}
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SingleMsgToUserPlatform) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SingleMsgToUserPlatform) ProtoMessage() {}
+
+func (x *SingleMsgToUserPlatform) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SingleMsgToUserPlatform.ProtoReflect.Descriptor instead.
+func (*SingleMsgToUserPlatform) Descriptor() ([]byte, []int) {
+	return file_idl_gateway_v1_gateway_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SingleMsgToUserPlatform) GetRecvPlatformID() int32 {
+	if x != nil {
+		return x.RecvPlatformID
+	}
+	return 0
+}
+
+func (x *SingleMsgToUserPlatform) GetResultCode() int64 {
+	if x != nil {
+		return x.ResultCode
+	}
+	return 0
+}
+
+func (x *SingleMsgToUserPlatform) GetIsBackground() bool {
+	if x != nil {
+		return x.IsBackground
+	}
+	return false
+}
+
+type SingleMsgToUserResults struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	UserID        string                     `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Resp          []*SingleMsgToUserPlatform `protobuf:"bytes,2,rep,name=resp,proto3" json:"resp,omitempty"`
+	OnlinePush    bool                       `protobuf:"varint,3,opt,name=onlinePush,proto3" json:"onlinePush,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SingleMsgToUserResults) Reset() {
+	*x = SingleMsgToUserResults{}
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SingleMsgToUserResults) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SingleMsgToUserResults) ProtoMessage() {}
+
+func (x *SingleMsgToUserResults) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SingleMsgToUserResults.ProtoReflect.Descriptor instead.
+func (*SingleMsgToUserResults) Descriptor() ([]byte, []int) {
+	return file_idl_gateway_v1_gateway_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SingleMsgToUserResults) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SingleMsgToUserResults) GetResp() []*SingleMsgToUserPlatform {
+	if x != nil {
+		return x.Resp
+	}
+	return nil
+}
+
+func (x *SingleMsgToUserResults) GetOnlinePush() bool {
+	if x != nil {
+		return x.OnlinePush
+	}
+	return false
+}
+
+type OnlineBatchPushOneMsgResponse struct {
+	state            protoimpl.MessageState    `protogen:"open.v1"`
+	SinglePushResult []*SingleMsgToUserResults `protobuf:"bytes,1,rep,name=singlePushResult,proto3" json:"singlePushResult,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *OnlineBatchPushOneMsgResponse) Reset() {
+	*x = OnlineBatchPushOneMsgResponse{}
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnlineBatchPushOneMsgResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnlineBatchPushOneMsgResponse) ProtoMessage() {}
+
+func (x *OnlineBatchPushOneMsgResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnlineBatchPushOneMsgResponse.ProtoReflect.Descriptor instead.
+func (*OnlineBatchPushOneMsgResponse) Descriptor() ([]byte, []int) {
+	return file_idl_gateway_v1_gateway_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *OnlineBatchPushOneMsgResponse) GetSinglePushResult() []*SingleMsgToUserResults {
+	if x != nil {
+		return x.SinglePushResult
+	}
+	return nil
+}
+
+type KickUserOfflineRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	KickUserIDList []string               `protobuf:"bytes,1,rep,name=kickUserIDList,proto3" json:"kickUserIDList,omitempty"`
+	PlatformID     int32                  `protobuf:"varint,2,opt,name=platformID,proto3" json:"platformID,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *KickUserOfflineRequest) Reset() {
+	*x = KickUserOfflineRequest{}
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickUserOfflineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickUserOfflineRequest) ProtoMessage() {}
+
+func (x *KickUserOfflineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickUserOfflineRequest.ProtoReflect.Descriptor instead.
+func (*KickUserOfflineRequest) Descriptor() ([]byte, []int) {
+	return file_idl_gateway_v1_gateway_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *KickUserOfflineRequest) GetKickUserIDList() []string {
+	if x != nil {
+		return x.KickUserIDList
+	}
+	return nil
+}
+
+func (x *KickUserOfflineRequest) GetPlatformID() int32 {
+	if x != nil {
+		return x.PlatformID
+	}
+	return 0
+}
+
+type KickUserOfflineResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KickUserOfflineResponse) Reset() {
+	*x = KickUserOfflineResponse{}
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickUserOfflineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickUserOfflineResponse) ProtoMessage() {}
+
+func (x *KickUserOfflineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickUserOfflineResponse.ProtoReflect.Descriptor instead.
+func (*KickUserOfflineResponse) Descriptor() ([]byte, []int) {
+	return file_idl_gateway_v1_gateway_proto_rawDescGZIP(), []int{7}
+}
+
+type MultiTerminalLoginCheckRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	PlatformID    int32                  `protobuf:"varint,2,opt,name=platformID,proto3" json:"platformID,omitempty"`
+	Token         string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultiTerminalLoginCheckRequest) Reset() {
+	*x = MultiTerminalLoginCheckRequest{}
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiTerminalLoginCheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiTerminalLoginCheckRequest) ProtoMessage() {}
+
+func (x *MultiTerminalLoginCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiTerminalLoginCheckRequest.ProtoReflect.Descriptor instead.
+func (*MultiTerminalLoginCheckRequest) Descriptor() ([]byte, []int) {
+	return file_idl_gateway_v1_gateway_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MultiTerminalLoginCheckRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *MultiTerminalLoginCheckRequest) GetPlatformID() int32 {
+	if x != nil {
+		return x.PlatformID
+	}
+	return 0
+}
+
+func (x *MultiTerminalLoginCheckRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type MultiTerminalLoginCheckResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultiTerminalLoginCheckResponse) Reset() {
+	*x = MultiTerminalLoginCheckResponse{}
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiTerminalLoginCheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiTerminalLoginCheckResponse) ProtoMessage() {}
+
+func (x *MultiTerminalLoginCheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiTerminalLoginCheckResponse.ProtoReflect.Descriptor instead.
+func (*MultiTerminalLoginCheckResponse) Descriptor() ([]byte, []int) {
+	return file_idl_gateway_v1_gateway_proto_rawDescGZIP(), []int{9}
+}
+
+type PullMsgs struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Msgs          []*v1.Message          `protobuf:"bytes,1,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PullMsgs) Reset() {
+	*x = PullMsgs{}
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullMsgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullMsgs) ProtoMessage() {}
+
+func (x *PullMsgs) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullMsgs.ProtoReflect.Descriptor instead.
+func (*PullMsgs) Descriptor() ([]byte, []int) {
+	return file_idl_gateway_v1_gateway_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PullMsgs) GetMsgs() []*v1.Message {
+	if x != nil {
+		return x.Msgs
+	}
+	return nil
+}
+
+// PushMessages is the payload of a WSPushMsg frame, grouped by conversation.
+type PushMessages struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Msgs             map[string]*PullMsgs   `protobuf:"bytes,1,rep,name=msgs,proto3" json:"msgs,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	NotificationMsgs map[string]*PullMsgs   `protobuf:"bytes,2,rep,name=notification_msgs,json=notificationMsgs,proto3" json:"notification_msgs,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PushMessages) Reset() {
+	*x = PushMessages{}
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushMessages) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushMessages) ProtoMessage() {}
+
+func (x *PushMessages) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushMessages.ProtoReflect.Descriptor instead.
+func (*PushMessages) Descriptor() ([]byte, []int) {
+	return file_idl_gateway_v1_gateway_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PushMessages) GetMsgs() map[string]*PullMsgs {
+	if x != nil {
+		return x.Msgs
+	}
+	return nil
+}
+
+func (x *PushMessages) GetNotificationMsgs() map[string]*PullMsgs {
+	if x != nil {
+		return x.NotificationMsgs
+	}
+	return nil
+}
+
+// Req is the envelope of a request frame on the long connection, it is what
+// clients connecting with the protobuf encoding send.
+type Req struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReqIdentifier int32                  `protobuf:"varint,1,opt,name=reqIdentifier,proto3" json:"reqIdentifier,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	SendID        string                 `protobuf:"bytes,3,opt,name=sendID,proto3" json:"sendID,omitempty"`
+	OperationID   string                 `protobuf:"bytes,4,opt,name=operationID,proto3" json:"operationID,omitempty"`
+	MsgIncr       string                 `protobuf:"bytes,5,opt,name=msgIncr,proto3" json:"msgIncr,omitempty"`
+	Data          []byte                 `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Req) Reset() {
+	*x = Req{}
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Req) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Req) ProtoMessage() {}
+
+func (x *Req) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Req.ProtoReflect.Descriptor instead.
+func (*Req) Descriptor() ([]byte, []int) {
+	return file_idl_gateway_v1_gateway_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Req) GetReqIdentifier() int32 {
+	if x != nil {
+		return x.ReqIdentifier
+	}
+	return 0
+}
+
+func (x *Req) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *Req) GetSendID() string {
+	if x != nil {
+		return x.SendID
+	}
+	return ""
+}
+
+func (x *Req) GetOperationID() string {
+	if x != nil {
+		return x.OperationID
+	}
+	return ""
+}
+
+func (x *Req) GetMsgIncr() string {
+	if x != nil {
+		return x.MsgIncr
+	}
+	return ""
+}
+
+func (x *Req) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Resp is the envelope of a response or push frame on the long connection.
+type Resp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReqIdentifier int32                  `protobuf:"varint,1,opt,name=reqIdentifier,proto3" json:"reqIdentifier,omitempty"`
+	MsgIncr       string                 `protobuf:"bytes,2,opt,name=msgIncr,proto3" json:"msgIncr,omitempty"`
+	OperationID   string                 `protobuf:"bytes,3,opt,name=operationID,proto3" json:"operationID,omitempty"`
+	ErrCode       int32                  `protobuf:"varint,4,opt,name=errCode,proto3" json:"errCode,omitempty"`
+	ErrMsg        string                 `protobuf:"bytes,5,opt,name=errMsg,proto3" json:"errMsg,omitempty"`
+	Data          []byte                 `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
+	// pushSeq numbers the WSPushMsg frames of a resumable session, a client
+	// resumes from the last one it received.
+	PushSeq       int64 `protobuf:"varint,7,opt,name=pushSeq,proto3" json:"pushSeq,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Resp) Reset() {
+	*x = Resp{}
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Resp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Resp) ProtoMessage() {}
+
+func (x *Resp) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Resp.ProtoReflect.Descriptor instead.
+func (*Resp) Descriptor() ([]byte, []int) {
+	return file_idl_gateway_v1_gateway_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Resp) GetReqIdentifier() int32 {
+	if x != nil {
+		return x.ReqIdentifier
+	}
+	return 0
+}
+
+func (x *Resp) GetMsgIncr() string {
+	if x != nil {
+		return x.MsgIncr
+	}
+	return ""
+}
+
+func (x *Resp) GetOperationID() string {
+	if x != nil {
+		return x.OperationID
+	}
+	return ""
+}
+
+func (x *Resp) GetErrCode() int32 {
+	if x != nil {
+		return x.ErrCode
+	}
+	return 0
+}
+
+func (x *Resp) GetErrMsg() string {
+	if x != nil {
+		return x.ErrMsg
+	}
+	return ""
+}
+
+func (x *Resp) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Resp) GetPushSeq() int64 {
+	if x != nil {
+		return x.PushSeq
+	}
+	return 0
+}
+
+// SetAppBackgroundStatusReq is the payload of a WsSetBackgroundStatus request.
+type SetAppBackgroundStatusReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsBackground  bool                   `protobuf:"varint,1,opt,name=isBackground,proto3" json:"isBackground,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAppBackgroundStatusReq) Reset() {
+	*x = SetAppBackgroundStatusReq{}
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAppBackgroundStatusReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAppBackgroundStatusReq) ProtoMessage() {}
+
+func (x *SetAppBackgroundStatusReq) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAppBackgroundStatusReq.ProtoReflect.Descriptor instead.
+func (*SetAppBackgroundStatusReq) Descriptor() ([]byte, []int) {
+	return file_idl_gateway_v1_gateway_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SetAppBackgroundStatusReq) GetIsBackground() bool {
+	if x != nil {
+		return x.IsBackground
+	}
+	return false
+}
+
+// SubUserOnlineStatus is the payload of a WsSubUserOnlineStatus request.
+type SubUserOnlineStatus struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	SubscribeUserID   []string               `protobuf:"bytes,1,rep,name=subscribeUserID,proto3" json:"subscribeUserID,omitempty"`
+	UnsubscribeUserID []string               `protobuf:"bytes,2,rep,name=unsubscribeUserID,proto3" json:"unsubscribeUserID,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SubUserOnlineStatus) Reset() {
+	*x = SubUserOnlineStatus{}
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubUserOnlineStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubUserOnlineStatus) ProtoMessage() {}
+
+func (x *SubUserOnlineStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubUserOnlineStatus.ProtoReflect.Descriptor instead.
+func (*SubUserOnlineStatus) Descriptor() ([]byte, []int) {
+	return file_idl_gateway_v1_gateway_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SubUserOnlineStatus) GetSubscribeUserID() []string {
+	if x != nil {
+		return x.SubscribeUserID
+	}
+	return nil
+}
+
+func (x *SubUserOnlineStatus) GetUnsubscribeUserID() []string {
+	if x != nil {
+		return x.UnsubscribeUserID
+	}
+	return nil
+}
+
+type SubUserOnlineStatusElem struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserID            string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	OnlinePlatformIDs []int32                `protobuf:"varint,2,rep,packed,name=onlinePlatformIDs,proto3" json:"onlinePlatformIDs,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SubUserOnlineStatusElem) Reset() {
+	*x = SubUserOnlineStatusElem{}
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubUserOnlineStatusElem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubUserOnlineStatusElem) ProtoMessage() {}
+
+func (x *SubUserOnlineStatusElem) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubUserOnlineStatusElem.ProtoReflect.Descriptor instead.
+func (*SubUserOnlineStatusElem) Descriptor() ([]byte, []int) {
+	return file_idl_gateway_v1_gateway_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SubUserOnlineStatusElem) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SubUserOnlineStatusElem) GetOnlinePlatformIDs() []int32 {
+	if x != nil {
+		return x.OnlinePlatformIDs
+	}
+	return nil
+}
+
+// SubUserOnlineStatusTips is the payload of a WsSubUserOnlineStatus response
+// or push.
+type SubUserOnlineStatusTips struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Subscribers   []*SubUserOnlineStatusElem `protobuf:"bytes,1,rep,name=subscribers,proto3" json:"subscribers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubUserOnlineStatusTips) Reset() {
+	*x = SubUserOnlineStatusTips{}
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubUserOnlineStatusTips) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubUserOnlineStatusTips) ProtoMessage() {}
+
+func (x *SubUserOnlineStatusTips) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubUserOnlineStatusTips.ProtoReflect.Descriptor instead.
+func (*SubUserOnlineStatusTips) Descriptor() ([]byte, []int) {
+	return file_idl_gateway_v1_gateway_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SubUserOnlineStatusTips) GetSubscribers() []*SubUserOnlineStatusElem {
+	if x != nil {
+		return x.Subscribers
+	}
+	return nil
+}
+
+// SessionResumeTips is the payload of the WsSessionResume frame sent on
+// connect, the client reconnects with resume=<resumeToken>&lastSeq=<pushSeq>.
+type SessionResumeTips struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ResumeToken string                 `protobuf:"bytes,1,opt,name=resumeToken,proto3" json:"resumeToken,omitempty"`
+	// resumed is false when the session could not be resumed, the client has
+	// to pull what it missed by seq.
+	Resumed       bool `protobuf:"varint,2,opt,name=resumed,proto3" json:"resumed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionResumeTips) Reset() {
+	*x = SessionResumeTips{}
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionResumeTips) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionResumeTips) ProtoMessage() {}
+
+func (x *SessionResumeTips) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionResumeTips.ProtoReflect.Descriptor instead.
+func (*SessionResumeTips) Descriptor() ([]byte, []int) {
+	return file_idl_gateway_v1_gateway_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SessionResumeTips) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *SessionResumeTips) GetResumed() bool {
+	if x != nil {
+		return x.Resumed
+	}
+	return false
+}
+
+// MsgAckReq is the payload of a WsMsgAck request, confirming the pushes of
+// a conversation reached the app.
+type MsgAckReq struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationID string                 `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+	Seqs           []int64                `protobuf:"varint,2,rep,packed,name=seqs,proto3" json:"seqs,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MsgAckReq) Reset() {
+	*x = MsgAckReq{}
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MsgAckReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgAckReq) ProtoMessage() {}
+
+func (x *MsgAckReq) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgAckReq.ProtoReflect.Descriptor instead.
+func (*MsgAckReq) Descriptor() ([]byte, []int) {
+	return file_idl_gateway_v1_gateway_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *MsgAckReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *MsgAckReq) GetSeqs() []int64 {
+	if x != nil {
+		return x.Seqs
+	}
+	return nil
+}
+
+// JoinRoomReq is the payload of a WsJoinRoom request. Room membership lasts as
+// long as the connection, a resumed session does not restore it.
+type JoinRoomReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomID        string                 `protobuf:"bytes,1,opt,name=roomID,proto3" json:"roomID,omitempty"`
+	RoomType      string                 `protobuf:"bytes,2,opt,name=roomType,proto3" json:"roomType,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinRoomReq) Reset() {
+	*x = JoinRoomReq{}
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinRoomReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinRoomReq) ProtoMessage() {}
+
+func (x *JoinRoomReq) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinRoomReq.ProtoReflect.Descriptor instead.
+func (*JoinRoomReq) Descriptor() ([]byte, []int) {
+	return file_idl_gateway_v1_gateway_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *JoinRoomReq) GetRoomID() string {
+	if x != nil {
+		return x.RoomID
+	}
+	return ""
+}
+
+func (x *JoinRoomReq) GetRoomType() string {
+	if x != nil {
+		return x.RoomType
+	}
+	return ""
+}
+
+// LeaveRoomReq is the payload of a WsLeaveRoom request.
+type LeaveRoomReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomID        string                 `protobuf:"bytes,1,opt,name=roomID,proto3" json:"roomID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveRoomReq) Reset() {
+	*x = LeaveRoomReq{}
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveRoomReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveRoomReq) ProtoMessage() {}
+
+func (x *LeaveRoomReq) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveRoomReq.ProtoReflect.Descriptor instead.
+func (*LeaveRoomReq) Descriptor() ([]byte, []int) {
+	return file_idl_gateway_v1_gateway_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *LeaveRoomReq) GetRoomID() string {
+	if x != nil {
+		return x.RoomID
+	}
+	return ""
+}
+
+// SendRoomMsgReq is the payload of a WsSendRoomMsg request.
+type SendRoomMsgReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomID        string                 `protobuf:"bytes,1,opt,name=roomID,proto3" json:"roomID,omitempty"`
+	ContentType   int32                  `protobuf:"varint,2,opt,name=contentType,proto3" json:"contentType,omitempty"`
+	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendRoomMsgReq) Reset() {
+	*x = SendRoomMsgReq{}
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendRoomMsgReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendRoomMsgReq) ProtoMessage() {}
+
+func (x *SendRoomMsgReq) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendRoomMsgReq.ProtoReflect.Descriptor instead.
+func (*SendRoomMsgReq) Descriptor() ([]byte, []int) {
+	return file_idl_gateway_v1_gateway_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SendRoomMsgReq) GetRoomID() string {
+	if x != nil {
+		return x.RoomID
+	}
+	return ""
+}
+
+func (x *SendRoomMsgReq) GetContentType() int32 {
+	if x != nil {
+		return x.ContentType
+	}
+	return 0
+}
+
+func (x *SendRoomMsgReq) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+// RoomMessage is the payload of a WsRoomMsg push. Room messages are not
+// persisted, members only get what is sent while they are in the room.
+type RoomMessage struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	RoomID           string                 `protobuf:"bytes,1,opt,name=roomID,proto3" json:"roomID,omitempty"`
+	SendID           string                 `protobuf:"bytes,2,opt,name=sendID,proto3" json:"sendID,omitempty"`
+	SenderPlatformID int32                  `protobuf:"varint,3,opt,name=senderPlatformID,proto3" json:"senderPlatformID,omitempty"`
+	ContentType      int32                  `protobuf:"varint,4,opt,name=contentType,proto3" json:"contentType,omitempty"`
+	Content          []byte                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	SendTime         int64                  `protobuf:"varint,6,opt,name=sendTime,proto3" json:"sendTime,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RoomMessage) Reset() {
+	*x = RoomMessage{}
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomMessage) ProtoMessage() {}
+
+func (x *RoomMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomMessage.ProtoReflect.Descriptor instead.
+func (*RoomMessage) Descriptor() ([]byte, []int) {
+	return file_idl_gateway_v1_gateway_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RoomMessage) GetRoomID() string {
+	if x != nil {
+		return x.RoomID
+	}
+	return ""
+}
+
+func (x *RoomMessage) GetSendID() string {
+	if x != nil {
+		return x.SendID
+	}
+	return ""
+}
+
+func (x *RoomMessage) GetSenderPlatformID() int32 {
+	if x != nil {
+		return x.SenderPlatformID
+	}
+	return 0
+}
+
+func (x *RoomMessage) GetContentType() int32 {
+	if x != nil {
+		return x.ContentType
+	}
+	return 0
+}
+
+func (x *RoomMessage) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *RoomMessage) GetSendTime() int64 {
+	if x != nil {
+		return x.SendTime
+	}
+	return 0
+}
+
+type BroadcastRoomRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Msg   *RoomMessage           `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	// forwarded is set on the copies a node sends to its peers, they only
+	// broadcast to their own connections.
+	Forwarded     bool `protobuf:"varint,2,opt,name=forwarded,proto3" json:"forwarded,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BroadcastRoomRequest) Reset() {
+	*x = BroadcastRoomRequest{}
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BroadcastRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BroadcastRoomRequest) ProtoMessage() {}
+
+func (x *BroadcastRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BroadcastRoomRequest.ProtoReflect.Descriptor instead.
+func (*BroadcastRoomRequest) Descriptor() ([]byte, []int) {
+	return file_idl_gateway_v1_gateway_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *BroadcastRoomRequest) GetMsg() *RoomMessage {
+	if x != nil {
+		return x.Msg
+	}
+	return nil
+}
+
+func (x *BroadcastRoomRequest) GetForwarded() bool {
+	if x != nil {
+		return x.Forwarded
+	}
+	return false
+}
+
+type BroadcastRoomResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BroadcastRoomResponse) Reset() {
+	*x = BroadcastRoomResponse{}
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BroadcastRoomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BroadcastRoomResponse) ProtoMessage() {}
+
+func (x *BroadcastRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BroadcastRoomResponse.ProtoReflect.Descriptor instead.
+func (*BroadcastRoomResponse) Descriptor() ([]byte, []int) {
+	return file_idl_gateway_v1_gateway_proto_rawDescGZIP(), []int{25}
+}
+
+type GetUsersOnlineStatusResponse_SuccessDetail struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlatformID    int32                  `protobuf:"varint,1,opt,name=platformID,proto3" json:"platformID,omitempty"`
+	ConnID        string                 `protobuf:"bytes,2,opt,name=connID,proto3" json:"connID,omitempty"`
+	Token         string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	IsBackground  bool                   `protobuf:"varint,4,opt,name=isBackground,proto3" json:"isBackground,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsersOnlineStatusResponse_SuccessDetail) Reset() {
+	*x = GetUsersOnlineStatusResponse_SuccessDetail{}
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsersOnlineStatusResponse_SuccessDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsersOnlineStatusResponse_SuccessDetail) ProtoMessage() {}
+
+func (x *GetUsersOnlineStatusResponse_SuccessDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsersOnlineStatusResponse_SuccessDetail.ProtoReflect.Descriptor instead.
+func (*GetUsersOnlineStatusResponse_SuccessDetail) Descriptor() ([]byte, []int) {
+	return file_idl_gateway_v1_gateway_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *GetUsersOnlineStatusResponse_SuccessDetail) GetPlatformID() int32 {
+	if x != nil {
+		return x.PlatformID
+	}
+	return 0
+}
+
+func (x *GetUsersOnlineStatusResponse_SuccessDetail) GetConnID() string {
+	if x != nil {
+		return x.ConnID
+	}
+	return ""
+}
+
+func (x *GetUsersOnlineStatusResponse_SuccessDetail) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetUsersOnlineStatusResponse_SuccessDetail) GetIsBackground() bool {
+	if x != nil {
+		return x.IsBackground
+	}
+	return false
+}
+
+type GetUsersOnlineStatusResponse_SuccessResult struct {
+	state                protoimpl.MessageState                        `protogen:"open.v1"`
+	UserID               string                                        `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Status               int32                                         `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	DetailPlatformStatus []*GetUsersOnlineStatusResponse_SuccessDetail `protobuf:"bytes,3,rep,name=detailPlatformStatus,proto3" json:"detailPlatformStatus,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GetUsersOnlineStatusResponse_SuccessResult) Reset() {
+	*x = GetUsersOnlineStatusResponse_SuccessResult{}
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsersOnlineStatusResponse_SuccessResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsersOnlineStatusResponse_SuccessResult) ProtoMessage() {}
+
+func (x *GetUsersOnlineStatusResponse_SuccessResult) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_gateway_v1_gateway_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsersOnlineStatusResponse_SuccessResult.ProtoReflect.Descriptor instead.
+func (*GetUsersOnlineStatusResponse_SuccessResult) Descriptor() ([]byte, []int) {
+	return file_idl_gateway_v1_gateway_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *GetUsersOnlineStatusResponse_SuccessResult) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetUsersOnlineStatusResponse_SuccessResult) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *GetUsersOnlineStatusResponse_SuccessResult) GetDetailPlatformStatus() []*GetUsersOnlineStatusResponse_SuccessDetail {
+	if x != nil {
+		return x.DetailPlatformStatus
+	}
+	return nil
+}
+
+var File_idl_gateway_v1_gateway_proto protoreflect.FileDescriptor
+
+const file_idl_gateway_v1_gateway_proto_rawDesc = "" +
+	"\n" +
+	"\x1cidl/gateway/v1/gateway.proto\x12\n" +
+	"gateway.v1\x1a\x1cidl/message/v1/message.proto\"7\n" +
+	"\x1bGetUsersOnlineStatusRequest\x12\x18\n" +
+	"\auserIDs\x18\x01 \x03(\tR\auserIDs\"\xae\x03\n" +
+	"\x1cGetUsersOnlineStatusResponse\x12\\\n" +
+	"\rsuccessResult\x18\x01 \x03(\v26.gateway.v1.GetUsersOnlineStatusResponse.SuccessResultR\rsuccessResult\x1a\x81\x01\n" +
+	"\rSuccessDetail\x12\x1e\n" +
+	"\n" +
+	"platformID\x18\x01 \x01(\x05R\n" +
+	"platformID\x12\x16\n" +
+	"\x06connID\x18\x02 \x01(\tR\x06connID\x12\x14\n" +
+	"\x05token\x18\x03 \x01(\tR\x05token\x12\"\n" +
+	"\fisBackground\x18\x04 \x01(\bR\fisBackground\x1a\xab\x01\n" +
+	"\rSuccessResult\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\x05R\x06status\x12j\n" +
+	"\x14detailPlatformStatus\x18\x03 \x03(\v26.gateway.v1.GetUsersOnlineStatusResponse.SuccessDetailR\x14detailPlatformStatus\"s\n" +
+	"\x1cOnlineBatchPushOneMsgRequest\x12$\n" +
+	"\rpushToUserIDs\x18\x01 \x03(\tR\rpushToUserIDs\x12-\n" +
+	"\amsgData\x18\x02 \x01(\v2\x13.message.v1.MessageR\amsgData\"\x85\x01\n" +
+	"\x17SingleMsgToUserPlatform\x12&\n" +
+	"\x0erecvPlatformID\x18\x01 \x01(\x05R\x0erecvPlatformID\x12\x1e\n" +
+	"\n" +
+	"resultCode\x18\x02 \x01(\x03R\n" +
+	"resultCode\x12\"\n" +
+	"\fisBackground\x18\x03 \x01(\bR\fisBackground\"\x89\x01\n" +
+	"\x16SingleMsgToUserResults\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x127\n" +
+	"\x04resp\x18\x02 \x03(\v2#.gateway.v1.SingleMsgToUserPlatformR\x04resp\x12\x1e\n" +
+	"\n" +
+	"onlinePush\x18\x03 \x01(\bR\n" +
+	"onlinePush\"o\n" +
+	"\x1dOnlineBatchPushOneMsgResponse\x12N\n" +
+	"\x10singlePushResult\x18\x01 \x03(\v2\".gateway.v1.SingleMsgToUserResultsR\x10singlePushResult\"`\n" +
+	"\x16KickUserOfflineRequest\x12&\n" +
+	"\x0ekickUserIDList\x18\x01 \x03(\tR\x0ekickUserIDList\x12\x1e\n" +
+	"\n" +
+	"platformID\x18\x02 \x01(\x05R\n" +
+	"platformID\"\x19\n" +
+	"\x17KickUserOfflineResponse\"n\n" +
+	"\x1eMultiTerminalLoginCheckRequest\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x1e\n" +
+	"\n" +
+	"platformID\x18\x02 \x01(\x05R\n" +
+	"platformID\x12\x14\n" +
+	"\x05token\x18\x03 \x01(\tR\x05token\"!\n" +
+	"\x1fMultiTerminalLoginCheckResponse\"3\n" +
+	"\bPullMsgs\x12'\n" +
+	"\x04msgs\x18\x01 \x03(\v2\x13.message.v1.MessageR\x04msgs\"\xcd\x02\n" +
+	"\fPushMessages\x126\n" +
+	"\x04msgs\x18\x01 \x03(\v2\".gateway.v1.PushMessages.MsgsEntryR\x04msgs\x12[\n" +
+	"\x11notification_msgs\x18\x02 \x03(\v2..gateway.v1.PushMessages.NotificationMsgsEntryR\x10notificationMsgs\x1aM\n" +
+	"\tMsgsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12*\n" +
+	"\x05value\x18\x02 \x01(\v2\x14.gateway.v1.PullMsgsR\x05value:\x028\x01\x1aY\n" +
+	"\x15NotificationMsgsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12*\n" +
+	"\x05value\x18\x02 \x01(\v2\x14.gateway.v1.PullMsgsR\x05value:\x028\x01\"\xa9\x01\n" +
+	"\x03Req\x12$\n" +
+	"\rreqIdentifier\x18\x01 \x01(\x05R\rreqIdentifier\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x12\x16\n" +
+	"\x06sendID\x18\x03 \x01(\tR\x06sendID\x12 \n" +
+	"\voperationID\x18\x04 \x01(\tR\voperationID\x12\x18\n" +
+	"\amsgIncr\x18\x05 \x01(\tR\amsgIncr\x12\x12\n" +
+	"\x04data\x18\x06 \x01(\fR\x04data\"\xc8\x01\n" +
+	"\x04Resp\x12$\n" +
+	"\rreqIdentifier\x18\x01 \x01(\x05R\rreqIdentifier\x12\x18\n" +
+	"\amsgIncr\x18\x02 \x01(\tR\amsgIncr\x12 \n" +
+	"\voperationID\x18\x03 \x01(\tR\voperationID\x12\x18\n" +
+	"\aerrCode\x18\x04 \x01(\x05R\aerrCode\x12\x16\n" +
+	"\x06errMsg\x18\x05 \x01(\tR\x06errMsg\x12\x12\n" +
+	"\x04data\x18\x06 \x01(\fR\x04data\x12\x18\n" +
+	"\apushSeq\x18\a \x01(\x03R\apushSeq\"?\n" +
+	"\x19SetAppBackgroundStatusReq\x12\"\n" +
+	"\fisBackground\x18\x01 \x01(\bR\fisBackground\"m\n" +
+	"\x13SubUserOnlineStatus\x12(\n" +
+	"\x0fsubscribeUserID\x18\x01 \x03(\tR\x0fsubscribeUserID\x12,\n" +
+	"\x11unsubscribeUserID\x18\x02 \x03(\tR\x11unsubscribeUserID\"_\n" +
+	"\x17SubUserOnlineStatusElem\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12,\n" +
+	"\x11onlinePlatformIDs\x18\x02 \x03(\x05R\x11onlinePlatformIDs\"`\n" +
+	"\x17SubUserOnlineStatusTips\x12E\n" +
+	"\vsubscribers\x18\x01 \x03(\v2#.gateway.v1.SubUserOnlineStatusElemR\vsubscribers\"O\n" +
+	"\x11SessionResumeTips\x12 \n" +
+	"\vresumeToken\x18\x01 \x01(\tR\vresumeToken\x12\x18\n" +
+	"\aresumed\x18\x02 \x01(\bR\aresumed\"G\n" +
+	"\tMsgAckReq\x12&\n" +
+	"\x0econversationID\x18\x01 \x01(\tR\x0econversationID\x12\x12\n" +
+	"\x04seqs\x18\x02 \x03(\x03R\x04seqs\"A\n" +
+	"\vJoinRoomReq\x12\x16\n" +
+	"\x06roomID\x18\x01 \x01(\tR\x06roomID\x12\x1a\n" +
+	"\broomType\x18\x02 \x01(\tR\broomType\"&\n" +
+	"\fLeaveRoomReq\x12\x16\n" +
+	"\x06roomID\x18\x01 \x01(\tR\x06roomID\"d\n" +
+	"\x0eSendRoomMsgReq\x12\x16\n" +
+	"\x06roomID\x18\x01 \x01(\tR\x06roomID\x12 \n" +
+	"\vcontentType\x18\x02 \x01(\x05R\vcontentType\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\fR\acontent\"\xc1\x01\n" +
+	"\vRoomMessage\x12\x16\n" +
+	"\x06roomID\x18\x01 \x01(\tR\x06roomID\x12\x16\n" +
+	"\x06sendID\x18\x02 \x01(\tR\x06sendID\x12*\n" +
+	"\x10senderPlatformID\x18\x03 \x01(\x05R\x10senderPlatformID\x12 \n" +
+	"\vcontentType\x18\x04 \x01(\x05R\vcontentType\x12\x18\n" +
+	"\acontent\x18\x05 \x01(\fR\acontent\x12\x1a\n" +
+	"\bsendTime\x18\x06 \x01(\x03R\bsendTime\"_\n" +
+	"\x14BroadcastRoomRequest\x12)\n" +
+	"\x03msg\x18\x01 \x01(\v2\x17.gateway.v1.RoomMessageR\x03msg\x12\x1c\n" +
+	"\tforwarded\x18\x02 \x01(\bR\tforwarded\"\x17\n" +
+	"\x15BroadcastRoomResponse2\x99\x04\n" +
+	"\x0eGatewayService\x12i\n" +
+	"\x14GetUsersOnlineStatus\x12'.gateway.v1.GetUsersOnlineStatusRequest\x1a(.gateway.v1.GetUsersOnlineStatusResponse\x12v\n" +
+	"\x1fSuperGroupOnlineBatchPushOneMsg\x12(.gateway.v1.OnlineBatchPushOneMsgRequest\x1a).gateway.v1.OnlineBatchPushOneMsgResponse\x12Z\n" +
+	"\x0fKickUserOffline\x12\".gateway.v1.KickUserOfflineRequest\x1a#.gateway.v1.KickUserOfflineResponse\x12r\n" +
+	"\x17MultiTerminalLoginCheck\x12*.gateway.v1.MultiTerminalLoginCheckRequest\x1a+.gateway.v1.MultiTerminalLoginCheckResponse\x12T\n" +
+	"\rBroadcastRoom\x12 .gateway.v1.BroadcastRoomRequest\x1a!.gateway.v1.BroadcastRoomResponseB<Z:github.com/crazyfrankie/goim/protocol/gateway/v1;gatewayv1b\x06proto3"
+
+var (
+	file_idl_gateway_v1_gateway_proto_rawDescOnce sync.Once
+	file_idl_gateway_v1_gateway_proto_rawDescData []byte
+)
+
+func file_idl_gateway_v1_gateway_proto_rawDescGZIP() []byte {
+	file_idl_gateway_v1_gateway_proto_rawDescOnce.Do(func() {
+		file_idl_gateway_v1_gateway_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_idl_gateway_v1_gateway_proto_rawDesc), len(file_idl_gateway_v1_gateway_proto_rawDesc)))
+	})
+	return file_idl_gateway_v1_gateway_proto_rawDescData
+}
+
+var file_idl_gateway_v1_gateway_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
+var file_idl_gateway_v1_gateway_proto_goTypes = []any{
+	(*GetUsersOnlineStatusRequest)(nil),                // 0: gateway.v1.GetUsersOnlineStatusRequest
+	(*GetUsersOnlineStatusResponse)(nil),               // 1: gateway.v1.GetUsersOnlineStatusResponse
+	(*OnlineBatchPushOneMsgRequest)(nil),               // 2: gateway.v1.OnlineBatchPushOneMsgRequest
+	(*SingleMsgToUserPlatform)(nil),                    // 3: gateway.v1.SingleMsgToUserPlatform
+	(*SingleMsgToUserResults)(nil),                     // 4: gateway.v1.SingleMsgToUserResults
+	(*OnlineBatchPushOneMsgResponse)(nil),              // 5: gateway.v1.OnlineBatchPushOneMsgResponse
+	(*KickUserOfflineRequest)(nil),                     // 6: gateway.v1.KickUserOfflineRequest
+	(*KickUserOfflineResponse)(nil),                    // 7: gateway.v1.KickUserOfflineResponse
+	(*MultiTerminalLoginCheckRequest)(nil),             // 8: gateway.v1.MultiTerminalLoginCheckRequest
+	(*MultiTerminalLoginCheckResponse)(nil),            // 9: gateway.v1.MultiTerminalLoginCheckResponse
+	(*PullMsgs)(nil),                                   // 10: gateway.v1.PullMsgs
+	(*PushMessages)(nil),                               // 11: gateway.v1.PushMessages
+	(*Req)(nil),                                        // 12: gateway.v1.Req
+	(*Resp)(nil),                                       // 13: gateway.v1.Resp
+	(*SetAppBackgroundStatusReq)(nil),                  // 14: gateway.v1.SetAppBackgroundStatusReq
+	(*SubUserOnlineStatus)(nil),                        // 15: gateway.v1.SubUserOnlineStatus
+	(*SubUserOnlineStatusElem)(nil),                    // 16: gateway.v1.SubUserOnlineStatusElem
+	(*SubUserOnlineStatusTips)(nil),                    // 17: gateway.v1.SubUserOnlineStatusTips
+	(*SessionResumeTips)(nil),                          // 18: gateway.v1.SessionResumeTips
+	(*MsgAckReq)(nil),                                  // 19: gateway.v1.MsgAckReq
+	(*JoinRoomReq)(nil),                                // 20: gateway.v1.JoinRoomReq
+	(*LeaveRoomReq)(nil),                               // 21: gateway.v1.LeaveRoomReq
+	(*SendRoomMsgReq)(nil),                             // 22: gateway.v1.SendRoomMsgReq
+	(*RoomMessage)(nil),                                // 23: gateway.v1.RoomMessage
+	(*BroadcastRoomRequest)(nil),                       // 24: gateway.v1.BroadcastRoomRequest
+	(*BroadcastRoomResponse)(nil),                      // 25: gateway.v1.BroadcastRoomResponse
+	(*GetUsersOnlineStatusResponse_SuccessDetail)(nil), // 26: gateway.v1.GetUsersOnlineStatusResponse.SuccessDetail
+	(*GetUsersOnlineStatusResponse_SuccessResult)(nil), // 27: gateway.v1.GetUsersOnlineStatusResponse.SuccessResult
+	nil,                // 28: gateway.v1.PushMessages.MsgsEntry
+	nil,                // 29: gateway.v1.PushMessages.NotificationMsgsEntry
+	(*v1.Message)(nil), // 30: message.v1.Message
+}
+var file_idl_gateway_v1_gateway_proto_depIdxs = []int32{
+	27, // 0: gateway.v1.GetUsersOnlineStatusResponse.successResult:type_name -> gateway.v1.GetUsersOnlineStatusResponse.SuccessResult
+	30, // 1: gateway.v1.OnlineBatchPushOneMsgRequest.msgData:type_name -> message.v1.Message
+	3,  // 2: gateway.v1.SingleMsgToUserResults.resp:type_name -> gateway.v1.SingleMsgToUserPlatform
+	4,  // 3: gateway.v1.OnlineBatchPushOneMsgResponse.singlePushResult:type_name -> gateway.v1.SingleMsgToUserResults
+	30, // 4: gateway.v1.PullMsgs.msgs:type_name -> message.v1.Message
+	28, // 5: gateway.v1.PushMessages.msgs:type_name -> gateway.v1.PushMessages.MsgsEntry
+	29, // 6: gateway.v1.PushMessages.notification_msgs:type_name -> gateway.v1.PushMessages.NotificationMsgsEntry
+	16, // 7: gateway.v1.SubUserOnlineStatusTips.subscribers:type_name -> gateway.v1.SubUserOnlineStatusElem
+	23, // 8: gateway.v1.BroadcastRoomRequest.msg:type_name -> gateway.v1.RoomMessage
+	26, // 9: gateway.v1.GetUsersOnlineStatusResponse.SuccessResult.detailPlatformStatus:type_name -> gateway.v1.GetUsersOnlineStatusResponse.SuccessDetail
+	10, // 10: gateway.v1.PushMessages.MsgsEntry.value:type_name -> gateway.v1.PullMsgs
+	10, // 11: gateway.v1.PushMessages.NotificationMsgsEntry.value:type_name -> gateway.v1.PullMsgs
+	0,  // 12: gateway.v1.GatewayService.GetUsersOnlineStatus:input_type -> gateway.v1.GetUsersOnlineStatusRequest
+	2,  // 13: gateway.v1.GatewayService.SuperGroupOnlineBatchPushOneMsg:input_type -> gateway.v1.OnlineBatchPushOneMsgRequest
+	6,  // 14: gateway.v1.GatewayService.KickUserOffline:input_type -> gateway.v1.KickUserOfflineRequest
+	8,  // 15: gateway.v1.GatewayService.MultiTerminalLoginCheck:input_type -> gateway.v1.MultiTerminalLoginCheckRequest
+	24, // 16: gateway.v1.GatewayService.BroadcastRoom:input_type -> gateway.v1.BroadcastRoomRequest
+	1,  // 17: gateway.v1.GatewayService.GetUsersOnlineStatus:output_type -> gateway.v1.GetUsersOnlineStatusResponse
+	5,  // 18: gateway.v1.GatewayService.SuperGroupOnlineBatchPushOneMsg:output_type -> gateway.v1.OnlineBatchPushOneMsgResponse
+	7,  // 19: gateway.v1.GatewayService.KickUserOffline:output_type -> gateway.v1.KickUserOfflineResponse
+	9,  // 20: gateway.v1.GatewayService.MultiTerminalLoginCheck:output_type -> gateway.v1.MultiTerminalLoginCheckResponse
+	25, // 21: gateway.v1.GatewayService.BroadcastRoom:output_type -> gateway.v1.BroadcastRoomResponse
+	17, // [17:22] is the sub-list for method output_type
+	12, // [12:17] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_idl_gateway_v1_gateway_proto_init() }
+func file_idl_gateway_v1_gateway_proto_init() {
+	if File_idl_gateway_v1_gateway_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_idl_gateway_v1_gateway_proto_rawDesc), len(file_idl_gateway_v1_gateway_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   30,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_idl_gateway_v1_gateway_proto_goTypes,
+		DependencyIndexes: file_idl_gateway_v1_gateway_proto_depIdxs,
+		MessageInfos:      file_idl_gateway_v1_gateway_proto_msgTypes,
+	}.Build()
+	File_idl_gateway_v1_gateway_proto = out.File
+	file_idl_gateway_v1_gateway_proto_goTypes = nil
+	file_idl_gateway_v1_gateway_proto_depIdxs = nil
+}
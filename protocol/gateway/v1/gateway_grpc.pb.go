@@ -0,0 +1,273 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: idl/gateway/v1/gateway.proto
+
+package gatewayv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GatewayService_GetUsersOnlineStatus_FullMethodName            = "/gateway.v1.GatewayService/GetUsersOnlineStatus"
+	GatewayService_SuperGroupOnlineBatchPushOneMsg_FullMethodName = "/gateway.v1.GatewayService/SuperGroupOnlineBatchPushOneMsg"
+	GatewayService_KickUserOffline_FullMethodName                 = "/gateway.v1.GatewayService/KickUserOffline"
+	GatewayService_MultiTerminalLoginCheck_FullMethodName         = "/gateway.v1.GatewayService/MultiTerminalLoginCheck"
+	GatewayService_BroadcastRoom_FullMethodName                   = "/gateway.v1.GatewayService/BroadcastRoom"
+)
+
+// GatewayServiceClient is the client API for GatewayService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GatewayServiceClient interface {
+	GetUsersOnlineStatus(ctx context.Context, in *GetUsersOnlineStatusRequest, opts ...grpc.CallOption) (*GetUsersOnlineStatusResponse, error)
+	SuperGroupOnlineBatchPushOneMsg(ctx context.Context, in *OnlineBatchPushOneMsgRequest, opts ...grpc.CallOption) (*OnlineBatchPushOneMsgResponse, error)
+	KickUserOffline(ctx context.Context, in *KickUserOfflineRequest, opts ...grpc.CallOption) (*KickUserOfflineResponse, error)
+	MultiTerminalLoginCheck(ctx context.Context, in *MultiTerminalLoginCheckRequest, opts ...grpc.CallOption) (*MultiTerminalLoginCheckResponse, error)
+	BroadcastRoom(ctx context.Context, in *BroadcastRoomRequest, opts ...grpc.CallOption) (*BroadcastRoomResponse, error)
+}
+
+type gatewayServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGatewayServiceClient(cc grpc.ClientConnInterface) GatewayServiceClient {
+	return &gatewayServiceClient{cc}
+}
+
+func (c *gatewayServiceClient) GetUsersOnlineStatus(ctx context.Context, in *GetUsersOnlineStatusRequest, opts ...grpc.CallOption) (*GetUsersOnlineStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUsersOnlineStatusResponse)
+	err := c.cc.Invoke(ctx, GatewayService_GetUsersOnlineStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) SuperGroupOnlineBatchPushOneMsg(ctx context.Context, in *OnlineBatchPushOneMsgRequest, opts ...grpc.CallOption) (*OnlineBatchPushOneMsgResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OnlineBatchPushOneMsgResponse)
+	err := c.cc.Invoke(ctx, GatewayService_SuperGroupOnlineBatchPushOneMsg_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) KickUserOffline(ctx context.Context, in *KickUserOfflineRequest, opts ...grpc.CallOption) (*KickUserOfflineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KickUserOfflineResponse)
+	err := c.cc.Invoke(ctx, GatewayService_KickUserOffline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) MultiTerminalLoginCheck(ctx context.Context, in *MultiTerminalLoginCheckRequest, opts ...grpc.CallOption) (*MultiTerminalLoginCheckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MultiTerminalLoginCheckResponse)
+	err := c.cc.Invoke(ctx, GatewayService_MultiTerminalLoginCheck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) BroadcastRoom(ctx context.Context, in *BroadcastRoomRequest, opts ...grpc.CallOption) (*BroadcastRoomResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BroadcastRoomResponse)
+	err := c.cc.Invoke(ctx, GatewayService_BroadcastRoom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GatewayServiceServer is the server API for GatewayService service.
+// All implementations must embed UnimplementedGatewayServiceServer
+// for forward compatibility.
+type GatewayServiceServer interface {
+	GetUsersOnlineStatus(context.Context, *GetUsersOnlineStatusRequest) (*GetUsersOnlineStatusResponse, error)
+	SuperGroupOnlineBatchPushOneMsg(context.Context, *OnlineBatchPushOneMsgRequest) (*OnlineBatchPushOneMsgResponse, error)
+	KickUserOffline(context.Context, *KickUserOfflineRequest) (*KickUserOfflineResponse, error)
+	MultiTerminalLoginCheck(context.Context, *MultiTerminalLoginCheckRequest) (*MultiTerminalLoginCheckResponse, error)
+	BroadcastRoom(context.Context, *BroadcastRoomRequest) (*BroadcastRoomResponse, error)
+	mustEmbedUnimplementedGatewayServiceServer()
+}
+
+// UnimplementedGatewayServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGatewayServiceServer struct{}
+
+func (UnimplementedGatewayServiceServer) GetUsersOnlineStatus(context.Context, *GetUsersOnlineStatusRequest) (*GetUsersOnlineStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsersOnlineStatus not implemented")
+}
+func (UnimplementedGatewayServiceServer) SuperGroupOnlineBatchPushOneMsg(context.Context, *OnlineBatchPushOneMsgRequest) (*OnlineBatchPushOneMsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuperGroupOnlineBatchPushOneMsg not implemented")
+}
+func (UnimplementedGatewayServiceServer) KickUserOffline(context.Context, *KickUserOfflineRequest) (*KickUserOfflineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KickUserOffline not implemented")
+}
+func (UnimplementedGatewayServiceServer) MultiTerminalLoginCheck(context.Context, *MultiTerminalLoginCheckRequest) (*MultiTerminalLoginCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiTerminalLoginCheck not implemented")
+}
+func (UnimplementedGatewayServiceServer) BroadcastRoom(context.Context, *BroadcastRoomRequest) (*BroadcastRoomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BroadcastRoom not implemented")
+}
+func (UnimplementedGatewayServiceServer) mustEmbedUnimplementedGatewayServiceServer() {}
+func (UnimplementedGatewayServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeGatewayServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GatewayServiceServer will
+// result in compilation errors.
+type UnsafeGatewayServiceServer interface {
+	mustEmbedUnimplementedGatewayServiceServer()
+}
+
+func RegisterGatewayServiceServer(s grpc.ServiceRegistrar, srv GatewayServiceServer) {
+	// If the following call pancis, it indicates UnimplementedGatewayServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GatewayService_ServiceDesc, srv)
+}
+
+func _GatewayService_GetUsersOnlineStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsersOnlineStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).GetUsersOnlineStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_GetUsersOnlineStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).GetUsersOnlineStatus(ctx, req.(*GetUsersOnlineStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_SuperGroupOnlineBatchPushOneMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnlineBatchPushOneMsgRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).SuperGroupOnlineBatchPushOneMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_SuperGroupOnlineBatchPushOneMsg_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).SuperGroupOnlineBatchPushOneMsg(ctx, req.(*OnlineBatchPushOneMsgRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_KickUserOffline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KickUserOfflineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).KickUserOffline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_KickUserOffline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).KickUserOffline(ctx, req.(*KickUserOfflineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_MultiTerminalLoginCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiTerminalLoginCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).MultiTerminalLoginCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_MultiTerminalLoginCheck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).MultiTerminalLoginCheck(ctx, req.(*MultiTerminalLoginCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_BroadcastRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BroadcastRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).BroadcastRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_BroadcastRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).BroadcastRoom(ctx, req.(*BroadcastRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GatewayService_ServiceDesc is the grpc.ServiceDesc for GatewayService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GatewayService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gateway.v1.GatewayService",
+	HandlerType: (*GatewayServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetUsersOnlineStatus",
+			Handler:    _GatewayService_GetUsersOnlineStatus_Handler,
+		},
+		{
+			MethodName: "SuperGroupOnlineBatchPushOneMsg",
+			Handler:    _GatewayService_SuperGroupOnlineBatchPushOneMsg_Handler,
+		},
+		{
+			MethodName: "KickUserOffline",
+			Handler:    _GatewayService_KickUserOffline_Handler,
+		},
+		{
+			MethodName: "MultiTerminalLoginCheck",
+			Handler:    _GatewayService_MultiTerminalLoginCheck_Handler,
+		},
+		{
+			MethodName: "BroadcastRoom",
+			Handler:    _GatewayService_BroadcastRoom_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "idl/gateway/v1/gateway.proto",
+}
@@ -0,0 +1,1886 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: idl/group/v1/group.proto
+
+package groupv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GroupRole int32
+
+const (
+	GroupRole_GROUP_ROLE_MEMBER GroupRole = 0
+	GroupRole_GROUP_ROLE_ADMIN  GroupRole = 1
+	GroupRole_GROUP_ROLE_OWNER  GroupRole = 2
+)
+
+// Enum value maps for GroupRole.
+var (
+	GroupRole_name = map[int32]string{
+		0: "GROUP_ROLE_MEMBER",
+		1: "GROUP_ROLE_ADMIN",
+		2: "GROUP_ROLE_OWNER",
+	}
+	GroupRole_value = map[string]int32{
+		"GROUP_ROLE_MEMBER": 0,
+		"GROUP_ROLE_ADMIN":  1,
+		"GROUP_ROLE_OWNER":  2,
+	}
+)
+
+func (x GroupRole) Enum() *GroupRole {
+	p := new(GroupRole)
+	*p = x
+	return p
+}
+
+func (x GroupRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GroupRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_idl_group_v1_group_proto_enumTypes[0].Descriptor()
+}
+
+func (GroupRole) Type() protoreflect.EnumType {
+	return &file_idl_group_v1_group_proto_enumTypes[0]
+}
+
+func (x GroupRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GroupRole.Descriptor instead.
+func (GroupRole) EnumDescriptor() ([]byte, []int) {
+	return file_idl_group_v1_group_proto_rawDescGZIP(), []int{0}
+}
+
+type Group struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupID       int64                  `protobuf:"varint,1,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	AvatarUrl     string                 `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	Notice        string                 `protobuf:"bytes,4,opt,name=notice,proto3" json:"notice,omitempty"`
+	OwnerID       int64                  `protobuf:"varint,5,opt,name=ownerID,proto3" json:"ownerID,omitempty"`
+	MemberCount   int32                  `protobuf:"varint,6,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`
+	CreateTime    int64                  `protobuf:"varint,7,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime    int64                  `protobuf:"varint,8,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Group) Reset() {
+	*x = Group{}
+	mi := &file_idl_group_v1_group_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Group) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Group) ProtoMessage() {}
+
+func (x *Group) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_group_v1_group_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Group.ProtoReflect.Descriptor instead.
+func (*Group) Descriptor() ([]byte, []int) {
+	return file_idl_group_v1_group_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Group) GetGroupID() int64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+func (x *Group) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Group) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *Group) GetNotice() string {
+	if x != nil {
+		return x.Notice
+	}
+	return ""
+}
+
+func (x *Group) GetOwnerID() int64 {
+	if x != nil {
+		return x.OwnerID
+	}
+	return 0
+}
+
+func (x *Group) GetMemberCount() int32 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+func (x *Group) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *Group) GetUpdateTime() int64 {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return 0
+}
+
+type GroupMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupID       int64                  `protobuf:"varint,1,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	UserID        int64                  `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	Role          GroupRole              `protobuf:"varint,3,opt,name=role,proto3,enum=group.v1.GroupRole" json:"role,omitempty"`
+	InviterID     int64                  `protobuf:"varint,4,opt,name=inviterID,proto3" json:"inviterID,omitempty"`
+	JoinTime      int64                  `protobuf:"varint,5,opt,name=join_time,json=joinTime,proto3" json:"join_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupMember) Reset() {
+	*x = GroupMember{}
+	mi := &file_idl_group_v1_group_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupMember) ProtoMessage() {}
+
+func (x *GroupMember) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_group_v1_group_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupMember.ProtoReflect.Descriptor instead.
+func (*GroupMember) Descriptor() ([]byte, []int) {
+	return file_idl_group_v1_group_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GroupMember) GetGroupID() int64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+func (x *GroupMember) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *GroupMember) GetRole() GroupRole {
+	if x != nil {
+		return x.Role
+	}
+	return GroupRole_GROUP_ROLE_MEMBER
+}
+
+func (x *GroupMember) GetInviterID() int64 {
+	if x != nil {
+		return x.InviterID
+	}
+	return 0
+}
+
+func (x *GroupMember) GetJoinTime() int64 {
+	if x != nil {
+		return x.JoinTime
+	}
+	return 0
+}
+
+type CreateGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Notice        string                 `protobuf:"bytes,2,opt,name=notice,proto3" json:"notice,omitempty"`
+	MemberIDs     []int64                `protobuf:"varint,3,rep,packed,name=memberIDs,proto3" json:"memberIDs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGroupRequest) Reset() {
+	*x = CreateGroupRequest{}
+	mi := &file_idl_group_v1_group_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupRequest) ProtoMessage() {}
+
+func (x *CreateGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_group_v1_group_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupRequest.ProtoReflect.Descriptor instead.
+func (*CreateGroupRequest) Descriptor() ([]byte, []int) {
+	return file_idl_group_v1_group_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetNotice() string {
+	if x != nil {
+		return x.Notice
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetMemberIDs() []int64 {
+	if x != nil {
+		return x.MemberIDs
+	}
+	return nil
+}
+
+type CreateGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *Group                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGroupResponse) Reset() {
+	*x = CreateGroupResponse{}
+	mi := &file_idl_group_v1_group_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupResponse) ProtoMessage() {}
+
+func (x *CreateGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_group_v1_group_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupResponse.ProtoReflect.Descriptor instead.
+func (*CreateGroupResponse) Descriptor() ([]byte, []int) {
+	return file_idl_group_v1_group_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateGroupResponse) GetData() *Group {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type DismissGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupID       int64                  `protobuf:"varint,1,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DismissGroupRequest) Reset() {
+	*x = DismissGroupRequest{}
+	mi := &file_idl_group_v1_group_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DismissGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DismissGroupRequest) ProtoMessage() {}
+
+func (x *DismissGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_group_v1_group_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DismissGroupRequest.ProtoReflect.Descriptor instead.
+func (*DismissGroupRequest) Descriptor() ([]byte, []int) {
+	return file_idl_group_v1_group_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DismissGroupRequest) GetGroupID() int64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+type DismissGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DismissGroupResponse) Reset() {
+	*x = DismissGroupResponse{}
+	mi := &file_idl_group_v1_group_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DismissGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DismissGroupResponse) ProtoMessage() {}
+
+func (x *DismissGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_group_v1_group_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DismissGroupResponse.ProtoReflect.Descriptor instead.
+func (*DismissGroupResponse) Descriptor() ([]byte, []int) {
+	return file_idl_group_v1_group_proto_rawDescGZIP(), []int{5}
+}
+
+type InviteToGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupID       int64                  `protobuf:"varint,1,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	UserIDs       []int64                `protobuf:"varint,2,rep,packed,name=userIDs,proto3" json:"userIDs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteToGroupRequest) Reset() {
+	*x = InviteToGroupRequest{}
+	mi := &file_idl_group_v1_group_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteToGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteToGroupRequest) ProtoMessage() {}
+
+func (x *InviteToGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_group_v1_group_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteToGroupRequest.ProtoReflect.Descriptor instead.
+func (*InviteToGroupRequest) Descriptor() ([]byte, []int) {
+	return file_idl_group_v1_group_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *InviteToGroupRequest) GetGroupID() int64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+func (x *InviteToGroupRequest) GetUserIDs() []int64 {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+type InviteToGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteToGroupResponse) Reset() {
+	*x = InviteToGroupResponse{}
+	mi := &file_idl_group_v1_group_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteToGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteToGroupResponse) ProtoMessage() {}
+
+func (x *InviteToGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_group_v1_group_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteToGroupResponse.ProtoReflect.Descriptor instead.
+func (*InviteToGroupResponse) Descriptor() ([]byte, []int) {
+	return file_idl_group_v1_group_proto_rawDescGZIP(), []int{7}
+}
+
+type KickGroupMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupID       int64                  `protobuf:"varint,1,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	UserIDs       []int64                `protobuf:"varint,2,rep,packed,name=userIDs,proto3" json:"userIDs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KickGroupMembersRequest) Reset() {
+	*x = KickGroupMembersRequest{}
+	mi := &file_idl_group_v1_group_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickGroupMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickGroupMembersRequest) ProtoMessage() {}
+
+func (x *KickGroupMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_group_v1_group_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickGroupMembersRequest.ProtoReflect.Descriptor instead.
+func (*KickGroupMembersRequest) Descriptor() ([]byte, []int) {
+	return file_idl_group_v1_group_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *KickGroupMembersRequest) GetGroupID() int64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+func (x *KickGroupMembersRequest) GetUserIDs() []int64 {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+type KickGroupMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KickGroupMembersResponse) Reset() {
+	*x = KickGroupMembersResponse{}
+	mi := &file_idl_group_v1_group_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickGroupMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickGroupMembersResponse) ProtoMessage() {}
+
+func (x *KickGroupMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_group_v1_group_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickGroupMembersResponse.ProtoReflect.Descriptor instead.
+func (*KickGroupMembersResponse) Descriptor() ([]byte, []int) {
+	return file_idl_group_v1_group_proto_rawDescGZIP(), []int{9}
+}
+
+type QuitGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupID       int64                  `protobuf:"varint,1,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuitGroupRequest) Reset() {
+	*x = QuitGroupRequest{}
+	mi := &file_idl_group_v1_group_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuitGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuitGroupRequest) ProtoMessage() {}
+
+func (x *QuitGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_group_v1_group_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuitGroupRequest.ProtoReflect.Descriptor instead.
+func (*QuitGroupRequest) Descriptor() ([]byte, []int) {
+	return file_idl_group_v1_group_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *QuitGroupRequest) GetGroupID() int64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+type QuitGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuitGroupResponse) Reset() {
+	*x = QuitGroupResponse{}
+	mi := &file_idl_group_v1_group_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuitGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuitGroupResponse) ProtoMessage() {}
+
+func (x *QuitGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_group_v1_group_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuitGroupResponse.ProtoReflect.Descriptor instead.
+func (*QuitGroupResponse) Descriptor() ([]byte, []int) {
+	return file_idl_group_v1_group_proto_rawDescGZIP(), []int{11}
+}
+
+type TransferGroupOwnerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupID       int64                  `protobuf:"varint,1,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	NewOwnerID    int64                  `protobuf:"varint,2,opt,name=new_ownerID,json=newOwnerID,proto3" json:"new_ownerID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferGroupOwnerRequest) Reset() {
+	*x = TransferGroupOwnerRequest{}
+	mi := &file_idl_group_v1_group_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferGroupOwnerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferGroupOwnerRequest) ProtoMessage() {}
+
+func (x *TransferGroupOwnerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_group_v1_group_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferGroupOwnerRequest.ProtoReflect.Descriptor instead.
+func (*TransferGroupOwnerRequest) Descriptor() ([]byte, []int) {
+	return file_idl_group_v1_group_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TransferGroupOwnerRequest) GetGroupID() int64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+func (x *TransferGroupOwnerRequest) GetNewOwnerID() int64 {
+	if x != nil {
+		return x.NewOwnerID
+	}
+	return 0
+}
+
+type TransferGroupOwnerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferGroupOwnerResponse) Reset() {
+	*x = TransferGroupOwnerResponse{}
+	mi := &file_idl_group_v1_group_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferGroupOwnerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferGroupOwnerResponse) ProtoMessage() {}
+
+func (x *TransferGroupOwnerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_group_v1_group_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferGroupOwnerResponse.ProtoReflect.Descriptor instead.
+func (*TransferGroupOwnerResponse) Descriptor() ([]byte, []int) {
+	return file_idl_group_v1_group_proto_rawDescGZIP(), []int{13}
+}
+
+type SetGroupMemberRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupID       int64                  `protobuf:"varint,1,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	UserID        int64                  `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	Role          GroupRole              `protobuf:"varint,3,opt,name=role,proto3,enum=group.v1.GroupRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetGroupMemberRoleRequest) Reset() {
+	*x = SetGroupMemberRoleRequest{}
+	mi := &file_idl_group_v1_group_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetGroupMemberRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGroupMemberRoleRequest) ProtoMessage() {}
+
+func (x *SetGroupMemberRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_group_v1_group_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGroupMemberRoleRequest.ProtoReflect.Descriptor instead.
+func (*SetGroupMemberRoleRequest) Descriptor() ([]byte, []int) {
+	return file_idl_group_v1_group_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SetGroupMemberRoleRequest) GetGroupID() int64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+func (x *SetGroupMemberRoleRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *SetGroupMemberRoleRequest) GetRole() GroupRole {
+	if x != nil {
+		return x.Role
+	}
+	return GroupRole_GROUP_ROLE_MEMBER
+}
+
+type SetGroupMemberRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetGroupMemberRoleResponse) Reset() {
+	*x = SetGroupMemberRoleResponse{}
+	mi := &file_idl_group_v1_group_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetGroupMemberRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGroupMemberRoleResponse) ProtoMessage() {}
+
+func (x *SetGroupMemberRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_group_v1_group_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGroupMemberRoleResponse.ProtoReflect.Descriptor instead.
+func (*SetGroupMemberRoleResponse) Descriptor() ([]byte, []int) {
+	return file_idl_group_v1_group_proto_rawDescGZIP(), []int{15}
+}
+
+type GetGroupsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupIDs      []int64                `protobuf:"varint,1,rep,packed,name=groupIDs,proto3" json:"groupIDs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupsRequest) Reset() {
+	*x = GetGroupsRequest{}
+	mi := &file_idl_group_v1_group_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupsRequest) ProtoMessage() {}
+
+func (x *GetGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_group_v1_group_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupsRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_idl_group_v1_group_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetGroupsRequest) GetGroupIDs() []int64 {
+	if x != nil {
+		return x.GroupIDs
+	}
+	return nil
+}
+
+type GetGroupsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*Group               `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupsResponse) Reset() {
+	*x = GetGroupsResponse{}
+	mi := &file_idl_group_v1_group_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupsResponse) ProtoMessage() {}
+
+func (x *GetGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_group_v1_group_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupsResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_idl_group_v1_group_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetGroupsResponse) GetGroups() []*Group {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type ListJoinedGroupsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Size          int32                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJoinedGroupsRequest) Reset() {
+	*x = ListJoinedGroupsRequest{}
+	mi := &file_idl_group_v1_group_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJoinedGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJoinedGroupsRequest) ProtoMessage() {}
+
+func (x *ListJoinedGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_group_v1_group_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJoinedGroupsRequest.ProtoReflect.Descriptor instead.
+func (*ListJoinedGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_idl_group_v1_group_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListJoinedGroupsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListJoinedGroupsRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type ListJoinedGroupsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*Group               `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJoinedGroupsResponse) Reset() {
+	*x = ListJoinedGroupsResponse{}
+	mi := &file_idl_group_v1_group_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJoinedGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJoinedGroupsResponse) ProtoMessage() {}
+
+func (x *ListJoinedGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_group_v1_group_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJoinedGroupsResponse.ProtoReflect.Descriptor instead.
+func (*ListJoinedGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_idl_group_v1_group_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListJoinedGroupsResponse) GetGroups() []*Group {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *ListJoinedGroupsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type SetGroupInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupID       int64                  `protobuf:"varint,1,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Notice        *string                `protobuf:"bytes,3,opt,name=notice,proto3,oneof" json:"notice,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetGroupInfoRequest) Reset() {
+	*x = SetGroupInfoRequest{}
+	mi := &file_idl_group_v1_group_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetGroupInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGroupInfoRequest) ProtoMessage() {}
+
+func (x *SetGroupInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_group_v1_group_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGroupInfoRequest.ProtoReflect.Descriptor instead.
+func (*SetGroupInfoRequest) Descriptor() ([]byte, []int) {
+	return file_idl_group_v1_group_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SetGroupInfoRequest) GetGroupID() int64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+func (x *SetGroupInfoRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *SetGroupInfoRequest) GetNotice() string {
+	if x != nil && x.Notice != nil {
+		return *x.Notice
+	}
+	return ""
+}
+
+type SetGroupInfoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetGroupInfoResponse) Reset() {
+	*x = SetGroupInfoResponse{}
+	mi := &file_idl_group_v1_group_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetGroupInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGroupInfoResponse) ProtoMessage() {}
+
+func (x *SetGroupInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_group_v1_group_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGroupInfoResponse.ProtoReflect.Descriptor instead.
+func (*SetGroupInfoResponse) Descriptor() ([]byte, []int) {
+	return file_idl_group_v1_group_proto_rawDescGZIP(), []int{21}
+}
+
+type UpdateGroupAvatarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupID       int64                  `protobuf:"varint,1,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	Avatar        []byte                 `protobuf:"bytes,2,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	MimeType      string                 `protobuf:"bytes,3,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGroupAvatarRequest) Reset() {
+	*x = UpdateGroupAvatarRequest{}
+	mi := &file_idl_group_v1_group_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGroupAvatarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupAvatarRequest) ProtoMessage() {}
+
+func (x *UpdateGroupAvatarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_group_v1_group_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupAvatarRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGroupAvatarRequest) Descriptor() ([]byte, []int) {
+	return file_idl_group_v1_group_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UpdateGroupAvatarRequest) GetGroupID() int64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+func (x *UpdateGroupAvatarRequest) GetAvatar() []byte {
+	if x != nil {
+		return x.Avatar
+	}
+	return nil
+}
+
+func (x *UpdateGroupAvatarRequest) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+type UpdateGroupAvatarResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AvatarUrl     string                 `protobuf:"bytes,1,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGroupAvatarResponse) Reset() {
+	*x = UpdateGroupAvatarResponse{}
+	mi := &file_idl_group_v1_group_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGroupAvatarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupAvatarResponse) ProtoMessage() {}
+
+func (x *UpdateGroupAvatarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_group_v1_group_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupAvatarResponse.ProtoReflect.Descriptor instead.
+func (*UpdateGroupAvatarResponse) Descriptor() ([]byte, []int) {
+	return file_idl_group_v1_group_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UpdateGroupAvatarResponse) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+type ListGroupMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupID       int64                  `protobuf:"varint,1,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Size          int32                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroupMembersRequest) Reset() {
+	*x = ListGroupMembersRequest{}
+	mi := &file_idl_group_v1_group_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupMembersRequest) ProtoMessage() {}
+
+func (x *ListGroupMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_group_v1_group_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListGroupMembersRequest) Descriptor() ([]byte, []int) {
+	return file_idl_group_v1_group_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListGroupMembersRequest) GetGroupID() int64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+func (x *ListGroupMembersRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListGroupMembersRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type ListGroupMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*GroupMember         `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroupMembersResponse) Reset() {
+	*x = ListGroupMembersResponse{}
+	mi := &file_idl_group_v1_group_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupMembersResponse) ProtoMessage() {}
+
+func (x *ListGroupMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_group_v1_group_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListGroupMembersResponse) Descriptor() ([]byte, []int) {
+	return file_idl_group_v1_group_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListGroupMembersResponse) GetMembers() []*GroupMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *ListGroupMembersResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetGroupMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupID       int64                  `protobuf:"varint,1,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	UserIDs       []int64                `protobuf:"varint,2,rep,packed,name=userIDs,proto3" json:"userIDs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupMembersRequest) Reset() {
+	*x = GetGroupMembersRequest{}
+	mi := &file_idl_group_v1_group_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupMembersRequest) ProtoMessage() {}
+
+func (x *GetGroupMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_group_v1_group_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupMembersRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupMembersRequest) Descriptor() ([]byte, []int) {
+	return file_idl_group_v1_group_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetGroupMembersRequest) GetGroupID() int64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+func (x *GetGroupMembersRequest) GetUserIDs() []int64 {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+type GetGroupMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*GroupMember         `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupMembersResponse) Reset() {
+	*x = GetGroupMembersResponse{}
+	mi := &file_idl_group_v1_group_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupMembersResponse) ProtoMessage() {}
+
+func (x *GetGroupMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_group_v1_group_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupMembersResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupMembersResponse) Descriptor() ([]byte, []int) {
+	return file_idl_group_v1_group_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetGroupMembersResponse) GetMembers() []*GroupMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type GetGroupMemberIDsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupID       int64                  `protobuf:"varint,1,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupMemberIDsRequest) Reset() {
+	*x = GetGroupMemberIDsRequest{}
+	mi := &file_idl_group_v1_group_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupMemberIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupMemberIDsRequest) ProtoMessage() {}
+
+func (x *GetGroupMemberIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_group_v1_group_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupMemberIDsRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupMemberIDsRequest) Descriptor() ([]byte, []int) {
+	return file_idl_group_v1_group_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetGroupMemberIDsRequest) GetGroupID() int64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+type GetGroupMemberIDsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemberIDs     []int64                `protobuf:"varint,1,rep,packed,name=memberIDs,proto3" json:"memberIDs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupMemberIDsResponse) Reset() {
+	*x = GetGroupMemberIDsResponse{}
+	mi := &file_idl_group_v1_group_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupMemberIDsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupMemberIDsResponse) ProtoMessage() {}
+
+func (x *GetGroupMemberIDsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_group_v1_group_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupMemberIDsResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupMemberIDsResponse) Descriptor() ([]byte, []int) {
+	return file_idl_group_v1_group_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetGroupMemberIDsResponse) GetMemberIDs() []int64 {
+	if x != nil {
+		return x.MemberIDs
+	}
+	return nil
+}
+
+type GetJoinedGroupIDsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupIDs      []int64                `protobuf:"varint,1,rep,packed,name=groupIDs,proto3" json:"groupIDs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJoinedGroupIDsRequest) Reset() {
+	*x = GetJoinedGroupIDsRequest{}
+	mi := &file_idl_group_v1_group_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJoinedGroupIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJoinedGroupIDsRequest) ProtoMessage() {}
+
+func (x *GetJoinedGroupIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_group_v1_group_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJoinedGroupIDsRequest.ProtoReflect.Descriptor instead.
+func (*GetJoinedGroupIDsRequest) Descriptor() ([]byte, []int) {
+	return file_idl_group_v1_group_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetJoinedGroupIDsRequest) GetGroupIDs() []int64 {
+	if x != nil {
+		return x.GroupIDs
+	}
+	return nil
+}
+
+type GetJoinedGroupIDsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupIDs      []int64                `protobuf:"varint,1,rep,packed,name=groupIDs,proto3" json:"groupIDs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJoinedGroupIDsResponse) Reset() {
+	*x = GetJoinedGroupIDsResponse{}
+	mi := &file_idl_group_v1_group_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJoinedGroupIDsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJoinedGroupIDsResponse) ProtoMessage() {}
+
+func (x *GetJoinedGroupIDsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_group_v1_group_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJoinedGroupIDsResponse.ProtoReflect.Descriptor instead.
+func (*GetJoinedGroupIDsResponse) Descriptor() ([]byte, []int) {
+	return file_idl_group_v1_group_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetJoinedGroupIDsResponse) GetGroupIDs() []int64 {
+	if x != nil {
+		return x.GroupIDs
+	}
+	return nil
+}
+
+var File_idl_group_v1_group_proto protoreflect.FileDescriptor
+
+const file_idl_group_v1_group_proto_rawDesc = "" +
+	"\n" +
+	"\x18idl/group/v1/group.proto\x12\bgroup.v1\"\xeb\x01\n" +
+	"\x05Group\x12\x18\n" +
+	"\agroupID\x18\x01 \x01(\x03R\agroupID\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x03 \x01(\tR\tavatarUrl\x12\x16\n" +
+	"\x06notice\x18\x04 \x01(\tR\x06notice\x12\x18\n" +
+	"\aownerID\x18\x05 \x01(\x03R\aownerID\x12!\n" +
+	"\fmember_count\x18\x06 \x01(\x05R\vmemberCount\x12\x1f\n" +
+	"\vcreate_time\x18\a \x01(\x03R\n" +
+	"createTime\x12\x1f\n" +
+	"\vupdate_time\x18\b \x01(\x03R\n" +
+	"updateTime\"\xa3\x01\n" +
+	"\vGroupMember\x12\x18\n" +
+	"\agroupID\x18\x01 \x01(\x03R\agroupID\x12\x16\n" +
+	"\x06userID\x18\x02 \x01(\x03R\x06userID\x12'\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x13.group.v1.GroupRoleR\x04role\x12\x1c\n" +
+	"\tinviterID\x18\x04 \x01(\x03R\tinviterID\x12\x1b\n" +
+	"\tjoin_time\x18\x05 \x01(\x03R\bjoinTime\"^\n" +
+	"\x12CreateGroupRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06notice\x18\x02 \x01(\tR\x06notice\x12\x1c\n" +
+	"\tmemberIDs\x18\x03 \x03(\x03R\tmemberIDs\":\n" +
+	"\x13CreateGroupResponse\x12#\n" +
+	"\x04data\x18\x01 \x01(\v2\x0f.group.v1.GroupR\x04data\"/\n" +
+	"\x13DismissGroupRequest\x12\x18\n" +
+	"\agroupID\x18\x01 \x01(\x03R\agroupID\"\x16\n" +
+	"\x14DismissGroupResponse\"J\n" +
+	"\x14InviteToGroupRequest\x12\x18\n" +
+	"\agroupID\x18\x01 \x01(\x03R\agroupID\x12\x18\n" +
+	"\auserIDs\x18\x02 \x03(\x03R\auserIDs\"\x17\n" +
+	"\x15InviteToGroupResponse\"M\n" +
+	"\x17KickGroupMembersRequest\x12\x18\n" +
+	"\agroupID\x18\x01 \x01(\x03R\agroupID\x12\x18\n" +
+	"\auserIDs\x18\x02 \x03(\x03R\auserIDs\"\x1a\n" +
+	"\x18KickGroupMembersResponse\",\n" +
+	"\x10QuitGroupRequest\x12\x18\n" +
+	"\agroupID\x18\x01 \x01(\x03R\agroupID\"\x13\n" +
+	"\x11QuitGroupResponse\"V\n" +
+	"\x19TransferGroupOwnerRequest\x12\x18\n" +
+	"\agroupID\x18\x01 \x01(\x03R\agroupID\x12\x1f\n" +
+	"\vnew_ownerID\x18\x02 \x01(\x03R\n" +
+	"newOwnerID\"\x1c\n" +
+	"\x1aTransferGroupOwnerResponse\"v\n" +
+	"\x19SetGroupMemberRoleRequest\x12\x18\n" +
+	"\agroupID\x18\x01 \x01(\x03R\agroupID\x12\x16\n" +
+	"\x06userID\x18\x02 \x01(\x03R\x06userID\x12'\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x13.group.v1.GroupRoleR\x04role\"\x1c\n" +
+	"\x1aSetGroupMemberRoleResponse\".\n" +
+	"\x10GetGroupsRequest\x12\x1a\n" +
+	"\bgroupIDs\x18\x01 \x03(\x03R\bgroupIDs\"<\n" +
+	"\x11GetGroupsResponse\x12'\n" +
+	"\x06groups\x18\x01 \x03(\v2\x0f.group.v1.GroupR\x06groups\"A\n" +
+	"\x17ListJoinedGroupsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x05R\x04size\"Y\n" +
+	"\x18ListJoinedGroupsResponse\x12'\n" +
+	"\x06groups\x18\x01 \x03(\v2\x0f.group.v1.GroupR\x06groups\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"y\n" +
+	"\x13SetGroupInfoRequest\x12\x18\n" +
+	"\agroupID\x18\x01 \x01(\x03R\agroupID\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x1b\n" +
+	"\x06notice\x18\x03 \x01(\tH\x01R\x06notice\x88\x01\x01B\a\n" +
+	"\x05_nameB\t\n" +
+	"\a_notice\"\x16\n" +
+	"\x14SetGroupInfoResponse\"i\n" +
+	"\x18UpdateGroupAvatarRequest\x12\x18\n" +
+	"\agroupID\x18\x01 \x01(\x03R\agroupID\x12\x16\n" +
+	"\x06avatar\x18\x02 \x01(\fR\x06avatar\x12\x1b\n" +
+	"\tmime_type\x18\x03 \x01(\tR\bmimeType\":\n" +
+	"\x19UpdateGroupAvatarResponse\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x01 \x01(\tR\tavatarUrl\"[\n" +
+	"\x17ListGroupMembersRequest\x12\x18\n" +
+	"\agroupID\x18\x01 \x01(\x03R\agroupID\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x05R\x04size\"a\n" +
+	"\x18ListGroupMembersResponse\x12/\n" +
+	"\amembers\x18\x01 \x03(\v2\x15.group.v1.GroupMemberR\amembers\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"L\n" +
+	"\x16GetGroupMembersRequest\x12\x18\n" +
+	"\agroupID\x18\x01 \x01(\x03R\agroupID\x12\x18\n" +
+	"\auserIDs\x18\x02 \x03(\x03R\auserIDs\"J\n" +
+	"\x17GetGroupMembersResponse\x12/\n" +
+	"\amembers\x18\x01 \x03(\v2\x15.group.v1.GroupMemberR\amembers\"4\n" +
+	"\x18GetGroupMemberIDsRequest\x12\x18\n" +
+	"\agroupID\x18\x01 \x01(\x03R\agroupID\"9\n" +
+	"\x19GetGroupMemberIDsResponse\x12\x1c\n" +
+	"\tmemberIDs\x18\x01 \x03(\x03R\tmemberIDs\"6\n" +
+	"\x18GetJoinedGroupIDsRequest\x12\x1a\n" +
+	"\bgroupIDs\x18\x01 \x03(\x03R\bgroupIDs\"7\n" +
+	"\x19GetJoinedGroupIDsResponse\x12\x1a\n" +
+	"\bgroupIDs\x18\x01 \x03(\x03R\bgroupIDs*N\n" +
+	"\tGroupRole\x12\x15\n" +
+	"\x11GROUP_ROLE_MEMBER\x10\x00\x12\x14\n" +
+	"\x10GROUP_ROLE_ADMIN\x10\x01\x12\x14\n" +
+	"\x10GROUP_ROLE_OWNER\x10\x022\x9b\n" +
+	"\n" +
+	"\fGroupService\x12J\n" +
+	"\vCreateGroup\x12\x1c.group.v1.CreateGroupRequest\x1a\x1d.group.v1.CreateGroupResponse\x12M\n" +
+	"\fDismissGroup\x12\x1d.group.v1.DismissGroupRequest\x1a\x1e.group.v1.DismissGroupResponse\x12P\n" +
+	"\rInviteToGroup\x12\x1e.group.v1.InviteToGroupRequest\x1a\x1f.group.v1.InviteToGroupResponse\x12Y\n" +
+	"\x10KickGroupMembers\x12!.group.v1.KickGroupMembersRequest\x1a\".group.v1.KickGroupMembersResponse\x12D\n" +
+	"\tQuitGroup\x12\x1a.group.v1.QuitGroupRequest\x1a\x1b.group.v1.QuitGroupResponse\x12_\n" +
+	"\x12TransferGroupOwner\x12#.group.v1.TransferGroupOwnerRequest\x1a$.group.v1.TransferGroupOwnerResponse\x12_\n" +
+	"\x12SetGroupMemberRole\x12#.group.v1.SetGroupMemberRoleRequest\x1a$.group.v1.SetGroupMemberRoleResponse\x12D\n" +
+	"\tGetGroups\x12\x1a.group.v1.GetGroupsRequest\x1a\x1b.group.v1.GetGroupsResponse\x12Y\n" +
+	"\x10ListJoinedGroups\x12!.group.v1.ListJoinedGroupsRequest\x1a\".group.v1.ListJoinedGroupsResponse\x12M\n" +
+	"\fSetGroupInfo\x12\x1d.group.v1.SetGroupInfoRequest\x1a\x1e.group.v1.SetGroupInfoResponse\x12\\\n" +
+	"\x11UpdateGroupAvatar\x12\".group.v1.UpdateGroupAvatarRequest\x1a#.group.v1.UpdateGroupAvatarResponse\x12Y\n" +
+	"\x10ListGroupMembers\x12!.group.v1.ListGroupMembersRequest\x1a\".group.v1.ListGroupMembersResponse\x12V\n" +
+	"\x0fGetGroupMembers\x12 .group.v1.GetGroupMembersRequest\x1a!.group.v1.GetGroupMembersResponse\x12\\\n" +
+	"\x11GetGroupMemberIDs\x12\".group.v1.GetGroupMemberIDsRequest\x1a#.group.v1.GetGroupMemberIDsResponse\x12\\\n" +
+	"\x11GetJoinedGroupIDs\x12\".group.v1.GetJoinedGroupIDsRequest\x1a#.group.v1.GetJoinedGroupIDsResponseB8Z6github.com/crazyfrankie/goim/protocol/group/v1;groupv1b\x06proto3"
+
+var (
+	file_idl_group_v1_group_proto_rawDescOnce sync.Once
+	file_idl_group_v1_group_proto_rawDescData []byte
+)
+
+func file_idl_group_v1_group_proto_rawDescGZIP() []byte {
+	file_idl_group_v1_group_proto_rawDescOnce.Do(func() {
+		file_idl_group_v1_group_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_idl_group_v1_group_proto_rawDesc), len(file_idl_group_v1_group_proto_rawDesc)))
+	})
+	return file_idl_group_v1_group_proto_rawDescData
+}
+
+var file_idl_group_v1_group_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_idl_group_v1_group_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
+var file_idl_group_v1_group_proto_goTypes = []any{
+	(GroupRole)(0),                     // 0: group.v1.GroupRole
+	(*Group)(nil),                      // 1: group.v1.Group
+	(*GroupMember)(nil),                // 2: group.v1.GroupMember
+	(*CreateGroupRequest)(nil),         // 3: group.v1.CreateGroupRequest
+	(*CreateGroupResponse)(nil),        // 4: group.v1.CreateGroupResponse
+	(*DismissGroupRequest)(nil),        // 5: group.v1.DismissGroupRequest
+	(*DismissGroupResponse)(nil),       // 6: group.v1.DismissGroupResponse
+	(*InviteToGroupRequest)(nil),       // 7: group.v1.InviteToGroupRequest
+	(*InviteToGroupResponse)(nil),      // 8: group.v1.InviteToGroupResponse
+	(*KickGroupMembersRequest)(nil),    // 9: group.v1.KickGroupMembersRequest
+	(*KickGroupMembersResponse)(nil),   // 10: group.v1.KickGroupMembersResponse
+	(*QuitGroupRequest)(nil),           // 11: group.v1.QuitGroupRequest
+	(*QuitGroupResponse)(nil),          // 12: group.v1.QuitGroupResponse
+	(*TransferGroupOwnerRequest)(nil),  // 13: group.v1.TransferGroupOwnerRequest
+	(*TransferGroupOwnerResponse)(nil), // 14: group.v1.TransferGroupOwnerResponse
+	(*SetGroupMemberRoleRequest)(nil),  // 15: group.v1.SetGroupMemberRoleRequest
+	(*SetGroupMemberRoleResponse)(nil), // 16: group.v1.SetGroupMemberRoleResponse
+	(*GetGroupsRequest)(nil),           // 17: group.v1.GetGroupsRequest
+	(*GetGroupsResponse)(nil),          // 18: group.v1.GetGroupsResponse
+	(*ListJoinedGroupsRequest)(nil),    // 19: group.v1.ListJoinedGroupsRequest
+	(*ListJoinedGroupsResponse)(nil),   // 20: group.v1.ListJoinedGroupsResponse
+	(*SetGroupInfoRequest)(nil),        // 21: group.v1.SetGroupInfoRequest
+	(*SetGroupInfoResponse)(nil),       // 22: group.v1.SetGroupInfoResponse
+	(*UpdateGroupAvatarRequest)(nil),   // 23: group.v1.UpdateGroupAvatarRequest
+	(*UpdateGroupAvatarResponse)(nil),  // 24: group.v1.UpdateGroupAvatarResponse
+	(*ListGroupMembersRequest)(nil),    // 25: group.v1.ListGroupMembersRequest
+	(*ListGroupMembersResponse)(nil),   // 26: group.v1.ListGroupMembersResponse
+	(*GetGroupMembersRequest)(nil),     // 27: group.v1.GetGroupMembersRequest
+	(*GetGroupMembersResponse)(nil),    // 28: group.v1.GetGroupMembersResponse
+	(*GetGroupMemberIDsRequest)(nil),   // 29: group.v1.GetGroupMemberIDsRequest
+	(*GetGroupMemberIDsResponse)(nil),  // 30: group.v1.GetGroupMemberIDsResponse
+	(*GetJoinedGroupIDsRequest)(nil),   // 31: group.v1.GetJoinedGroupIDsRequest
+	(*GetJoinedGroupIDsResponse)(nil),  // 32: group.v1.GetJoinedGroupIDsResponse
+}
+var file_idl_group_v1_group_proto_depIdxs = []int32{
+	0,  // 0: group.v1.GroupMember.role:type_name -> group.v1.GroupRole
+	1,  // 1: group.v1.CreateGroupResponse.data:type_name -> group.v1.Group
+	0,  // 2: group.v1.SetGroupMemberRoleRequest.role:type_name -> group.v1.GroupRole
+	1,  // 3: group.v1.GetGroupsResponse.groups:type_name -> group.v1.Group
+	1,  // 4: group.v1.ListJoinedGroupsResponse.groups:type_name -> group.v1.Group
+	2,  // 5: group.v1.ListGroupMembersResponse.members:type_name -> group.v1.GroupMember
+	2,  // 6: group.v1.GetGroupMembersResponse.members:type_name -> group.v1.GroupMember
+	3,  // 7: group.v1.GroupService.CreateGroup:input_type -> group.v1.CreateGroupRequest
+	5,  // 8: group.v1.GroupService.DismissGroup:input_type -> group.v1.DismissGroupRequest
+	7,  // 9: group.v1.GroupService.InviteToGroup:input_type -> group.v1.InviteToGroupRequest
+	9,  // 10: group.v1.GroupService.KickGroupMembers:input_type -> group.v1.KickGroupMembersRequest
+	11, // 11: group.v1.GroupService.QuitGroup:input_type -> group.v1.QuitGroupRequest
+	13, // 12: group.v1.GroupService.TransferGroupOwner:input_type -> group.v1.TransferGroupOwnerRequest
+	15, // 13: group.v1.GroupService.SetGroupMemberRole:input_type -> group.v1.SetGroupMemberRoleRequest
+	17, // 14: group.v1.GroupService.GetGroups:input_type -> group.v1.GetGroupsRequest
+	19, // 15: group.v1.GroupService.ListJoinedGroups:input_type -> group.v1.ListJoinedGroupsRequest
+	21, // 16: group.v1.GroupService.SetGroupInfo:input_type -> group.v1.SetGroupInfoRequest
+	23, // 17: group.v1.GroupService.UpdateGroupAvatar:input_type -> group.v1.UpdateGroupAvatarRequest
+	25, // 18: group.v1.GroupService.ListGroupMembers:input_type -> group.v1.ListGroupMembersRequest
+	27, // 19: group.v1.GroupService.GetGroupMembers:input_type -> group.v1.GetGroupMembersRequest
+	29, // 20: group.v1.GroupService.GetGroupMemberIDs:input_type -> group.v1.GetGroupMemberIDsRequest
+	31, // 21: group.v1.GroupService.GetJoinedGroupIDs:input_type -> group.v1.GetJoinedGroupIDsRequest
+	4,  // 22: group.v1.GroupService.CreateGroup:output_type -> group.v1.CreateGroupResponse
+	6,  // 23: group.v1.GroupService.DismissGroup:output_type -> group.v1.DismissGroupResponse
+	8,  // 24: group.v1.GroupService.InviteToGroup:output_type -> group.v1.InviteToGroupResponse
+	10, // 25: group.v1.GroupService.KickGroupMembers:output_type -> group.v1.KickGroupMembersResponse
+	12, // 26: group.v1.GroupService.QuitGroup:output_type -> group.v1.QuitGroupResponse
+	14, // 27: group.v1.GroupService.TransferGroupOwner:output_type -> group.v1.TransferGroupOwnerResponse
+	16, // 28: group.v1.GroupService.SetGroupMemberRole:output_type -> group.v1.SetGroupMemberRoleResponse
+	18, // 29: group.v1.GroupService.GetGroups:output_type -> group.v1.GetGroupsResponse
+	20, // 30: group.v1.GroupService.ListJoinedGroups:output_type -> group.v1.ListJoinedGroupsResponse
+	22, // 31: group.v1.GroupService.SetGroupInfo:output_type -> group.v1.SetGroupInfoResponse
+	24, // 32: group.v1.GroupService.UpdateGroupAvatar:output_type -> group.v1.UpdateGroupAvatarResponse
+	26, // 33: group.v1.GroupService.ListGroupMembers:output_type -> group.v1.ListGroupMembersResponse
+	28, // 34: group.v1.GroupService.GetGroupMembers:output_type -> group.v1.GetGroupMembersResponse
+	30, // 35: group.v1.GroupService.GetGroupMemberIDs:output_type -> group.v1.GetGroupMemberIDsResponse
+	32, // 36: group.v1.GroupService.GetJoinedGroupIDs:output_type -> group.v1.GetJoinedGroupIDsResponse
+	22, // [22:37] is the sub-list for method output_type
+	7,  // [7:22] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_idl_group_v1_group_proto_init() }
+func file_idl_group_v1_group_proto_init() {
+	if File_idl_group_v1_group_proto != nil {
+		return
+	}
+	file_idl_group_v1_group_proto_msgTypes[20].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_idl_group_v1_group_proto_rawDesc), len(file_idl_group_v1_group_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   32,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_idl_group_v1_group_proto_goTypes,
+		DependencyIndexes: file_idl_group_v1_group_proto_depIdxs,
+		EnumInfos:         file_idl_group_v1_group_proto_enumTypes,
+		MessageInfos:      file_idl_group_v1_group_proto_msgTypes,
+	}.Build()
+	File_idl_group_v1_group_proto = out.File
+	file_idl_group_v1_group_proto_goTypes = nil
+	file_idl_group_v1_group_proto_depIdxs = nil
+}
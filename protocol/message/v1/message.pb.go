@@ -21,19 +21,132 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// MessageStatus is the status messages are moved to. Its values are not those
+// of Message.status, which carries the stored status as is.
+type MessageStatus int32
+
+const (
+	MessageStatus_MESSAGE_STATUS_UNSPECIFIED MessageStatus = 0
+	MessageStatus_MESSAGE_STATUS_SENT        MessageStatus = 1
+	MessageStatus_MESSAGE_STATUS_REVOKED     MessageStatus = 2
+	MessageStatus_MESSAGE_STATUS_SENDING     MessageStatus = 3
+	MessageStatus_MESSAGE_STATUS_DELIVERED   MessageStatus = 4
+	MessageStatus_MESSAGE_STATUS_FAILED      MessageStatus = 5
+	MessageStatus_MESSAGE_STATUS_DELETED     MessageStatus = 6
+)
+
+// Enum value maps for MessageStatus.
+var (
+	MessageStatus_name = map[int32]string{
+		0: "MESSAGE_STATUS_UNSPECIFIED",
+		1: "MESSAGE_STATUS_SENT",
+		2: "MESSAGE_STATUS_REVOKED",
+		3: "MESSAGE_STATUS_SENDING",
+		4: "MESSAGE_STATUS_DELIVERED",
+		5: "MESSAGE_STATUS_FAILED",
+		6: "MESSAGE_STATUS_DELETED",
+	}
+	MessageStatus_value = map[string]int32{
+		"MESSAGE_STATUS_UNSPECIFIED": 0,
+		"MESSAGE_STATUS_SENT":        1,
+		"MESSAGE_STATUS_REVOKED":     2,
+		"MESSAGE_STATUS_SENDING":     3,
+		"MESSAGE_STATUS_DELIVERED":   4,
+		"MESSAGE_STATUS_FAILED":      5,
+		"MESSAGE_STATUS_DELETED":     6,
+	}
+)
+
+func (x MessageStatus) Enum() *MessageStatus {
+	p := new(MessageStatus)
+	*p = x
+	return p
+}
+
+func (x MessageStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MessageStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_idl_message_v1_message_proto_enumTypes[0].Descriptor()
+}
+
+func (MessageStatus) Type() protoreflect.EnumType {
+	return &file_idl_message_v1_message_proto_enumTypes[0]
+}
+
+func (x MessageStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MessageStatus.Descriptor instead.
+func (MessageStatus) EnumDescriptor() ([]byte, []int) {
+	return file_idl_message_v1_message_proto_rawDescGZIP(), []int{0}
+}
+
+type PullOrder int32
+
+const (
+	PullOrder_PULL_ORDER_ASC  PullOrder = 0
+	PullOrder_PULL_ORDER_DESC PullOrder = 1
+)
+
+// Enum value maps for PullOrder.
+var (
+	PullOrder_name = map[int32]string{
+		0: "PULL_ORDER_ASC",
+		1: "PULL_ORDER_DESC",
+	}
+	PullOrder_value = map[string]int32{
+		"PULL_ORDER_ASC":  0,
+		"PULL_ORDER_DESC": 1,
+	}
+)
+
+func (x PullOrder) Enum() *PullOrder {
+	p := new(PullOrder)
+	*p = x
+	return p
+}
+
+func (x PullOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PullOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_idl_message_v1_message_proto_enumTypes[1].Descriptor()
+}
+
+func (PullOrder) Type() protoreflect.EnumType {
+	return &file_idl_message_v1_message_proto_enumTypes[1]
+}
+
+func (x PullOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PullOrder.Descriptor instead.
+func (PullOrder) EnumDescriptor() ([]byte, []int) {
+	return file_idl_message_v1_message_proto_rawDescGZIP(), []int{1}
+}
+
 type Message struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SendID        int64                  `protobuf:"varint,1,opt,name=sendID,proto3" json:"sendID,omitempty"`
-	RecvID        int64                  `protobuf:"varint,2,opt,name=recvID,proto3" json:"recvID,omitempty"`
-	GroupID       int64                  `protobuf:"varint,3,opt,name=groupID,proto3" json:"groupID,omitempty"`
-	ClientMsgID   string                 `protobuf:"bytes,4,opt,name=client_msgID,json=clientMsgID,proto3" json:"client_msgID,omitempty"`
-	SessionType   int32                  `protobuf:"varint,5,opt,name=session_type,json=sessionType,proto3" json:"session_type,omitempty"`
-	MessageFrom   int32                  `protobuf:"varint,6,opt,name=message_from,json=messageFrom,proto3" json:"message_from,omitempty"`
-	ContentType   int32                  `protobuf:"varint,7,opt,name=contentType,proto3" json:"contentType,omitempty"`
-	SendTime      int64                  `protobuf:"varint,8,opt,name=send_time,json=sendTime,proto3" json:"send_time,omitempty"`
-	Content       []byte                 `protobuf:"bytes,9,opt,name=content,proto3" json:"content,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SendID         int64                  `protobuf:"varint,1,opt,name=sendID,proto3" json:"sendID,omitempty"`
+	RecvID         int64                  `protobuf:"varint,2,opt,name=recvID,proto3" json:"recvID,omitempty"`
+	GroupID        int64                  `protobuf:"varint,3,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	ClientMsgID    string                 `protobuf:"bytes,4,opt,name=client_msgID,json=clientMsgID,proto3" json:"client_msgID,omitempty"`
+	SessionType    int32                  `protobuf:"varint,5,opt,name=session_type,json=sessionType,proto3" json:"session_type,omitempty"`
+	MessageFrom    int32                  `protobuf:"varint,6,opt,name=message_from,json=messageFrom,proto3" json:"message_from,omitempty"`
+	ContentType    int32                  `protobuf:"varint,7,opt,name=contentType,proto3" json:"contentType,omitempty"`
+	SendTime       int64                  `protobuf:"varint,8,opt,name=send_time,json=sendTime,proto3" json:"send_time,omitempty"`
+	Content        []byte                 `protobuf:"bytes,9,opt,name=content,proto3" json:"content,omitempty"`
+	ServerMsgID    int64                  `protobuf:"varint,10,opt,name=serverMsgID,proto3" json:"serverMsgID,omitempty"`
+	Seq            int64                  `protobuf:"varint,11,opt,name=seq,proto3" json:"seq,omitempty"`
+	ConversationID string                 `protobuf:"bytes,12,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+	Status         int32                  `protobuf:"varint,13,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Message) Reset() {
@@ -129,6 +242,34 @@ func (x *Message) GetContent() []byte {
 	return nil
 }
 
+func (x *Message) GetServerMsgID() int64 {
+	if x != nil {
+		return x.ServerMsgID
+	}
+	return 0
+}
+
+func (x *Message) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *Message) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *Message) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
 type SendMessageRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Data          *Message               `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
@@ -178,6 +319,7 @@ type SendMessageResponse struct {
 	ServerMsgID   int64                  `protobuf:"varint,1,opt,name=serverMsgID,proto3" json:"serverMsgID,omitempty"`
 	ClientMsgID   string                 `protobuf:"bytes,2,opt,name=clientMsgID,proto3" json:"clientMsgID,omitempty"`
 	SendTime      int64                  `protobuf:"varint,3,opt,name=sendTime,proto3" json:"sendTime,omitempty"`
+	Seq           int64                  `protobuf:"varint,4,opt,name=seq,proto3" json:"seq,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -233,11 +375,22 @@ func (x *SendMessageResponse) GetSendTime() int64 {
 	return 0
 }
 
+func (x *SendMessageResponse) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+// SetMessageStatusRequest targets messages either by seqs or by serverMsgIDs.
 type SetMessageStatusRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationID string                 `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+	Seqs           []int64                `protobuf:"varint,2,rep,packed,name=seqs,proto3" json:"seqs,omitempty"`
+	ServerMsgIDs   []int64                `protobuf:"varint,3,rep,packed,name=serverMsgIDs,proto3" json:"serverMsgIDs,omitempty"`
+	Status         MessageStatus          `protobuf:"varint,4,opt,name=status,proto3,enum=message.v1.MessageStatus" json:"status,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *SetMessageStatusRequest) Reset() {
@@ -270,15 +423,37 @@ func (*SetMessageStatusRequest) Descriptor() ([]byte, []int) {
 	return file_idl_message_v1_message_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *SetMessageStatusRequest) GetStatus() int32 {
+func (x *SetMessageStatusRequest) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *SetMessageStatusRequest) GetSeqs() []int64 {
+	if x != nil {
+		return x.Seqs
+	}
+	return nil
+}
+
+func (x *SetMessageStatusRequest) GetServerMsgIDs() []int64 {
+	if x != nil {
+		return x.ServerMsgIDs
+	}
+	return nil
+}
+
+func (x *SetMessageStatusRequest) GetStatus() MessageStatus {
 	if x != nil {
 		return x.Status
 	}
-	return 0
+	return MessageStatus_MESSAGE_STATUS_UNSPECIFIED
 }
 
 type SetMessageStatusResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChangedSeqs   []int64                `protobuf:"varint,1,rep,packed,name=changed_seqs,json=changedSeqs,proto3" json:"changed_seqs,omitempty"` // Messages that were not in the status already
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -313,12 +488,819 @@ func (*SetMessageStatusResponse) Descriptor() ([]byte, []int) {
 	return file_idl_message_v1_message_proto_rawDescGZIP(), []int{4}
 }
 
+func (x *SetMessageStatusResponse) GetChangedSeqs() []int64 {
+	if x != nil {
+		return x.ChangedSeqs
+	}
+	return nil
+}
+
+type PullMessagesBySeqsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationID string                 `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+	Seqs           []int64                `protobuf:"varint,2,rep,packed,name=seqs,proto3" json:"seqs,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PullMessagesBySeqsRequest) Reset() {
+	*x = PullMessagesBySeqsRequest{}
+	mi := &file_idl_message_v1_message_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullMessagesBySeqsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullMessagesBySeqsRequest) ProtoMessage() {}
+
+func (x *PullMessagesBySeqsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_message_v1_message_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullMessagesBySeqsRequest.ProtoReflect.Descriptor instead.
+func (*PullMessagesBySeqsRequest) Descriptor() ([]byte, []int) {
+	return file_idl_message_v1_message_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PullMessagesBySeqsRequest) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *PullMessagesBySeqsRequest) GetSeqs() []int64 {
+	if x != nil {
+		return x.Seqs
+	}
+	return nil
+}
+
+type PullMessagesBySeqsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Msgs          []*Message             `protobuf:"bytes,1,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PullMessagesBySeqsResponse) Reset() {
+	*x = PullMessagesBySeqsResponse{}
+	mi := &file_idl_message_v1_message_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullMessagesBySeqsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullMessagesBySeqsResponse) ProtoMessage() {}
+
+func (x *PullMessagesBySeqsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_message_v1_message_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullMessagesBySeqsResponse.ProtoReflect.Descriptor instead.
+func (*PullMessagesBySeqsResponse) Descriptor() ([]byte, []int) {
+	return file_idl_message_v1_message_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PullMessagesBySeqsResponse) GetMsgs() []*Message {
+	if x != nil {
+		return x.Msgs
+	}
+	return nil
+}
+
+type PullMessagesBySeqRangeRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationID string                 `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+	Begin          int64                  `protobuf:"varint,2,opt,name=begin,proto3" json:"begin,omitempty"`
+	End            int64                  `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`
+	Limit          int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Order          PullOrder              `protobuf:"varint,5,opt,name=order,proto3,enum=message.v1.PullOrder" json:"order,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PullMessagesBySeqRangeRequest) Reset() {
+	*x = PullMessagesBySeqRangeRequest{}
+	mi := &file_idl_message_v1_message_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullMessagesBySeqRangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullMessagesBySeqRangeRequest) ProtoMessage() {}
+
+func (x *PullMessagesBySeqRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_message_v1_message_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullMessagesBySeqRangeRequest.ProtoReflect.Descriptor instead.
+func (*PullMessagesBySeqRangeRequest) Descriptor() ([]byte, []int) {
+	return file_idl_message_v1_message_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PullMessagesBySeqRangeRequest) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *PullMessagesBySeqRangeRequest) GetBegin() int64 {
+	if x != nil {
+		return x.Begin
+	}
+	return 0
+}
+
+func (x *PullMessagesBySeqRangeRequest) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *PullMessagesBySeqRangeRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *PullMessagesBySeqRangeRequest) GetOrder() PullOrder {
+	if x != nil {
+		return x.Order
+	}
+	return PullOrder_PULL_ORDER_ASC
+}
+
+type PullMessagesBySeqRangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Msgs          []*Message             `protobuf:"bytes,1,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	IsEnd         bool                   `protobuf:"varint,2,opt,name=is_end,json=isEnd,proto3" json:"is_end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PullMessagesBySeqRangeResponse) Reset() {
+	*x = PullMessagesBySeqRangeResponse{}
+	mi := &file_idl_message_v1_message_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullMessagesBySeqRangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullMessagesBySeqRangeResponse) ProtoMessage() {}
+
+func (x *PullMessagesBySeqRangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_message_v1_message_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullMessagesBySeqRangeResponse.ProtoReflect.Descriptor instead.
+func (*PullMessagesBySeqRangeResponse) Descriptor() ([]byte, []int) {
+	return file_idl_message_v1_message_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PullMessagesBySeqRangeResponse) GetMsgs() []*Message {
+	if x != nil {
+		return x.Msgs
+	}
+	return nil
+}
+
+func (x *PullMessagesBySeqRangeResponse) GetIsEnd() bool {
+	if x != nil {
+		return x.IsEnd
+	}
+	return false
+}
+
+type GetNewestSeqRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ConversationIDs []string               `protobuf:"bytes,1,rep,name=conversationIDs,proto3" json:"conversationIDs,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetNewestSeqRequest) Reset() {
+	*x = GetNewestSeqRequest{}
+	mi := &file_idl_message_v1_message_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNewestSeqRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNewestSeqRequest) ProtoMessage() {}
+
+func (x *GetNewestSeqRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_message_v1_message_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNewestSeqRequest.ProtoReflect.Descriptor instead.
+func (*GetNewestSeqRequest) Descriptor() ([]byte, []int) {
+	return file_idl_message_v1_message_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetNewestSeqRequest) GetConversationIDs() []string {
+	if x != nil {
+		return x.ConversationIDs
+	}
+	return nil
+}
+
+type GetNewestSeqResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaxSeqs       map[string]int64       `protobuf:"bytes,1,rep,name=max_seqs,json=maxSeqs,proto3" json:"max_seqs,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNewestSeqResponse) Reset() {
+	*x = GetNewestSeqResponse{}
+	mi := &file_idl_message_v1_message_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNewestSeqResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNewestSeqResponse) ProtoMessage() {}
+
+func (x *GetNewestSeqResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_message_v1_message_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNewestSeqResponse.ProtoReflect.Descriptor instead.
+func (*GetNewestSeqResponse) Descriptor() ([]byte, []int) {
+	return file_idl_message_v1_message_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetNewestSeqResponse) GetMaxSeqs() map[string]int64 {
+	if x != nil {
+		return x.MaxSeqs
+	}
+	return nil
+}
+
+type RevokeMessageRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationID string                 `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+	Seq            int64                  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RevokeMessageRequest) Reset() {
+	*x = RevokeMessageRequest{}
+	mi := &file_idl_message_v1_message_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeMessageRequest) ProtoMessage() {}
+
+func (x *RevokeMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_message_v1_message_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeMessageRequest.ProtoReflect.Descriptor instead.
+func (*RevokeMessageRequest) Descriptor() ([]byte, []int) {
+	return file_idl_message_v1_message_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RevokeMessageRequest) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *RevokeMessageRequest) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+type RevokeMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeMessageResponse) Reset() {
+	*x = RevokeMessageResponse{}
+	mi := &file_idl_message_v1_message_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeMessageResponse) ProtoMessage() {}
+
+func (x *RevokeMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_message_v1_message_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeMessageResponse.ProtoReflect.Descriptor instead.
+func (*RevokeMessageResponse) Descriptor() ([]byte, []int) {
+	return file_idl_message_v1_message_proto_rawDescGZIP(), []int{12}
+}
+
+type DeleteMessagesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationID string                 `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+	Seqs           []int64                `protobuf:"varint,2,rep,packed,name=seqs,proto3" json:"seqs,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeleteMessagesRequest) Reset() {
+	*x = DeleteMessagesRequest{}
+	mi := &file_idl_message_v1_message_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMessagesRequest) ProtoMessage() {}
+
+func (x *DeleteMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_message_v1_message_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMessagesRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_idl_message_v1_message_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteMessagesRequest) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *DeleteMessagesRequest) GetSeqs() []int64 {
+	if x != nil {
+		return x.Seqs
+	}
+	return nil
+}
+
+type DeleteMessagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMessagesResponse) Reset() {
+	*x = DeleteMessagesResponse{}
+	mi := &file_idl_message_v1_message_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMessagesResponse) ProtoMessage() {}
+
+func (x *DeleteMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_message_v1_message_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMessagesResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_idl_message_v1_message_proto_rawDescGZIP(), []int{14}
+}
+
+type ClearConversationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationID string                 `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+	UpToSeq        int64                  `protobuf:"varint,2,opt,name=up_to_seq,json=upToSeq,proto3" json:"up_to_seq,omitempty"` // 0 clears everything sent so far
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ClearConversationRequest) Reset() {
+	*x = ClearConversationRequest{}
+	mi := &file_idl_message_v1_message_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearConversationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearConversationRequest) ProtoMessage() {}
+
+func (x *ClearConversationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_message_v1_message_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearConversationRequest.ProtoReflect.Descriptor instead.
+func (*ClearConversationRequest) Descriptor() ([]byte, []int) {
+	return file_idl_message_v1_message_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ClearConversationRequest) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *ClearConversationRequest) GetUpToSeq() int64 {
+	if x != nil {
+		return x.UpToSeq
+	}
+	return 0
+}
+
+type ClearConversationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClearedSeq    int64                  `protobuf:"varint,1,opt,name=cleared_seq,json=clearedSeq,proto3" json:"cleared_seq,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearConversationResponse) Reset() {
+	*x = ClearConversationResponse{}
+	mi := &file_idl_message_v1_message_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearConversationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearConversationResponse) ProtoMessage() {}
+
+func (x *ClearConversationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_message_v1_message_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearConversationResponse.ProtoReflect.Descriptor instead.
+func (*ClearConversationResponse) Descriptor() ([]byte, []int) {
+	return file_idl_message_v1_message_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ClearConversationResponse) GetClearedSeq() int64 {
+	if x != nil {
+		return x.ClearedSeq
+	}
+	return 0
+}
+
+type MarkConversationAsReadRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationID string                 `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+	HasReadSeq     int64                  `protobuf:"varint,2,opt,name=has_read_seq,json=hasReadSeq,proto3" json:"has_read_seq,omitempty"` // 0 marks everything sent so far as read
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MarkConversationAsReadRequest) Reset() {
+	*x = MarkConversationAsReadRequest{}
+	mi := &file_idl_message_v1_message_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkConversationAsReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkConversationAsReadRequest) ProtoMessage() {}
+
+func (x *MarkConversationAsReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_message_v1_message_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkConversationAsReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkConversationAsReadRequest) Descriptor() ([]byte, []int) {
+	return file_idl_message_v1_message_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *MarkConversationAsReadRequest) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *MarkConversationAsReadRequest) GetHasReadSeq() int64 {
+	if x != nil {
+		return x.HasReadSeq
+	}
+	return 0
+}
+
+type MarkConversationAsReadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HasReadSeq    int64                  `protobuf:"varint,1,opt,name=has_read_seq,json=hasReadSeq,proto3" json:"has_read_seq,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkConversationAsReadResponse) Reset() {
+	*x = MarkConversationAsReadResponse{}
+	mi := &file_idl_message_v1_message_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkConversationAsReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkConversationAsReadResponse) ProtoMessage() {}
+
+func (x *MarkConversationAsReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_message_v1_message_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkConversationAsReadResponse.ProtoReflect.Descriptor instead.
+func (*MarkConversationAsReadResponse) Descriptor() ([]byte, []int) {
+	return file_idl_message_v1_message_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *MarkConversationAsReadResponse) GetHasReadSeq() int64 {
+	if x != nil {
+		return x.HasReadSeq
+	}
+	return 0
+}
+
+type GetMessageReadersRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationID string                 `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+	Seq            int64                  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	Page           int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Size           int32                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetMessageReadersRequest) Reset() {
+	*x = GetMessageReadersRequest{}
+	mi := &file_idl_message_v1_message_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMessageReadersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMessageReadersRequest) ProtoMessage() {}
+
+func (x *GetMessageReadersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_message_v1_message_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMessageReadersRequest.ProtoReflect.Descriptor instead.
+func (*GetMessageReadersRequest) Descriptor() ([]byte, []int) {
+	return file_idl_message_v1_message_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetMessageReadersRequest) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *GetMessageReadersRequest) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *GetMessageReadersRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetMessageReadersRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type GetMessageReadersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReaderIDs     []int64                `protobuf:"varint,1,rep,packed,name=readerIDs,proto3" json:"readerIDs,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMessageReadersResponse) Reset() {
+	*x = GetMessageReadersResponse{}
+	mi := &file_idl_message_v1_message_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMessageReadersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMessageReadersResponse) ProtoMessage() {}
+
+func (x *GetMessageReadersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_message_v1_message_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMessageReadersResponse.ProtoReflect.Descriptor instead.
+func (*GetMessageReadersResponse) Descriptor() ([]byte, []int) {
+	return file_idl_message_v1_message_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetMessageReadersResponse) GetReaderIDs() []int64 {
+	if x != nil {
+		return x.ReaderIDs
+	}
+	return nil
+}
+
+func (x *GetMessageReadersResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
 var File_idl_message_v1_message_proto protoreflect.FileDescriptor
 
 const file_idl_message_v1_message_proto_rawDesc = "" +
 	"\n" +
 	"\x1cidl/message/v1/message.proto\x12\n" +
-	"message.v1\"\x95\x02\n" +
+	"message.v1\"\x89\x03\n" +
 	"\aMessage\x12\x16\n" +
 	"\x06sendID\x18\x01 \x01(\x03R\x06sendID\x12\x16\n" +
 	"\x06recvID\x18\x02 \x01(\x03R\x06recvID\x12\x18\n" +
@@ -328,19 +1310,98 @@ const file_idl_message_v1_message_proto_rawDesc = "" +
 	"\fmessage_from\x18\x06 \x01(\x05R\vmessageFrom\x12 \n" +
 	"\vcontentType\x18\a \x01(\x05R\vcontentType\x12\x1b\n" +
 	"\tsend_time\x18\b \x01(\x03R\bsendTime\x12\x18\n" +
-	"\acontent\x18\t \x01(\fR\acontent\"=\n" +
+	"\acontent\x18\t \x01(\fR\acontent\x12 \n" +
+	"\vserverMsgID\x18\n" +
+	" \x01(\x03R\vserverMsgID\x12\x10\n" +
+	"\x03seq\x18\v \x01(\x03R\x03seq\x12&\n" +
+	"\x0econversationID\x18\f \x01(\tR\x0econversationID\x12\x16\n" +
+	"\x06status\x18\r \x01(\x05R\x06status\"=\n" +
 	"\x12SendMessageRequest\x12'\n" +
-	"\x04data\x18\x01 \x01(\v2\x13.message.v1.MessageR\x04data\"u\n" +
+	"\x04data\x18\x01 \x01(\v2\x13.message.v1.MessageR\x04data\"\x87\x01\n" +
 	"\x13SendMessageResponse\x12 \n" +
 	"\vserverMsgID\x18\x01 \x01(\x03R\vserverMsgID\x12 \n" +
 	"\vclientMsgID\x18\x02 \x01(\tR\vclientMsgID\x12\x1a\n" +
-	"\bsendTime\x18\x03 \x01(\x03R\bsendTime\"1\n" +
-	"\x17SetMessageStatusRequest\x12\x16\n" +
-	"\x06status\x18\x01 \x01(\x05R\x06status\"\x1a\n" +
-	"\x18SetMessageStatusResponse2\xbf\x01\n" +
+	"\bsendTime\x18\x03 \x01(\x03R\bsendTime\x12\x10\n" +
+	"\x03seq\x18\x04 \x01(\x03R\x03seq\"\xac\x01\n" +
+	"\x17SetMessageStatusRequest\x12&\n" +
+	"\x0econversationID\x18\x01 \x01(\tR\x0econversationID\x12\x12\n" +
+	"\x04seqs\x18\x02 \x03(\x03R\x04seqs\x12\"\n" +
+	"\fserverMsgIDs\x18\x03 \x03(\x03R\fserverMsgIDs\x121\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x19.message.v1.MessageStatusR\x06status\"=\n" +
+	"\x18SetMessageStatusResponse\x12!\n" +
+	"\fchanged_seqs\x18\x01 \x03(\x03R\vchangedSeqs\"W\n" +
+	"\x19PullMessagesBySeqsRequest\x12&\n" +
+	"\x0econversationID\x18\x01 \x01(\tR\x0econversationID\x12\x12\n" +
+	"\x04seqs\x18\x02 \x03(\x03R\x04seqs\"E\n" +
+	"\x1aPullMessagesBySeqsResponse\x12'\n" +
+	"\x04msgs\x18\x01 \x03(\v2\x13.message.v1.MessageR\x04msgs\"\xb2\x01\n" +
+	"\x1dPullMessagesBySeqRangeRequest\x12&\n" +
+	"\x0econversationID\x18\x01 \x01(\tR\x0econversationID\x12\x14\n" +
+	"\x05begin\x18\x02 \x01(\x03R\x05begin\x12\x10\n" +
+	"\x03end\x18\x03 \x01(\x03R\x03end\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12+\n" +
+	"\x05order\x18\x05 \x01(\x0e2\x15.message.v1.PullOrderR\x05order\"`\n" +
+	"\x1ePullMessagesBySeqRangeResponse\x12'\n" +
+	"\x04msgs\x18\x01 \x03(\v2\x13.message.v1.MessageR\x04msgs\x12\x15\n" +
+	"\x06is_end\x18\x02 \x01(\bR\x05isEnd\"?\n" +
+	"\x13GetNewestSeqRequest\x12(\n" +
+	"\x0fconversationIDs\x18\x01 \x03(\tR\x0fconversationIDs\"\x9c\x01\n" +
+	"\x14GetNewestSeqResponse\x12H\n" +
+	"\bmax_seqs\x18\x01 \x03(\v2-.message.v1.GetNewestSeqResponse.MaxSeqsEntryR\amaxSeqs\x1a:\n" +
+	"\fMaxSeqsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"P\n" +
+	"\x14RevokeMessageRequest\x12&\n" +
+	"\x0econversationID\x18\x01 \x01(\tR\x0econversationID\x12\x10\n" +
+	"\x03seq\x18\x02 \x01(\x03R\x03seq\"\x17\n" +
+	"\x15RevokeMessageResponse\"S\n" +
+	"\x15DeleteMessagesRequest\x12&\n" +
+	"\x0econversationID\x18\x01 \x01(\tR\x0econversationID\x12\x12\n" +
+	"\x04seqs\x18\x02 \x03(\x03R\x04seqs\"\x18\n" +
+	"\x16DeleteMessagesResponse\"^\n" +
+	"\x18ClearConversationRequest\x12&\n" +
+	"\x0econversationID\x18\x01 \x01(\tR\x0econversationID\x12\x1a\n" +
+	"\tup_to_seq\x18\x02 \x01(\x03R\aupToSeq\"<\n" +
+	"\x19ClearConversationResponse\x12\x1f\n" +
+	"\vcleared_seq\x18\x01 \x01(\x03R\n" +
+	"clearedSeq\"i\n" +
+	"\x1dMarkConversationAsReadRequest\x12&\n" +
+	"\x0econversationID\x18\x01 \x01(\tR\x0econversationID\x12 \n" +
+	"\fhas_read_seq\x18\x02 \x01(\x03R\n" +
+	"hasReadSeq\"B\n" +
+	"\x1eMarkConversationAsReadResponse\x12 \n" +
+	"\fhas_read_seq\x18\x01 \x01(\x03R\n" +
+	"hasReadSeq\"|\n" +
+	"\x18GetMessageReadersRequest\x12&\n" +
+	"\x0econversationID\x18\x01 \x01(\tR\x0econversationID\x12\x10\n" +
+	"\x03seq\x18\x02 \x01(\x03R\x03seq\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x05R\x04size\"O\n" +
+	"\x19GetMessageReadersResponse\x12\x1c\n" +
+	"\treaderIDs\x18\x01 \x03(\x03R\treaderIDs\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total*\xd5\x01\n" +
+	"\rMessageStatus\x12\x1e\n" +
+	"\x1aMESSAGE_STATUS_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13MESSAGE_STATUS_SENT\x10\x01\x12\x1a\n" +
+	"\x16MESSAGE_STATUS_REVOKED\x10\x02\x12\x1a\n" +
+	"\x16MESSAGE_STATUS_SENDING\x10\x03\x12\x1c\n" +
+	"\x18MESSAGE_STATUS_DELIVERED\x10\x04\x12\x19\n" +
+	"\x15MESSAGE_STATUS_FAILED\x10\x05\x12\x1a\n" +
+	"\x16MESSAGE_STATUS_DELETED\x10\x06*4\n" +
+	"\tPullOrder\x12\x12\n" +
+	"\x0ePULL_ORDER_ASC\x10\x00\x12\x13\n" +
+	"\x0fPULL_ORDER_DESC\x10\x012\xcc\a\n" +
 	"\x0eMessageService\x12N\n" +
 	"\vSendMessage\x12\x1e.message.v1.SendMessageRequest\x1a\x1f.message.v1.SendMessageResponse\x12]\n" +
-	"\x10SetMessageStatus\x12#.message.v1.SetMessageStatusRequest\x1a$.message.v1.SetMessageStatusResponseB<Z:github.com/crazyfrankie/goim/protocol/message/v1;messagev1b\x06proto3"
+	"\x10SetMessageStatus\x12#.message.v1.SetMessageStatusRequest\x1a$.message.v1.SetMessageStatusResponse\x12c\n" +
+	"\x12PullMessagesBySeqs\x12%.message.v1.PullMessagesBySeqsRequest\x1a&.message.v1.PullMessagesBySeqsResponse\x12o\n" +
+	"\x16PullMessagesBySeqRange\x12).message.v1.PullMessagesBySeqRangeRequest\x1a*.message.v1.PullMessagesBySeqRangeResponse\x12Q\n" +
+	"\fGetNewestSeq\x12\x1f.message.v1.GetNewestSeqRequest\x1a .message.v1.GetNewestSeqResponse\x12T\n" +
+	"\rRevokeMessage\x12 .message.v1.RevokeMessageRequest\x1a!.message.v1.RevokeMessageResponse\x12W\n" +
+	"\x0eDeleteMessages\x12!.message.v1.DeleteMessagesRequest\x1a\".message.v1.DeleteMessagesResponse\x12`\n" +
+	"\x11ClearConversation\x12$.message.v1.ClearConversationRequest\x1a%.message.v1.ClearConversationResponse\x12o\n" +
+	"\x16MarkConversationAsRead\x12).message.v1.MarkConversationAsReadRequest\x1a*.message.v1.MarkConversationAsReadResponse\x12`\n" +
+	"\x11GetMessageReaders\x12$.message.v1.GetMessageReadersRequest\x1a%.message.v1.GetMessageReadersResponseB<Z:github.com/crazyfrankie/goim/protocol/message/v1;messagev1b\x06proto3"
 
 var (
 	file_idl_message_v1_message_proto_rawDescOnce sync.Once
@@ -354,25 +1415,66 @@ func file_idl_message_v1_message_proto_rawDescGZIP() []byte {
 	return file_idl_message_v1_message_proto_rawDescData
 }
 
-var file_idl_message_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_idl_message_v1_message_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_idl_message_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_idl_message_v1_message_proto_goTypes = []any{
-	(*Message)(nil),                  // 0: message.v1.Message
-	(*SendMessageRequest)(nil),       // 1: message.v1.SendMessageRequest
-	(*SendMessageResponse)(nil),      // 2: message.v1.SendMessageResponse
-	(*SetMessageStatusRequest)(nil),  // 3: message.v1.SetMessageStatusRequest
-	(*SetMessageStatusResponse)(nil), // 4: message.v1.SetMessageStatusResponse
+	(MessageStatus)(0),                     // 0: message.v1.MessageStatus
+	(PullOrder)(0),                         // 1: message.v1.PullOrder
+	(*Message)(nil),                        // 2: message.v1.Message
+	(*SendMessageRequest)(nil),             // 3: message.v1.SendMessageRequest
+	(*SendMessageResponse)(nil),            // 4: message.v1.SendMessageResponse
+	(*SetMessageStatusRequest)(nil),        // 5: message.v1.SetMessageStatusRequest
+	(*SetMessageStatusResponse)(nil),       // 6: message.v1.SetMessageStatusResponse
+	(*PullMessagesBySeqsRequest)(nil),      // 7: message.v1.PullMessagesBySeqsRequest
+	(*PullMessagesBySeqsResponse)(nil),     // 8: message.v1.PullMessagesBySeqsResponse
+	(*PullMessagesBySeqRangeRequest)(nil),  // 9: message.v1.PullMessagesBySeqRangeRequest
+	(*PullMessagesBySeqRangeResponse)(nil), // 10: message.v1.PullMessagesBySeqRangeResponse
+	(*GetNewestSeqRequest)(nil),            // 11: message.v1.GetNewestSeqRequest
+	(*GetNewestSeqResponse)(nil),           // 12: message.v1.GetNewestSeqResponse
+	(*RevokeMessageRequest)(nil),           // 13: message.v1.RevokeMessageRequest
+	(*RevokeMessageResponse)(nil),          // 14: message.v1.RevokeMessageResponse
+	(*DeleteMessagesRequest)(nil),          // 15: message.v1.DeleteMessagesRequest
+	(*DeleteMessagesResponse)(nil),         // 16: message.v1.DeleteMessagesResponse
+	(*ClearConversationRequest)(nil),       // 17: message.v1.ClearConversationRequest
+	(*ClearConversationResponse)(nil),      // 18: message.v1.ClearConversationResponse
+	(*MarkConversationAsReadRequest)(nil),  // 19: message.v1.MarkConversationAsReadRequest
+	(*MarkConversationAsReadResponse)(nil), // 20: message.v1.MarkConversationAsReadResponse
+	(*GetMessageReadersRequest)(nil),       // 21: message.v1.GetMessageReadersRequest
+	(*GetMessageReadersResponse)(nil),      // 22: message.v1.GetMessageReadersResponse
+	nil,                                    // 23: message.v1.GetNewestSeqResponse.MaxSeqsEntry
 }
 var file_idl_message_v1_message_proto_depIdxs = []int32{
-	0, // 0: message.v1.SendMessageRequest.data:type_name -> message.v1.Message
-	1, // 1: message.v1.MessageService.SendMessage:input_type -> message.v1.SendMessageRequest
-	3, // 2: message.v1.MessageService.SetMessageStatus:input_type -> message.v1.SetMessageStatusRequest
-	2, // 3: message.v1.MessageService.SendMessage:output_type -> message.v1.SendMessageResponse
-	4, // 4: message.v1.MessageService.SetMessageStatus:output_type -> message.v1.SetMessageStatusResponse
-	3, // [3:5] is the sub-list for method output_type
-	1, // [1:3] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2,  // 0: message.v1.SendMessageRequest.data:type_name -> message.v1.Message
+	0,  // 1: message.v1.SetMessageStatusRequest.status:type_name -> message.v1.MessageStatus
+	2,  // 2: message.v1.PullMessagesBySeqsResponse.msgs:type_name -> message.v1.Message
+	1,  // 3: message.v1.PullMessagesBySeqRangeRequest.order:type_name -> message.v1.PullOrder
+	2,  // 4: message.v1.PullMessagesBySeqRangeResponse.msgs:type_name -> message.v1.Message
+	23, // 5: message.v1.GetNewestSeqResponse.max_seqs:type_name -> message.v1.GetNewestSeqResponse.MaxSeqsEntry
+	3,  // 6: message.v1.MessageService.SendMessage:input_type -> message.v1.SendMessageRequest
+	5,  // 7: message.v1.MessageService.SetMessageStatus:input_type -> message.v1.SetMessageStatusRequest
+	7,  // 8: message.v1.MessageService.PullMessagesBySeqs:input_type -> message.v1.PullMessagesBySeqsRequest
+	9,  // 9: message.v1.MessageService.PullMessagesBySeqRange:input_type -> message.v1.PullMessagesBySeqRangeRequest
+	11, // 10: message.v1.MessageService.GetNewestSeq:input_type -> message.v1.GetNewestSeqRequest
+	13, // 11: message.v1.MessageService.RevokeMessage:input_type -> message.v1.RevokeMessageRequest
+	15, // 12: message.v1.MessageService.DeleteMessages:input_type -> message.v1.DeleteMessagesRequest
+	17, // 13: message.v1.MessageService.ClearConversation:input_type -> message.v1.ClearConversationRequest
+	19, // 14: message.v1.MessageService.MarkConversationAsRead:input_type -> message.v1.MarkConversationAsReadRequest
+	21, // 15: message.v1.MessageService.GetMessageReaders:input_type -> message.v1.GetMessageReadersRequest
+	4,  // 16: message.v1.MessageService.SendMessage:output_type -> message.v1.SendMessageResponse
+	6,  // 17: message.v1.MessageService.SetMessageStatus:output_type -> message.v1.SetMessageStatusResponse
+	8,  // 18: message.v1.MessageService.PullMessagesBySeqs:output_type -> message.v1.PullMessagesBySeqsResponse
+	10, // 19: message.v1.MessageService.PullMessagesBySeqRange:output_type -> message.v1.PullMessagesBySeqRangeResponse
+	12, // 20: message.v1.MessageService.GetNewestSeq:output_type -> message.v1.GetNewestSeqResponse
+	14, // 21: message.v1.MessageService.RevokeMessage:output_type -> message.v1.RevokeMessageResponse
+	16, // 22: message.v1.MessageService.DeleteMessages:output_type -> message.v1.DeleteMessagesResponse
+	18, // 23: message.v1.MessageService.ClearConversation:output_type -> message.v1.ClearConversationResponse
+	20, // 24: message.v1.MessageService.MarkConversationAsRead:output_type -> message.v1.MarkConversationAsReadResponse
+	22, // 25: message.v1.MessageService.GetMessageReaders:output_type -> message.v1.GetMessageReadersResponse
+	16, // [16:26] is the sub-list for method output_type
+	6,  // [6:16] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_idl_message_v1_message_proto_init() }
@@ -385,13 +1487,14 @@ func file_idl_message_v1_message_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_idl_message_v1_message_proto_rawDesc), len(file_idl_message_v1_message_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   5,
+			NumEnums:      2,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_idl_message_v1_message_proto_goTypes,
 		DependencyIndexes: file_idl_message_v1_message_proto_depIdxs,
+		EnumInfos:         file_idl_message_v1_message_proto_enumTypes,
 		MessageInfos:      file_idl_message_v1_message_proto_msgTypes,
 	}.Build()
 	File_idl_message_v1_message_proto = out.File
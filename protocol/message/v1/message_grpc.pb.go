@@ -19,8 +19,16 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MessageService_SendMessage_FullMethodName      = "/message.v1.MessageService/SendMessage"
-	MessageService_SetMessageStatus_FullMethodName = "/message.v1.MessageService/SetMessageStatus"
+	MessageService_SendMessage_FullMethodName            = "/message.v1.MessageService/SendMessage"
+	MessageService_SetMessageStatus_FullMethodName       = "/message.v1.MessageService/SetMessageStatus"
+	MessageService_PullMessagesBySeqs_FullMethodName     = "/message.v1.MessageService/PullMessagesBySeqs"
+	MessageService_PullMessagesBySeqRange_FullMethodName = "/message.v1.MessageService/PullMessagesBySeqRange"
+	MessageService_GetNewestSeq_FullMethodName           = "/message.v1.MessageService/GetNewestSeq"
+	MessageService_RevokeMessage_FullMethodName          = "/message.v1.MessageService/RevokeMessage"
+	MessageService_DeleteMessages_FullMethodName         = "/message.v1.MessageService/DeleteMessages"
+	MessageService_ClearConversation_FullMethodName      = "/message.v1.MessageService/ClearConversation"
+	MessageService_MarkConversationAsRead_FullMethodName = "/message.v1.MessageService/MarkConversationAsRead"
+	MessageService_GetMessageReaders_FullMethodName      = "/message.v1.MessageService/GetMessageReaders"
 )
 
 // MessageServiceClient is the client API for MessageService service.
@@ -28,7 +36,19 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type MessageServiceClient interface {
 	SendMessage(ctx context.Context, in *SendMessageRequest, opts ...grpc.CallOption) (*SendMessageResponse, error)
+	// SetMessageStatus moves messages to a new status, illegal transitions are rejected.
 	SetMessageStatus(ctx context.Context, in *SetMessageStatusRequest, opts ...grpc.CallOption) (*SetMessageStatusResponse, error)
+	PullMessagesBySeqs(ctx context.Context, in *PullMessagesBySeqsRequest, opts ...grpc.CallOption) (*PullMessagesBySeqsResponse, error)
+	PullMessagesBySeqRange(ctx context.Context, in *PullMessagesBySeqRangeRequest, opts ...grpc.CallOption) (*PullMessagesBySeqRangeResponse, error)
+	GetNewestSeq(ctx context.Context, in *GetNewestSeqRequest, opts ...grpc.CallOption) (*GetNewestSeqResponse, error)
+	RevokeMessage(ctx context.Context, in *RevokeMessageRequest, opts ...grpc.CallOption) (*RevokeMessageResponse, error)
+	// DeleteMessages and ClearConversation only hide messages from the caller, the other participants still see them.
+	DeleteMessages(ctx context.Context, in *DeleteMessagesRequest, opts ...grpc.CallOption) (*DeleteMessagesResponse, error)
+	ClearConversation(ctx context.Context, in *ClearConversationRequest, opts ...grpc.CallOption) (*ClearConversationResponse, error)
+	// MarkConversationAsRead advances the read cursor of the caller and tells the senders of the newly read messages.
+	MarkConversationAsRead(ctx context.Context, in *MarkConversationAsReadRequest, opts ...grpc.CallOption) (*MarkConversationAsReadResponse, error)
+	// GetMessageReaders lists who has read a message, only its sender may ask.
+	GetMessageReaders(ctx context.Context, in *GetMessageReadersRequest, opts ...grpc.CallOption) (*GetMessageReadersResponse, error)
 }
 
 type messageServiceClient struct {
@@ -59,12 +79,104 @@ func (c *messageServiceClient) SetMessageStatus(ctx context.Context, in *SetMess
 	return out, nil
 }
 
+func (c *messageServiceClient) PullMessagesBySeqs(ctx context.Context, in *PullMessagesBySeqsRequest, opts ...grpc.CallOption) (*PullMessagesBySeqsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PullMessagesBySeqsResponse)
+	err := c.cc.Invoke(ctx, MessageService_PullMessagesBySeqs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) PullMessagesBySeqRange(ctx context.Context, in *PullMessagesBySeqRangeRequest, opts ...grpc.CallOption) (*PullMessagesBySeqRangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PullMessagesBySeqRangeResponse)
+	err := c.cc.Invoke(ctx, MessageService_PullMessagesBySeqRange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) GetNewestSeq(ctx context.Context, in *GetNewestSeqRequest, opts ...grpc.CallOption) (*GetNewestSeqResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNewestSeqResponse)
+	err := c.cc.Invoke(ctx, MessageService_GetNewestSeq_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) RevokeMessage(ctx context.Context, in *RevokeMessageRequest, opts ...grpc.CallOption) (*RevokeMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeMessageResponse)
+	err := c.cc.Invoke(ctx, MessageService_RevokeMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) DeleteMessages(ctx context.Context, in *DeleteMessagesRequest, opts ...grpc.CallOption) (*DeleteMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteMessagesResponse)
+	err := c.cc.Invoke(ctx, MessageService_DeleteMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) ClearConversation(ctx context.Context, in *ClearConversationRequest, opts ...grpc.CallOption) (*ClearConversationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClearConversationResponse)
+	err := c.cc.Invoke(ctx, MessageService_ClearConversation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) MarkConversationAsRead(ctx context.Context, in *MarkConversationAsReadRequest, opts ...grpc.CallOption) (*MarkConversationAsReadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkConversationAsReadResponse)
+	err := c.cc.Invoke(ctx, MessageService_MarkConversationAsRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) GetMessageReaders(ctx context.Context, in *GetMessageReadersRequest, opts ...grpc.CallOption) (*GetMessageReadersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMessageReadersResponse)
+	err := c.cc.Invoke(ctx, MessageService_GetMessageReaders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MessageServiceServer is the server API for MessageService service.
 // All implementations must embed UnimplementedMessageServiceServer
 // for forward compatibility.
 type MessageServiceServer interface {
 	SendMessage(context.Context, *SendMessageRequest) (*SendMessageResponse, error)
+	// SetMessageStatus moves messages to a new status, illegal transitions are rejected.
 	SetMessageStatus(context.Context, *SetMessageStatusRequest) (*SetMessageStatusResponse, error)
+	PullMessagesBySeqs(context.Context, *PullMessagesBySeqsRequest) (*PullMessagesBySeqsResponse, error)
+	PullMessagesBySeqRange(context.Context, *PullMessagesBySeqRangeRequest) (*PullMessagesBySeqRangeResponse, error)
+	GetNewestSeq(context.Context, *GetNewestSeqRequest) (*GetNewestSeqResponse, error)
+	RevokeMessage(context.Context, *RevokeMessageRequest) (*RevokeMessageResponse, error)
+	// DeleteMessages and ClearConversation only hide messages from the caller, the other participants still see them.
+	DeleteMessages(context.Context, *DeleteMessagesRequest) (*DeleteMessagesResponse, error)
+	ClearConversation(context.Context, *ClearConversationRequest) (*ClearConversationResponse, error)
+	// MarkConversationAsRead advances the read cursor of the caller and tells the senders of the newly read messages.
+	MarkConversationAsRead(context.Context, *MarkConversationAsReadRequest) (*MarkConversationAsReadResponse, error)
+	// GetMessageReaders lists who has read a message, only its sender may ask.
+	GetMessageReaders(context.Context, *GetMessageReadersRequest) (*GetMessageReadersResponse, error)
 	mustEmbedUnimplementedMessageServiceServer()
 }
 
@@ -81,6 +193,30 @@ func (UnimplementedMessageServiceServer) SendMessage(context.Context, *SendMessa
 func (UnimplementedMessageServiceServer) SetMessageStatus(context.Context, *SetMessageStatusRequest) (*SetMessageStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetMessageStatus not implemented")
 }
+func (UnimplementedMessageServiceServer) PullMessagesBySeqs(context.Context, *PullMessagesBySeqsRequest) (*PullMessagesBySeqsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PullMessagesBySeqs not implemented")
+}
+func (UnimplementedMessageServiceServer) PullMessagesBySeqRange(context.Context, *PullMessagesBySeqRangeRequest) (*PullMessagesBySeqRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PullMessagesBySeqRange not implemented")
+}
+func (UnimplementedMessageServiceServer) GetNewestSeq(context.Context, *GetNewestSeqRequest) (*GetNewestSeqResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNewestSeq not implemented")
+}
+func (UnimplementedMessageServiceServer) RevokeMessage(context.Context, *RevokeMessageRequest) (*RevokeMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeMessage not implemented")
+}
+func (UnimplementedMessageServiceServer) DeleteMessages(context.Context, *DeleteMessagesRequest) (*DeleteMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMessages not implemented")
+}
+func (UnimplementedMessageServiceServer) ClearConversation(context.Context, *ClearConversationRequest) (*ClearConversationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearConversation not implemented")
+}
+func (UnimplementedMessageServiceServer) MarkConversationAsRead(context.Context, *MarkConversationAsReadRequest) (*MarkConversationAsReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkConversationAsRead not implemented")
+}
+func (UnimplementedMessageServiceServer) GetMessageReaders(context.Context, *GetMessageReadersRequest) (*GetMessageReadersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMessageReaders not implemented")
+}
 func (UnimplementedMessageServiceServer) mustEmbedUnimplementedMessageServiceServer() {}
 func (UnimplementedMessageServiceServer) testEmbeddedByValue()                        {}
 
@@ -138,6 +274,150 @@ func _MessageService_SetMessageStatus_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MessageService_PullMessagesBySeqs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PullMessagesBySeqsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).PullMessagesBySeqs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_PullMessagesBySeqs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).PullMessagesBySeqs(ctx, req.(*PullMessagesBySeqsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_PullMessagesBySeqRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PullMessagesBySeqRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).PullMessagesBySeqRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_PullMessagesBySeqRange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).PullMessagesBySeqRange(ctx, req.(*PullMessagesBySeqRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_GetNewestSeq_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNewestSeqRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).GetNewestSeq(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_GetNewestSeq_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).GetNewestSeq(ctx, req.(*GetNewestSeqRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_RevokeMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).RevokeMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_RevokeMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).RevokeMessage(ctx, req.(*RevokeMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_DeleteMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).DeleteMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_DeleteMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).DeleteMessages(ctx, req.(*DeleteMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_ClearConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearConversationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).ClearConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_ClearConversation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).ClearConversation(ctx, req.(*ClearConversationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_MarkConversationAsRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkConversationAsReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).MarkConversationAsRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_MarkConversationAsRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).MarkConversationAsRead(ctx, req.(*MarkConversationAsReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_GetMessageReaders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMessageReadersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).GetMessageReaders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_GetMessageReaders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).GetMessageReaders(ctx, req.(*GetMessageReadersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MessageService_ServiceDesc is the grpc.ServiceDesc for MessageService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -153,6 +433,38 @@ var MessageService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetMessageStatus",
 			Handler:    _MessageService_SetMessageStatus_Handler,
 		},
+		{
+			MethodName: "PullMessagesBySeqs",
+			Handler:    _MessageService_PullMessagesBySeqs_Handler,
+		},
+		{
+			MethodName: "PullMessagesBySeqRange",
+			Handler:    _MessageService_PullMessagesBySeqRange_Handler,
+		},
+		{
+			MethodName: "GetNewestSeq",
+			Handler:    _MessageService_GetNewestSeq_Handler,
+		},
+		{
+			MethodName: "RevokeMessage",
+			Handler:    _MessageService_RevokeMessage_Handler,
+		},
+		{
+			MethodName: "DeleteMessages",
+			Handler:    _MessageService_DeleteMessages_Handler,
+		},
+		{
+			MethodName: "ClearConversation",
+			Handler:    _MessageService_ClearConversation_Handler,
+		},
+		{
+			MethodName: "MarkConversationAsRead",
+			Handler:    _MessageService_MarkConversationAsRead_Handler,
+		},
+		{
+			MethodName: "GetMessageReaders",
+			Handler:    _MessageService_GetMessageReaders_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "idl/message/v1/message.proto",
@@ -0,0 +1,1364 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: idl/relation/v1/relation.proto
+
+package relationv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type FriendRequestStatus int32
+
+const (
+	FriendRequestStatus_FRIEND_REQUEST_STATUS_PENDING  FriendRequestStatus = 0
+	FriendRequestStatus_FRIEND_REQUEST_STATUS_ACCEPTED FriendRequestStatus = 1
+	FriendRequestStatus_FRIEND_REQUEST_STATUS_REJECTED FriendRequestStatus = 2
+)
+
+// Enum value maps for FriendRequestStatus.
+var (
+	FriendRequestStatus_name = map[int32]string{
+		0: "FRIEND_REQUEST_STATUS_PENDING",
+		1: "FRIEND_REQUEST_STATUS_ACCEPTED",
+		2: "FRIEND_REQUEST_STATUS_REJECTED",
+	}
+	FriendRequestStatus_value = map[string]int32{
+		"FRIEND_REQUEST_STATUS_PENDING":  0,
+		"FRIEND_REQUEST_STATUS_ACCEPTED": 1,
+		"FRIEND_REQUEST_STATUS_REJECTED": 2,
+	}
+)
+
+func (x FriendRequestStatus) Enum() *FriendRequestStatus {
+	p := new(FriendRequestStatus)
+	*p = x
+	return p
+}
+
+func (x FriendRequestStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FriendRequestStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_idl_relation_v1_relation_proto_enumTypes[0].Descriptor()
+}
+
+func (FriendRequestStatus) Type() protoreflect.EnumType {
+	return &file_idl_relation_v1_relation_proto_enumTypes[0]
+}
+
+func (x FriendRequestStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FriendRequestStatus.Descriptor instead.
+func (FriendRequestStatus) EnumDescriptor() ([]byte, []int) {
+	return file_idl_relation_v1_relation_proto_rawDescGZIP(), []int{0}
+}
+
+type FriendRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromUserID    int64                  `protobuf:"varint,1,opt,name=fromUserID,proto3" json:"fromUserID,omitempty"`
+	ToUserID      int64                  `protobuf:"varint,2,opt,name=toUserID,proto3" json:"toUserID,omitempty"`
+	Greeting      string                 `protobuf:"bytes,3,opt,name=greeting,proto3" json:"greeting,omitempty"`
+	Status        FriendRequestStatus    `protobuf:"varint,4,opt,name=status,proto3,enum=relation.v1.FriendRequestStatus" json:"status,omitempty"`
+	HandleTime    int64                  `protobuf:"varint,5,opt,name=handle_time,json=handleTime,proto3" json:"handle_time,omitempty"`
+	CreateTime    int64                  `protobuf:"varint,6,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FriendRequest) Reset() {
+	*x = FriendRequest{}
+	mi := &file_idl_relation_v1_relation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FriendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendRequest) ProtoMessage() {}
+
+func (x *FriendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_relation_v1_relation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FriendRequest.ProtoReflect.Descriptor instead.
+func (*FriendRequest) Descriptor() ([]byte, []int) {
+	return file_idl_relation_v1_relation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FriendRequest) GetFromUserID() int64 {
+	if x != nil {
+		return x.FromUserID
+	}
+	return 0
+}
+
+func (x *FriendRequest) GetToUserID() int64 {
+	if x != nil {
+		return x.ToUserID
+	}
+	return 0
+}
+
+func (x *FriendRequest) GetGreeting() string {
+	if x != nil {
+		return x.Greeting
+	}
+	return ""
+}
+
+func (x *FriendRequest) GetStatus() FriendRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return FriendRequestStatus_FRIEND_REQUEST_STATUS_PENDING
+}
+
+func (x *FriendRequest) GetHandleTime() int64 {
+	if x != nil {
+		return x.HandleTime
+	}
+	return 0
+}
+
+func (x *FriendRequest) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+type Friend struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FriendID      int64                  `protobuf:"varint,1,opt,name=friendID,proto3" json:"friendID,omitempty"`
+	Remark        string                 `protobuf:"bytes,2,opt,name=remark,proto3" json:"remark,omitempty"`
+	CreateTime    int64                  `protobuf:"varint,3,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Friend) Reset() {
+	*x = Friend{}
+	mi := &file_idl_relation_v1_relation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Friend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Friend) ProtoMessage() {}
+
+func (x *Friend) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_relation_v1_relation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Friend.ProtoReflect.Descriptor instead.
+func (*Friend) Descriptor() ([]byte, []int) {
+	return file_idl_relation_v1_relation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Friend) GetFriendID() int64 {
+	if x != nil {
+		return x.FriendID
+	}
+	return 0
+}
+
+func (x *Friend) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+func (x *Friend) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+type BlockedUser struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        int64                  `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	CreateTime    int64                  `protobuf:"varint,2,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockedUser) Reset() {
+	*x = BlockedUser{}
+	mi := &file_idl_relation_v1_relation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockedUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockedUser) ProtoMessage() {}
+
+func (x *BlockedUser) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_relation_v1_relation_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockedUser.ProtoReflect.Descriptor instead.
+func (*BlockedUser) Descriptor() ([]byte, []int) {
+	return file_idl_relation_v1_relation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BlockedUser) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *BlockedUser) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+type ApplyToAddFriendRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ToUserID      int64                  `protobuf:"varint,1,opt,name=toUserID,proto3" json:"toUserID,omitempty"`
+	Greeting      string                 `protobuf:"bytes,2,opt,name=greeting,proto3" json:"greeting,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyToAddFriendRequest) Reset() {
+	*x = ApplyToAddFriendRequest{}
+	mi := &file_idl_relation_v1_relation_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyToAddFriendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyToAddFriendRequest) ProtoMessage() {}
+
+func (x *ApplyToAddFriendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_relation_v1_relation_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyToAddFriendRequest.ProtoReflect.Descriptor instead.
+func (*ApplyToAddFriendRequest) Descriptor() ([]byte, []int) {
+	return file_idl_relation_v1_relation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ApplyToAddFriendRequest) GetToUserID() int64 {
+	if x != nil {
+		return x.ToUserID
+	}
+	return 0
+}
+
+func (x *ApplyToAddFriendRequest) GetGreeting() string {
+	if x != nil {
+		return x.Greeting
+	}
+	return ""
+}
+
+type ApplyToAddFriendResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyToAddFriendResponse) Reset() {
+	*x = ApplyToAddFriendResponse{}
+	mi := &file_idl_relation_v1_relation_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyToAddFriendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyToAddFriendResponse) ProtoMessage() {}
+
+func (x *ApplyToAddFriendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_relation_v1_relation_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyToAddFriendResponse.ProtoReflect.Descriptor instead.
+func (*ApplyToAddFriendResponse) Descriptor() ([]byte, []int) {
+	return file_idl_relation_v1_relation_proto_rawDescGZIP(), []int{4}
+}
+
+type ListFriendRequestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sent          bool                   `protobuf:"varint,1,opt,name=sent,proto3" json:"sent,omitempty"` // false lists the requests received by the caller
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Size          int32                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFriendRequestsRequest) Reset() {
+	*x = ListFriendRequestsRequest{}
+	mi := &file_idl_relation_v1_relation_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFriendRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFriendRequestsRequest) ProtoMessage() {}
+
+func (x *ListFriendRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_relation_v1_relation_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFriendRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListFriendRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_idl_relation_v1_relation_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListFriendRequestsRequest) GetSent() bool {
+	if x != nil {
+		return x.Sent
+	}
+	return false
+}
+
+func (x *ListFriendRequestsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListFriendRequestsRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type ListFriendRequestsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Requests      []*FriendRequest       `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFriendRequestsResponse) Reset() {
+	*x = ListFriendRequestsResponse{}
+	mi := &file_idl_relation_v1_relation_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFriendRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFriendRequestsResponse) ProtoMessage() {}
+
+func (x *ListFriendRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_relation_v1_relation_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFriendRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListFriendRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_idl_relation_v1_relation_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListFriendRequestsResponse) GetRequests() []*FriendRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+func (x *ListFriendRequestsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type HandleFriendRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromUserID    int64                  `protobuf:"varint,1,opt,name=fromUserID,proto3" json:"fromUserID,omitempty"`
+	Accept        bool                   `protobuf:"varint,2,opt,name=accept,proto3" json:"accept,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HandleFriendRequestRequest) Reset() {
+	*x = HandleFriendRequestRequest{}
+	mi := &file_idl_relation_v1_relation_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandleFriendRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandleFriendRequestRequest) ProtoMessage() {}
+
+func (x *HandleFriendRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_relation_v1_relation_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandleFriendRequestRequest.ProtoReflect.Descriptor instead.
+func (*HandleFriendRequestRequest) Descriptor() ([]byte, []int) {
+	return file_idl_relation_v1_relation_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *HandleFriendRequestRequest) GetFromUserID() int64 {
+	if x != nil {
+		return x.FromUserID
+	}
+	return 0
+}
+
+func (x *HandleFriendRequestRequest) GetAccept() bool {
+	if x != nil {
+		return x.Accept
+	}
+	return false
+}
+
+type HandleFriendRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HandleFriendRequestResponse) Reset() {
+	*x = HandleFriendRequestResponse{}
+	mi := &file_idl_relation_v1_relation_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandleFriendRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandleFriendRequestResponse) ProtoMessage() {}
+
+func (x *HandleFriendRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_relation_v1_relation_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandleFriendRequestResponse.ProtoReflect.Descriptor instead.
+func (*HandleFriendRequestResponse) Descriptor() ([]byte, []int) {
+	return file_idl_relation_v1_relation_proto_rawDescGZIP(), []int{8}
+}
+
+type ListFriendsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Size          int32                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFriendsRequest) Reset() {
+	*x = ListFriendsRequest{}
+	mi := &file_idl_relation_v1_relation_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFriendsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFriendsRequest) ProtoMessage() {}
+
+func (x *ListFriendsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_relation_v1_relation_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFriendsRequest.ProtoReflect.Descriptor instead.
+func (*ListFriendsRequest) Descriptor() ([]byte, []int) {
+	return file_idl_relation_v1_relation_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListFriendsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListFriendsRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type ListFriendsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Friends       []*Friend              `protobuf:"bytes,1,rep,name=friends,proto3" json:"friends,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFriendsResponse) Reset() {
+	*x = ListFriendsResponse{}
+	mi := &file_idl_relation_v1_relation_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFriendsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFriendsResponse) ProtoMessage() {}
+
+func (x *ListFriendsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_relation_v1_relation_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFriendsResponse.ProtoReflect.Descriptor instead.
+func (*ListFriendsResponse) Descriptor() ([]byte, []int) {
+	return file_idl_relation_v1_relation_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListFriendsResponse) GetFriends() []*Friend {
+	if x != nil {
+		return x.Friends
+	}
+	return nil
+}
+
+func (x *ListFriendsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type SetFriendRemarkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FriendID      int64                  `protobuf:"varint,1,opt,name=friendID,proto3" json:"friendID,omitempty"`
+	Remark        string                 `protobuf:"bytes,2,opt,name=remark,proto3" json:"remark,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFriendRemarkRequest) Reset() {
+	*x = SetFriendRemarkRequest{}
+	mi := &file_idl_relation_v1_relation_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFriendRemarkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFriendRemarkRequest) ProtoMessage() {}
+
+func (x *SetFriendRemarkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_relation_v1_relation_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFriendRemarkRequest.ProtoReflect.Descriptor instead.
+func (*SetFriendRemarkRequest) Descriptor() ([]byte, []int) {
+	return file_idl_relation_v1_relation_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SetFriendRemarkRequest) GetFriendID() int64 {
+	if x != nil {
+		return x.FriendID
+	}
+	return 0
+}
+
+func (x *SetFriendRemarkRequest) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+type SetFriendRemarkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFriendRemarkResponse) Reset() {
+	*x = SetFriendRemarkResponse{}
+	mi := &file_idl_relation_v1_relation_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFriendRemarkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFriendRemarkResponse) ProtoMessage() {}
+
+func (x *SetFriendRemarkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_relation_v1_relation_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFriendRemarkResponse.ProtoReflect.Descriptor instead.
+func (*SetFriendRemarkResponse) Descriptor() ([]byte, []int) {
+	return file_idl_relation_v1_relation_proto_rawDescGZIP(), []int{12}
+}
+
+type DeleteFriendRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FriendID      int64                  `protobuf:"varint,1,opt,name=friendID,proto3" json:"friendID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFriendRequest) Reset() {
+	*x = DeleteFriendRequest{}
+	mi := &file_idl_relation_v1_relation_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFriendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFriendRequest) ProtoMessage() {}
+
+func (x *DeleteFriendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_relation_v1_relation_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFriendRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFriendRequest) Descriptor() ([]byte, []int) {
+	return file_idl_relation_v1_relation_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteFriendRequest) GetFriendID() int64 {
+	if x != nil {
+		return x.FriendID
+	}
+	return 0
+}
+
+type DeleteFriendResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFriendResponse) Reset() {
+	*x = DeleteFriendResponse{}
+	mi := &file_idl_relation_v1_relation_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFriendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFriendResponse) ProtoMessage() {}
+
+func (x *DeleteFriendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_relation_v1_relation_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFriendResponse.ProtoReflect.Descriptor instead.
+func (*DeleteFriendResponse) Descriptor() ([]byte, []int) {
+	return file_idl_relation_v1_relation_proto_rawDescGZIP(), []int{14}
+}
+
+type AddBlockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        int64                  `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddBlockRequest) Reset() {
+	*x = AddBlockRequest{}
+	mi := &file_idl_relation_v1_relation_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBlockRequest) ProtoMessage() {}
+
+func (x *AddBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_relation_v1_relation_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBlockRequest.ProtoReflect.Descriptor instead.
+func (*AddBlockRequest) Descriptor() ([]byte, []int) {
+	return file_idl_relation_v1_relation_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AddBlockRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+type AddBlockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddBlockResponse) Reset() {
+	*x = AddBlockResponse{}
+	mi := &file_idl_relation_v1_relation_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddBlockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBlockResponse) ProtoMessage() {}
+
+func (x *AddBlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_relation_v1_relation_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBlockResponse.ProtoReflect.Descriptor instead.
+func (*AddBlockResponse) Descriptor() ([]byte, []int) {
+	return file_idl_relation_v1_relation_proto_rawDescGZIP(), []int{16}
+}
+
+type RemoveBlockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        int64                  `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveBlockRequest) Reset() {
+	*x = RemoveBlockRequest{}
+	mi := &file_idl_relation_v1_relation_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveBlockRequest) ProtoMessage() {}
+
+func (x *RemoveBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_relation_v1_relation_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveBlockRequest.ProtoReflect.Descriptor instead.
+func (*RemoveBlockRequest) Descriptor() ([]byte, []int) {
+	return file_idl_relation_v1_relation_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RemoveBlockRequest) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+type RemoveBlockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveBlockResponse) Reset() {
+	*x = RemoveBlockResponse{}
+	mi := &file_idl_relation_v1_relation_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveBlockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveBlockResponse) ProtoMessage() {}
+
+func (x *RemoveBlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_relation_v1_relation_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveBlockResponse.ProtoReflect.Descriptor instead.
+func (*RemoveBlockResponse) Descriptor() ([]byte, []int) {
+	return file_idl_relation_v1_relation_proto_rawDescGZIP(), []int{18}
+}
+
+type ListBlocksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Size          int32                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBlocksRequest) Reset() {
+	*x = ListBlocksRequest{}
+	mi := &file_idl_relation_v1_relation_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlocksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlocksRequest) ProtoMessage() {}
+
+func (x *ListBlocksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_relation_v1_relation_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlocksRequest.ProtoReflect.Descriptor instead.
+func (*ListBlocksRequest) Descriptor() ([]byte, []int) {
+	return file_idl_relation_v1_relation_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListBlocksRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListBlocksRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type ListBlocksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*BlockedUser         `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBlocksResponse) Reset() {
+	*x = ListBlocksResponse{}
+	mi := &file_idl_relation_v1_relation_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlocksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlocksResponse) ProtoMessage() {}
+
+func (x *ListBlocksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_relation_v1_relation_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlocksResponse.ProtoReflect.Descriptor instead.
+func (*ListBlocksResponse) Descriptor() ([]byte, []int) {
+	return file_idl_relation_v1_relation_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListBlocksResponse) GetUsers() []*BlockedUser {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListBlocksResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type CheckSingleChatRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SendID        int64                  `protobuf:"varint,1,opt,name=sendID,proto3" json:"sendID,omitempty"`
+	RecvID        int64                  `protobuf:"varint,2,opt,name=recvID,proto3" json:"recvID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckSingleChatRequest) Reset() {
+	*x = CheckSingleChatRequest{}
+	mi := &file_idl_relation_v1_relation_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckSingleChatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckSingleChatRequest) ProtoMessage() {}
+
+func (x *CheckSingleChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_relation_v1_relation_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckSingleChatRequest.ProtoReflect.Descriptor instead.
+func (*CheckSingleChatRequest) Descriptor() ([]byte, []int) {
+	return file_idl_relation_v1_relation_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CheckSingleChatRequest) GetSendID() int64 {
+	if x != nil {
+		return x.SendID
+	}
+	return 0
+}
+
+func (x *CheckSingleChatRequest) GetRecvID() int64 {
+	if x != nil {
+		return x.RecvID
+	}
+	return 0
+}
+
+type CheckSingleChatResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckSingleChatResponse) Reset() {
+	*x = CheckSingleChatResponse{}
+	mi := &file_idl_relation_v1_relation_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckSingleChatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckSingleChatResponse) ProtoMessage() {}
+
+func (x *CheckSingleChatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_relation_v1_relation_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckSingleChatResponse.ProtoReflect.Descriptor instead.
+func (*CheckSingleChatResponse) Descriptor() ([]byte, []int) {
+	return file_idl_relation_v1_relation_proto_rawDescGZIP(), []int{22}
+}
+
+var File_idl_relation_v1_relation_proto protoreflect.FileDescriptor
+
+const file_idl_relation_v1_relation_proto_rawDesc = "" +
+	"\n" +
+	"\x1eidl/relation/v1/relation.proto\x12\vrelation.v1\"\xe3\x01\n" +
+	"\rFriendRequest\x12\x1e\n" +
+	"\n" +
+	"fromUserID\x18\x01 \x01(\x03R\n" +
+	"fromUserID\x12\x1a\n" +
+	"\btoUserID\x18\x02 \x01(\x03R\btoUserID\x12\x1a\n" +
+	"\bgreeting\x18\x03 \x01(\tR\bgreeting\x128\n" +
+	"\x06status\x18\x04 \x01(\x0e2 .relation.v1.FriendRequestStatusR\x06status\x12\x1f\n" +
+	"\vhandle_time\x18\x05 \x01(\x03R\n" +
+	"handleTime\x12\x1f\n" +
+	"\vcreate_time\x18\x06 \x01(\x03R\n" +
+	"createTime\"]\n" +
+	"\x06Friend\x12\x1a\n" +
+	"\bfriendID\x18\x01 \x01(\x03R\bfriendID\x12\x16\n" +
+	"\x06remark\x18\x02 \x01(\tR\x06remark\x12\x1f\n" +
+	"\vcreate_time\x18\x03 \x01(\x03R\n" +
+	"createTime\"F\n" +
+	"\vBlockedUser\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\x03R\x06userID\x12\x1f\n" +
+	"\vcreate_time\x18\x02 \x01(\x03R\n" +
+	"createTime\"Q\n" +
+	"\x17ApplyToAddFriendRequest\x12\x1a\n" +
+	"\btoUserID\x18\x01 \x01(\x03R\btoUserID\x12\x1a\n" +
+	"\bgreeting\x18\x02 \x01(\tR\bgreeting\"\x1a\n" +
+	"\x18ApplyToAddFriendResponse\"W\n" +
+	"\x19ListFriendRequestsRequest\x12\x12\n" +
+	"\x04sent\x18\x01 \x01(\bR\x04sent\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x05R\x04size\"j\n" +
+	"\x1aListFriendRequestsResponse\x126\n" +
+	"\brequests\x18\x01 \x03(\v2\x1a.relation.v1.FriendRequestR\brequests\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"T\n" +
+	"\x1aHandleFriendRequestRequest\x12\x1e\n" +
+	"\n" +
+	"fromUserID\x18\x01 \x01(\x03R\n" +
+	"fromUserID\x12\x16\n" +
+	"\x06accept\x18\x02 \x01(\bR\x06accept\"\x1d\n" +
+	"\x1bHandleFriendRequestResponse\"<\n" +
+	"\x12ListFriendsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x05R\x04size\"Z\n" +
+	"\x13ListFriendsResponse\x12-\n" +
+	"\afriends\x18\x01 \x03(\v2\x13.relation.v1.FriendR\afriends\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"L\n" +
+	"\x16SetFriendRemarkRequest\x12\x1a\n" +
+	"\bfriendID\x18\x01 \x01(\x03R\bfriendID\x12\x16\n" +
+	"\x06remark\x18\x02 \x01(\tR\x06remark\"\x19\n" +
+	"\x17SetFriendRemarkResponse\"1\n" +
+	"\x13DeleteFriendRequest\x12\x1a\n" +
+	"\bfriendID\x18\x01 \x01(\x03R\bfriendID\"\x16\n" +
+	"\x14DeleteFriendResponse\")\n" +
+	"\x0fAddBlockRequest\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\x03R\x06userID\"\x12\n" +
+	"\x10AddBlockResponse\",\n" +
+	"\x12RemoveBlockRequest\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\x03R\x06userID\"\x15\n" +
+	"\x13RemoveBlockResponse\";\n" +
+	"\x11ListBlocksRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x05R\x04size\"Z\n" +
+	"\x12ListBlocksResponse\x12.\n" +
+	"\x05users\x18\x01 \x03(\v2\x18.relation.v1.BlockedUserR\x05users\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"H\n" +
+	"\x16CheckSingleChatRequest\x12\x16\n" +
+	"\x06sendID\x18\x01 \x01(\x03R\x06sendID\x12\x16\n" +
+	"\x06recvID\x18\x02 \x01(\x03R\x06recvID\"\x19\n" +
+	"\x17CheckSingleChatResponse*\x80\x01\n" +
+	"\x13FriendRequestStatus\x12!\n" +
+	"\x1dFRIEND_REQUEST_STATUS_PENDING\x10\x00\x12\"\n" +
+	"\x1eFRIEND_REQUEST_STATUS_ACCEPTED\x10\x01\x12\"\n" +
+	"\x1eFRIEND_REQUEST_STATUS_REJECTED\x10\x022\x90\a\n" +
+	"\x0fRelationService\x12_\n" +
+	"\x10ApplyToAddFriend\x12$.relation.v1.ApplyToAddFriendRequest\x1a%.relation.v1.ApplyToAddFriendResponse\x12e\n" +
+	"\x12ListFriendRequests\x12&.relation.v1.ListFriendRequestsRequest\x1a'.relation.v1.ListFriendRequestsResponse\x12h\n" +
+	"\x13HandleFriendRequest\x12'.relation.v1.HandleFriendRequestRequest\x1a(.relation.v1.HandleFriendRequestResponse\x12P\n" +
+	"\vListFriends\x12\x1f.relation.v1.ListFriendsRequest\x1a .relation.v1.ListFriendsResponse\x12\\\n" +
+	"\x0fSetFriendRemark\x12#.relation.v1.SetFriendRemarkRequest\x1a$.relation.v1.SetFriendRemarkResponse\x12S\n" +
+	"\fDeleteFriend\x12 .relation.v1.DeleteFriendRequest\x1a!.relation.v1.DeleteFriendResponse\x12G\n" +
+	"\bAddBlock\x12\x1c.relation.v1.AddBlockRequest\x1a\x1d.relation.v1.AddBlockResponse\x12P\n" +
+	"\vRemoveBlock\x12\x1f.relation.v1.RemoveBlockRequest\x1a .relation.v1.RemoveBlockResponse\x12M\n" +
+	"\n" +
+	"ListBlocks\x12\x1e.relation.v1.ListBlocksRequest\x1a\x1f.relation.v1.ListBlocksResponse\x12\\\n" +
+	"\x0fCheckSingleChat\x12#.relation.v1.CheckSingleChatRequest\x1a$.relation.v1.CheckSingleChatResponseB>Z<github.com/crazyfrankie/goim/protocol/relation/v1;relationv1b\x06proto3"
+
+var (
+	file_idl_relation_v1_relation_proto_rawDescOnce sync.Once
+	file_idl_relation_v1_relation_proto_rawDescData []byte
+)
+
+func file_idl_relation_v1_relation_proto_rawDescGZIP() []byte {
+	file_idl_relation_v1_relation_proto_rawDescOnce.Do(func() {
+		file_idl_relation_v1_relation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_idl_relation_v1_relation_proto_rawDesc), len(file_idl_relation_v1_relation_proto_rawDesc)))
+	})
+	return file_idl_relation_v1_relation_proto_rawDescData
+}
+
+var file_idl_relation_v1_relation_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_idl_relation_v1_relation_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_idl_relation_v1_relation_proto_goTypes = []any{
+	(FriendRequestStatus)(0),            // 0: relation.v1.FriendRequestStatus
+	(*FriendRequest)(nil),               // 1: relation.v1.FriendRequest
+	(*Friend)(nil),                      // 2: relation.v1.Friend
+	(*BlockedUser)(nil),                 // 3: relation.v1.BlockedUser
+	(*ApplyToAddFriendRequest)(nil),     // 4: relation.v1.ApplyToAddFriendRequest
+	(*ApplyToAddFriendResponse)(nil),    // 5: relation.v1.ApplyToAddFriendResponse
+	(*ListFriendRequestsRequest)(nil),   // 6: relation.v1.ListFriendRequestsRequest
+	(*ListFriendRequestsResponse)(nil),  // 7: relation.v1.ListFriendRequestsResponse
+	(*HandleFriendRequestRequest)(nil),  // 8: relation.v1.HandleFriendRequestRequest
+	(*HandleFriendRequestResponse)(nil), // 9: relation.v1.HandleFriendRequestResponse
+	(*ListFriendsRequest)(nil),          // 10: relation.v1.ListFriendsRequest
+	(*ListFriendsResponse)(nil),         // 11: relation.v1.ListFriendsResponse
+	(*SetFriendRemarkRequest)(nil),      // 12: relation.v1.SetFriendRemarkRequest
+	(*SetFriendRemarkResponse)(nil),     // 13: relation.v1.SetFriendRemarkResponse
+	(*DeleteFriendRequest)(nil),         // 14: relation.v1.DeleteFriendRequest
+	(*DeleteFriendResponse)(nil),        // 15: relation.v1.DeleteFriendResponse
+	(*AddBlockRequest)(nil),             // 16: relation.v1.AddBlockRequest
+	(*AddBlockResponse)(nil),            // 17: relation.v1.AddBlockResponse
+	(*RemoveBlockRequest)(nil),          // 18: relation.v1.RemoveBlockRequest
+	(*RemoveBlockResponse)(nil),         // 19: relation.v1.RemoveBlockResponse
+	(*ListBlocksRequest)(nil),           // 20: relation.v1.ListBlocksRequest
+	(*ListBlocksResponse)(nil),          // 21: relation.v1.ListBlocksResponse
+	(*CheckSingleChatRequest)(nil),      // 22: relation.v1.CheckSingleChatRequest
+	(*CheckSingleChatResponse)(nil),     // 23: relation.v1.CheckSingleChatResponse
+}
+var file_idl_relation_v1_relation_proto_depIdxs = []int32{
+	0,  // 0: relation.v1.FriendRequest.status:type_name -> relation.v1.FriendRequestStatus
+	1,  // 1: relation.v1.ListFriendRequestsResponse.requests:type_name -> relation.v1.FriendRequest
+	2,  // 2: relation.v1.ListFriendsResponse.friends:type_name -> relation.v1.Friend
+	3,  // 3: relation.v1.ListBlocksResponse.users:type_name -> relation.v1.BlockedUser
+	4,  // 4: relation.v1.RelationService.ApplyToAddFriend:input_type -> relation.v1.ApplyToAddFriendRequest
+	6,  // 5: relation.v1.RelationService.ListFriendRequests:input_type -> relation.v1.ListFriendRequestsRequest
+	8,  // 6: relation.v1.RelationService.HandleFriendRequest:input_type -> relation.v1.HandleFriendRequestRequest
+	10, // 7: relation.v1.RelationService.ListFriends:input_type -> relation.v1.ListFriendsRequest
+	12, // 8: relation.v1.RelationService.SetFriendRemark:input_type -> relation.v1.SetFriendRemarkRequest
+	14, // 9: relation.v1.RelationService.DeleteFriend:input_type -> relation.v1.DeleteFriendRequest
+	16, // 10: relation.v1.RelationService.AddBlock:input_type -> relation.v1.AddBlockRequest
+	18, // 11: relation.v1.RelationService.RemoveBlock:input_type -> relation.v1.RemoveBlockRequest
+	20, // 12: relation.v1.RelationService.ListBlocks:input_type -> relation.v1.ListBlocksRequest
+	22, // 13: relation.v1.RelationService.CheckSingleChat:input_type -> relation.v1.CheckSingleChatRequest
+	5,  // 14: relation.v1.RelationService.ApplyToAddFriend:output_type -> relation.v1.ApplyToAddFriendResponse
+	7,  // 15: relation.v1.RelationService.ListFriendRequests:output_type -> relation.v1.ListFriendRequestsResponse
+	9,  // 16: relation.v1.RelationService.HandleFriendRequest:output_type -> relation.v1.HandleFriendRequestResponse
+	11, // 17: relation.v1.RelationService.ListFriends:output_type -> relation.v1.ListFriendsResponse
+	13, // 18: relation.v1.RelationService.SetFriendRemark:output_type -> relation.v1.SetFriendRemarkResponse
+	15, // 19: relation.v1.RelationService.DeleteFriend:output_type -> relation.v1.DeleteFriendResponse
+	17, // 20: relation.v1.RelationService.AddBlock:output_type -> relation.v1.AddBlockResponse
+	19, // 21: relation.v1.RelationService.RemoveBlock:output_type -> relation.v1.RemoveBlockResponse
+	21, // 22: relation.v1.RelationService.ListBlocks:output_type -> relation.v1.ListBlocksResponse
+	23, // 23: relation.v1.RelationService.CheckSingleChat:output_type -> relation.v1.CheckSingleChatResponse
+	14, // [14:24] is the sub-list for method output_type
+	4,  // [4:14] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_idl_relation_v1_relation_proto_init() }
+func file_idl_relation_v1_relation_proto_init() {
+	if File_idl_relation_v1_relation_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_idl_relation_v1_relation_proto_rawDesc), len(file_idl_relation_v1_relation_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   23,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_idl_relation_v1_relation_proto_goTypes,
+		DependencyIndexes: file_idl_relation_v1_relation_proto_depIdxs,
+		EnumInfos:         file_idl_relation_v1_relation_proto_enumTypes,
+		MessageInfos:      file_idl_relation_v1_relation_proto_msgTypes,
+	}.Build()
+	File_idl_relation_v1_relation_proto = out.File
+	file_idl_relation_v1_relation_proto_goTypes = nil
+	file_idl_relation_v1_relation_proto_depIdxs = nil
+}
@@ -25,7 +25,8 @@ CREATE TABLE IF NOT EXISTS `message` (
   `send_id` bigint NOT NULL COMMENT 'Sender ID',
   `recv_id` bigint NOT NULL COMMENT 'Receiver ID',
   `group_id` bigint NOT NULL COMMENT 'Group ID',
-  `client_msg_id` tinytext NOT NULL COMMENT 'Client Message ID',
+  `conversation_id` varchar(128) NOT NULL COMMENT 'Conversation ID',
+  `client_msg_id` varchar(64) NOT NULL COMMENT 'Client Message ID',
   `session_type` int NOT NULL COMMENT 'Session Type',
   `message_from` int NOT NULL COMMENT 'Message Source',
   `content_type` int NOT NULL COMMENT 'Message Content Type',
@@ -39,5 +40,127 @@ CREATE TABLE IF NOT EXISTS `message` (
   PRIMARY KEY (`id`),
   INDEX `idx_send_id` (`send_id`),
   INDEX `idx_recv_id` (`recv_id`),
-  INDEX `idx_group_id` (`group_id`)
-) ENGINE=InnoDB CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT 'Message Table';
\ No newline at end of file
+  INDEX `idx_group_id` (`group_id`),
+  UNIQUE INDEX `uniq_conversation_seq` (`conversation_id`, `seq`),
+  UNIQUE INDEX `uniq_send_client_msg` (`send_id`, `client_msg_id`)
+) ENGINE=InnoDB CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT 'Message Table';
+
+CREATE TABLE IF NOT EXISTS `seq_conversation` (
+  `conversation_id` varchar(128) NOT NULL COMMENT 'Conversation ID',
+  `max_seq` bigint NOT NULL COMMENT 'Highest Reserved Sequence Number',
+  `updated_time` bigint NOT NULL COMMENT 'Update Time (Milliseconds)',
+  PRIMARY KEY (`conversation_id`)
+) ENGINE=InnoDB CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT 'Conversation Sequence High-Water Mark Table';
+
+CREATE TABLE IF NOT EXISTS `message_user_clear` (
+  `owner_id` bigint NOT NULL COMMENT 'Owner User ID',
+  `conversation_id` varchar(128) NOT NULL COMMENT 'Conversation ID',
+  `cleared_seq` bigint NOT NULL COMMENT 'Messages Up To This Sequence Number Are Hidden',
+  `updated_time` bigint NOT NULL COMMENT 'Update Time (Milliseconds)',
+  PRIMARY KEY (`owner_id`, `conversation_id`)
+) ENGINE=InnoDB CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT 'Per-User Conversation Clear Cursor Table';
+
+CREATE TABLE IF NOT EXISTS `message_user_delete` (
+  `id` bigint NOT NULL AUTO_INCREMENT COMMENT 'Primary Key ID',
+  `owner_id` bigint NOT NULL COMMENT 'Owner User ID',
+  `conversation_id` varchar(128) NOT NULL COMMENT 'Conversation ID',
+  `seq` bigint NOT NULL COMMENT 'Deleted Message Sequence Number',
+  `created_time` bigint NOT NULL COMMENT 'Creation Time (Milliseconds)',
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `uniq_owner_conversation_seq` (`owner_id`, `conversation_id`, `seq`)
+) ENGINE=InnoDB CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT 'Per-User Deleted Message Table';
+
+CREATE TABLE IF NOT EXISTS `message_read_seq` (
+  `owner_id` bigint NOT NULL COMMENT 'Reader User ID',
+  `conversation_id` varchar(128) NOT NULL COMMENT 'Conversation ID',
+  `has_read_seq` bigint NOT NULL DEFAULT 0 COMMENT 'Has Read Message Sequence Number',
+  `updated_time` bigint NOT NULL COMMENT 'Update Time (Milliseconds)',
+  PRIMARY KEY (`owner_id`, `conversation_id`),
+  INDEX `idx_conversation_has_read_seq` (`conversation_id`, `has_read_seq`)
+) ENGINE=InnoDB CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT 'Per-User Message Read Cursor Table';
+
+CREATE TABLE IF NOT EXISTS `conversation` (
+  `id` bigint NOT NULL AUTO_INCREMENT COMMENT 'Primary Key ID',
+  `owner_id` bigint NOT NULL COMMENT 'Owner User ID',
+  `conversation_id` varchar(128) NOT NULL COMMENT 'Conversation ID',
+  `conversation_type` int NOT NULL COMMENT 'Conversation Type',
+  `peer_id` bigint NOT NULL DEFAULT 0 COMMENT 'Peer User ID (single chat)',
+  `group_id` bigint NOT NULL DEFAULT 0 COMMENT 'Group ID (group chat)',
+  `max_seq` bigint NOT NULL DEFAULT 0 COMMENT 'Max Message Sequence Number',
+  `has_read_seq` bigint NOT NULL DEFAULT 0 COMMENT 'Has Read Message Sequence Number',
+  `last_msg_id` bigint NOT NULL DEFAULT 0 COMMENT 'Last Message ID',
+  `last_msg_send_id` bigint NOT NULL DEFAULT 0 COMMENT 'Last Message Sender ID',
+  `last_msg_content_type` int NOT NULL DEFAULT 0 COMMENT 'Last Message Content Type',
+  `last_msg_content` text NOT NULL COMMENT 'Last Message Content',
+  `last_msg_time` bigint NOT NULL DEFAULT 0 COMMENT 'Last Message Send Time (Milliseconds)',
+  `is_pinned` boolean NOT NULL DEFAULT false COMMENT 'Pinned Flag',
+  `is_muted` boolean NOT NULL DEFAULT false COMMENT 'Do Not Disturb Flag',
+  `draft` varchar(1024) NOT NULL DEFAULT '' COMMENT 'Draft Text',
+  `draft_time` bigint NOT NULL DEFAULT 0 COMMENT 'Draft Update Time (Milliseconds)',
+  `created_time` bigint NOT NULL DEFAULT 0 COMMENT 'Creation Time (Milliseconds)',
+  `updated_time` bigint NOT NULL DEFAULT 0 COMMENT 'Update Time (Milliseconds)',
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `uniq_owner_conversation` (`owner_id`, `conversation_id`),
+  INDEX `idx_owner_last_msg_time` (`owner_id`, `is_pinned`, `last_msg_time`)
+) ENGINE=InnoDB CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT 'Conversation Table';
+
+CREATE TABLE IF NOT EXISTS `group_info` (
+  `id` bigint NOT NULL COMMENT 'Group ID',
+  `name` varchar(64) NOT NULL DEFAULT '' COMMENT 'Group Name',
+  `icon_uri` varchar(512) NOT NULL DEFAULT '' COMMENT 'Avatar URI',
+  `notice` varchar(1024) NOT NULL DEFAULT '' COMMENT 'Group Notice',
+  `owner_id` bigint NOT NULL COMMENT 'Owner User ID',
+  `member_count` int NOT NULL DEFAULT 0 COMMENT 'Member Count',
+  `status` tinyint NOT NULL DEFAULT 0 COMMENT 'Group Status, 0 normal, 1 dismissed',
+  `created_at` bigint unsigned NOT NULL DEFAULT 0 COMMENT 'Creation Time (Milliseconds)',
+  `updated_at` bigint unsigned NOT NULL DEFAULT 0 COMMENT 'Update Time (Milliseconds)',
+  PRIMARY KEY (`id`),
+  INDEX `idx_owner_id` (`owner_id`)
+) ENGINE=InnoDB CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT 'Group Table';
+
+CREATE TABLE IF NOT EXISTS `group_member` (
+  `id` bigint NOT NULL AUTO_INCREMENT COMMENT 'Primary Key ID',
+  `group_id` bigint NOT NULL COMMENT 'Group ID',
+  `user_id` bigint NOT NULL COMMENT 'Member User ID',
+  `role` tinyint NOT NULL DEFAULT 0 COMMENT 'Member Role, 0 member, 1 admin, 2 owner',
+  `inviter_id` bigint NOT NULL DEFAULT 0 COMMENT 'Inviter User ID',
+  `created_at` bigint unsigned NOT NULL DEFAULT 0 COMMENT 'Join Time (Milliseconds)',
+  `updated_at` bigint unsigned NOT NULL DEFAULT 0 COMMENT 'Update Time (Milliseconds)',
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `uniq_group_user` (`group_id`, `user_id`),
+  INDEX `idx_user_id` (`user_id`)
+) ENGINE=InnoDB CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT 'Group Member Table';
+
+CREATE TABLE IF NOT EXISTS `friend_request` (
+  `id` bigint NOT NULL AUTO_INCREMENT COMMENT 'Primary Key ID',
+  `from_user_id` bigint NOT NULL COMMENT 'Applicant User ID',
+  `to_user_id` bigint NOT NULL COMMENT 'Recipient User ID',
+  `greeting` varchar(255) NOT NULL DEFAULT '' COMMENT 'Greeting Message',
+  `status` tinyint NOT NULL DEFAULT 0 COMMENT 'Request Status, 0 pending, 1 accepted, 2 rejected',
+  `handled_at` bigint unsigned NOT NULL DEFAULT 0 COMMENT 'Handle Time (Milliseconds)',
+  `created_at` bigint unsigned NOT NULL DEFAULT 0 COMMENT 'Creation Time (Milliseconds)',
+  `updated_at` bigint unsigned NOT NULL DEFAULT 0 COMMENT 'Update Time (Milliseconds)',
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `uniq_from_to` (`from_user_id`, `to_user_id`),
+  INDEX `idx_to_user_id` (`to_user_id`)
+) ENGINE=InnoDB CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT 'Friend Request Table';
+
+CREATE TABLE IF NOT EXISTS `friend` (
+  `id` bigint NOT NULL AUTO_INCREMENT COMMENT 'Primary Key ID',
+  `owner_id` bigint NOT NULL COMMENT 'Owner User ID',
+  `friend_id` bigint NOT NULL COMMENT 'Friend User ID',
+  `remark` varchar(64) NOT NULL DEFAULT '' COMMENT 'Remark Name',
+  `created_at` bigint unsigned NOT NULL DEFAULT 0 COMMENT 'Creation Time (Milliseconds)',
+  `updated_at` bigint unsigned NOT NULL DEFAULT 0 COMMENT 'Update Time (Milliseconds)',
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `uniq_owner_friend` (`owner_id`, `friend_id`)
+) ENGINE=InnoDB CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT 'Friend Table';
+
+CREATE TABLE IF NOT EXISTS `blocklist` (
+  `id` bigint NOT NULL AUTO_INCREMENT COMMENT 'Primary Key ID',
+  `owner_id` bigint NOT NULL COMMENT 'Owner User ID',
+  `block_user_id` bigint NOT NULL COMMENT 'Blocked User ID',
+  `created_at` bigint unsigned NOT NULL DEFAULT 0 COMMENT 'Creation Time (Milliseconds)',
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `uniq_owner_block_user` (`owner_id`, `block_user_id`)
+) ENGINE=InnoDB CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT 'Blocklist Table';
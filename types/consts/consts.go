@@ -13,6 +13,11 @@ const (
 	RMQSecretKey  = "RMQ_SECRET_KEY"
 	MQServer      = "MQ_SERVER"
 	DiscoveryType = "DISCOVERY_TYPE"
+
+	MsgRevokeWindow = "MSG_REVOKE_WINDOW"
+
+	OfflinePushType       = "OFFLINE_PUSH_TYPE"
+	OfflinePushWebhookURL = "OFFLINE_PUSH_WEBHOOK_URL"
 )
 
 const (
@@ -16,6 +16,9 @@ const (
 	QuoteMessageType
 	MarkdownTextMessageType
 	OANotification
+	DeleteMessageNotification
+	HasReadReceipt
+	MessageStatusNotification
 )
 
 const (
@@ -25,3 +28,23 @@ const (
 	ReadGroupChatType
 	NotificationChatType
 )
+
+// IsGroupSessionType reports whether messages of the session type go to a
+// group, whose members share one conversation.
+func IsGroupSessionType(sessionType int32) bool {
+	switch sessionType {
+	case GroupChatType, WriteGroupChatType, ReadGroupChatType:
+		return true
+	default:
+		return false
+	}
+}
+
+const (
+	MsgStatusSent = iota
+	MsgStatusRevoked
+	MsgStatusSending
+	MsgStatusDelivered
+	MsgStatusFailed
+	MsgStatusDeleted
+)
@@ -35,6 +35,21 @@ const (
 	TerminalPad    = "Pad"
 )
 
+// Multi-terminal login policies, they decide which connections of a user a
+// new login kicks.
+const (
+	// AllowAllLogin never kicks.
+	AllowAllLogin = iota + 1
+	// OnePerPlatformLogin kicks the connections on the same platform.
+	OnePerPlatformLogin
+	// OnePerClassLogin kicks the connections of the same terminal class.
+	OnePerClassLogin
+	// PCAndMobileLogin keeps one PC and one non PC terminal, such as a phone.
+	PCAndMobileLogin
+	// SingleDeviceLogin kicks every other connection.
+	SingleDeviceLogin
+)
+
 var PlatformID2Name = map[int32]string{
 	IOSPlatformID:        IOSPlatformStr,
 	AndroidPlatformID:    AndroidPlatformStr,
@@ -1,9 +1,17 @@
 package consts
 
 const (
-	UserServiceName    = "goim-rpc-user"
-	AuthServiceName    = "goim-rpc-auth"
-	MessageServiceName = "goim-rpc-message"
+	UserServiceName         = "goim-rpc-user"
+	AuthServiceName         = "goim-rpc-auth"
+	MessageServiceName      = "goim-rpc-message"
+	ConversationServiceName = "goim-rpc-conversation"
+	GroupServiceName        = "goim-rpc-group"
+	RelationServiceName     = "goim-rpc-relation"
+)
+
+const (
+	MsgGatewayServiceName = "goim-msg-gateway"
+	PushServiceName       = "goim-push"
 )
 
 const (
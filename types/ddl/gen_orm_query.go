@@ -22,8 +22,23 @@ var path2Table2FieldMapping = map[string]map[string]map[string]any{
 	// The message table is stored in TiDB. Although you can still use MySQL when generating code (since TiDB is compatible with the MySQL protocol),
 	// note that during actual runtime, the message table should not exist in MySQL—it should reside in TiDB.
 	"apps/message/domain/internal/dal/query": {
-		"message": {},
-		//"conversation": {},
+		"message":             {},
+		"seq_conversation":    {},
+		"message_user_clear":  {},
+		"message_user_delete": {},
+		"message_read_seq":    {},
+	},
+	"apps/conversation/domain/internal/dal/query": {
+		"conversation": {},
+	},
+	"apps/group/domain/internal/dal/query": {
+		"group_info":   {},
+		"group_member": {},
+	},
+	"apps/relation/domain/internal/dal/query": {
+		"friend_request": {},
+		"friend":         {},
+		"blocklist":      {},
 	},
 }
 
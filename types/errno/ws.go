@@ -11,6 +11,42 @@ const (
 	ErrConnArgsCode              = 102101
 	errConnArgsMessage           = "args err, need token, sendID, platformID"
 	errConnArgsNoAffectStability = true
+
+	ErrReqDataCode              = 102102
+	errReqDataMessage           = "invalid request data : {msg}"
+	errReqDataNoAffectStability = true
+
+	ErrConnTokenInvalidCode              = 102103
+	errConnTokenInvalidMessage           = "connection token is invalid"
+	errConnTokenInvalidNoAffectStability = true
+
+	ErrConnTokenExpiredCode              = 102104
+	errConnTokenExpiredMessage           = "connection token is expired"
+	errConnTokenExpiredNoAffectStability = true
+
+	ErrConnTokenRevokedCode              = 102105
+	errConnTokenRevokedMessage           = "connection token has been revoked"
+	errConnTokenRevokedNoAffectStability = true
+
+	ErrConnTokenMismatchCode              = 102106
+	errConnTokenMismatchMessage           = "connection token does not belong to sendID : {send_id}"
+	errConnTokenMismatchNoAffectStability = true
+
+	ErrRateLimitedCode              = 102107
+	errRateLimitedMessage           = "request rate limit exceeded : {scope}"
+	errRateLimitedNoAffectStability = true
+
+	ErrRoomForbiddenCode              = 102108
+	errRoomForbiddenMessage           = "room access denied : {room_id}"
+	errRoomForbiddenNoAffectStability = true
+
+	ErrRoomNotJoinedCode              = 102109
+	errRoomNotJoinedMessage           = "not a member of room : {room_id}"
+	errRoomNotJoinedNoAffectStability = true
+
+	ErrRoomLimitCode              = 102110
+	errRoomLimitMessage           = "too many rooms joined, the limit is {limit}"
+	errRoomLimitNoAffectStability = true
 )
 
 func init() {
@@ -21,4 +57,58 @@ func init() {
 		code.WithAffectStability(!errConnArgsNoAffectStability),
 	)
 
+	code.Register(
+		ErrReqDataCode,
+		errReqDataMessage,
+		code.WithAffectStability(!errReqDataNoAffectStability),
+	)
+
+	code.Register(
+		ErrConnTokenInvalidCode,
+		errConnTokenInvalidMessage,
+		code.WithAffectStability(!errConnTokenInvalidNoAffectStability),
+	)
+
+	code.Register(
+		ErrConnTokenExpiredCode,
+		errConnTokenExpiredMessage,
+		code.WithAffectStability(!errConnTokenExpiredNoAffectStability),
+	)
+
+	code.Register(
+		ErrConnTokenRevokedCode,
+		errConnTokenRevokedMessage,
+		code.WithAffectStability(!errConnTokenRevokedNoAffectStability),
+	)
+
+	code.Register(
+		ErrConnTokenMismatchCode,
+		errConnTokenMismatchMessage,
+		code.WithAffectStability(!errConnTokenMismatchNoAffectStability),
+	)
+
+	code.Register(
+		ErrRateLimitedCode,
+		errRateLimitedMessage,
+		code.WithAffectStability(!errRateLimitedNoAffectStability),
+	)
+
+	code.Register(
+		ErrRoomForbiddenCode,
+		errRoomForbiddenMessage,
+		code.WithAffectStability(!errRoomForbiddenNoAffectStability),
+	)
+
+	code.Register(
+		ErrRoomNotJoinedCode,
+		errRoomNotJoinedMessage,
+		code.WithAffectStability(!errRoomNotJoinedNoAffectStability),
+	)
+
+	code.Register(
+		ErrRoomLimitCode,
+		errRoomLimitMessage,
+		code.WithAffectStability(!errRoomLimitNoAffectStability),
+	)
+
 }